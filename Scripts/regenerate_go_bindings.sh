@@ -0,0 +1,13 @@
+#!/bin/bash
+set -euxo pipefail
+# Regenerates frost_go_ffi/frost_uniffi_sdk.go and frost_go_ffi/frost_go_ffi.h
+# from the RedPallas library, whose bindings are the ones committed. Run it
+# after changing any exported item of frost-uniffi-sdk.
+cargo build --package frost-uniffi-sdk --features redpallas
+
+LIBRARY=./target/debug/libfrost_uniffi_sdk.so
+if [ "$(uname)" = "Darwin" ]; then
+	LIBRARY=./target/debug/libfrost_uniffi_sdk.dylib
+fi
+
+uniffi-bindgen-go --library "$LIBRARY" --out-dir .
@@ -15,4 +15,12 @@ LD_LIBRARY_PATH="${LD_LIBRARY_PATH:-}:$BINARIES_DIR" \
 LD_LIBRARY_PATH="${LD_LIBRARY_PATH:-}:$BINARIES_DIR" \
 	CGO_LDFLAGS="-lfrost_uniffi_sdk -L$BINARIES_DIR -lm -ldl" \
 	CGO_ENABLED=1 \
-	go test -v $BINDINGS_DIR/frost_go_ffi_orchard_keys_test.go $BINDINGS_DIR/frost_uniffi_sdk.go 
\ No newline at end of file
+	go test -v $BINDINGS_DIR/frost_go_ffi_orchard_keys_test.go $BINDINGS_DIR/frost_uniffi_sdk.go
+LD_LIBRARY_PATH="${LD_LIBRARY_PATH:-}:$BINARIES_DIR" \
+	CGO_LDFLAGS="-lfrost_uniffi_sdk -L$BINARIES_DIR -lm -ldl" \
+	CGO_ENABLED=1 \
+	go test -v $BINDINGS_DIR/coordinator_test.go $BINDINGS_DIR/signing_participant_test.go $BINDINGS_DIR/session_test.go $BINDINGS_DIR/dkg_session_test.go $BINDINGS_DIR/dkg_checkpoint_test.go $BINDINGS_DIR/keystore_test.go $BINDINGS_DIR/sealed_secret_test.go $BINDINGS_DIR/dkg_channel_test.go $BINDINGS_DIR/dkg_broadcast_test.go $BINDINGS_DIR/refresh_test.go $BINDINGS_DIR/repair_test.go $BINDINGS_DIR/reshare_test.go $BINDINGS_DIR/reconstruct_test.go $BINDINGS_DIR/orchard_spend_auth_test.go $BINDINGS_DIR/randomization_test.go $BINDINGS_DIR/pczt_signer_test.go $BINDINGS_DIR/orchard_key_backup_test.go $BINDINGS_DIR/coordinator.go $BINDINGS_DIR/signing_participant.go $BINDINGS_DIR/session.go $BINDINGS_DIR/dkg_session.go $BINDINGS_DIR/dkg_checkpoint.go $BINDINGS_DIR/sealed_secret.go $BINDINGS_DIR/keystore.go $BINDINGS_DIR/dkg_channel.go $BINDINGS_DIR/dkg_broadcast.go $BINDINGS_DIR/refresh.go $BINDINGS_DIR/repair.go $BINDINGS_DIR/reshare.go $BINDINGS_DIR/reconstruct.go $BINDINGS_DIR/orchard_spend_auth.go $BINDINGS_DIR/randomization.go $BINDINGS_DIR/pczt_signer.go $BINDINGS_DIR/orchard_key_backup.go $BINDINGS_DIR/frost_uniffi_sdk.go
+LD_LIBRARY_PATH="${LD_LIBRARY_PATH:-}:$BINARIES_DIR" \
+	CGO_LDFLAGS="-lfrost_uniffi_sdk -L$BINARIES_DIR -lm -ldl" \
+	CGO_ENABLED=1 \
+	go test -v ./cmd/frost ./coordinatorhttp ./commkey ./frostd/... ./ed25519 ./secp256k1tr
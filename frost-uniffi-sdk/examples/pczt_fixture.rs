@@ -0,0 +1,91 @@
+//! Generates `frost_go_ffi/testdata/orchard_spend.pczt`, the PCZT the Go
+//! tests of the PCZT signer role sign end to end:
+//!
+//! ````
+//! cargo run -p frost-uniffi-sdk --example pczt_fixture
+//! ````
+//!
+//! Its Orchard bundle spends a note of the FROST group dealt from
+//! `ORCHARD_SPEND_AUTH_SECRET`, the secret of the Go orchard spend
+//! authorization fixture, next to a dummy spend that is already signed.
+use std::path::Path;
+
+use frost_uniffi_sdk::{trusted_dealer::trusted_dealer_keygen_from_configuration, Configuration};
+use incrementalmerkletree::{Hashable, Level};
+use orchard::{
+    keys::{FullViewingKey, Scope, SpendValidatingKey, SpendingKey},
+    note::{RandomSeed, Rho},
+    tree::{MerkleHashOrchard, MerklePath},
+    value::NoteValue,
+    Note, NOTE_COMMITMENT_TREE_DEPTH,
+};
+use pczt::roles::{creator::Creator, io_finalizer::IoFinalizer};
+use rand::rngs::OsRng;
+use zcash_primitives::transaction::{
+    builder::{BuildConfig, Builder, PcztResult},
+    fees::zip317,
+};
+use zcash_protocol::{consensus::BlockHeight, consensus::TEST_NETWORK, memo::MemoBytes};
+
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+
+const ORCHARD_SPEND_AUTH_SECRET: &str =
+    "0e2da4d6c0ee1e73e83d4e1c5f3a5bd2d6a8e55b53b02b7bb6c8d7a6f2e1c103";
+
+fn main() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: hex::decode(ORCHARD_SPEND_AUTH_SECRET).unwrap(),
+    };
+
+    let (pubkeys, _) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+
+    // the ak of the group, with the nk and rivk of an unrelated key
+    let ak = SpendValidatingKey::from_bytes(&hex::decode(&pubkeys.verifying_key).unwrap())
+        .expect("the verifying key of the group must be a valid ak");
+    let other_fvk = FullViewingKey::from(&SpendingKey::from_bytes([7; 32]).unwrap());
+    let fvk =
+        FullViewingKey::from_checked_parts(ak, *other_fvk.nk(), other_fvk.rivk(Scope::External));
+    let recipient = fvk.address_at(0u32, Scope::External);
+
+    let rho = Rho::from_bytes(&[0; 32]).unwrap();
+    let rseed = RandomSeed::from_bytes([1; 32], &rho).unwrap();
+    let note = Note::from_parts(recipient, NoteValue::from_raw(1_000_000), rho, rseed).unwrap();
+
+    // the note is the only leaf of the tree
+    let auth_path: [MerkleHashOrchard; NOTE_COMMITMENT_TREE_DEPTH] =
+        core::array::from_fn(|level| MerkleHashOrchard::empty_root(Level::from(level as u8)));
+    let merkle_path = MerklePath::from_parts(0, auth_path);
+    let anchor = merkle_path.root(note.commitment().into());
+
+    let mut builder = Builder::new(
+        TEST_NETWORK,
+        BlockHeight::from_u32(3_000_000),
+        BuildConfig::Standard {
+            sapling_anchor: None,
+            orchard_anchor: Some(anchor),
+        },
+    );
+    builder
+        .add_orchard_spend::<zip317::FeeRule>(fvk.clone(), note, merkle_path)
+        .unwrap();
+    builder
+        .add_orchard_output::<zip317::FeeRule>(None, recipient, 990_000, MemoBytes::empty())
+        .unwrap();
+
+    let PcztResult { pczt_parts, .. } = builder
+        .build_for_pczt(OsRng, &zip317::FeeRule::standard())
+        .unwrap();
+
+    let pczt = Creator::build_from_parts(pczt_parts).unwrap();
+    // signs the dummy spend
+    let pczt = IoFinalizer::new(pczt).finalize_io().unwrap();
+
+    let path =
+        Path::new(env!("CARGO_MANIFEST_DIR")).join("../frost_go_ffi/testdata/orchard_spend.pczt");
+    std::fs::create_dir_all(path.parent().unwrap()).unwrap();
+    std::fs::write(&path, pczt.serialize()).unwrap();
+
+    println!("wrote {}", path.display());
+}
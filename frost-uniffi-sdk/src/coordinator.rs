@@ -5,16 +5,14 @@ type E = reddsa::frost::redpallas::PallasBlake2b512;
 #[cfg(not(feature = "redpallas"))]
 type E = frost_ed25519::Ed25519Sha512;
 
-#[cfg(not(feature = "redpallas"))]
-use crate::participant::FrostSignatureShare;
-
-#[cfg(not(feature = "redpallas"))]
-use frost::round2::SignatureShare;
-
-use crate::{participant::FrostSigningCommitments, FrostPublicKeyPackage};
+use crate::{
+    participant::{FrostSignatureShare, FrostSigningCommitments},
+    FrostPublicKeyPackage, ParticipantIdentifier,
+};
 
 use frost::{
-    round1::SigningCommitments, Ciphersuite, Error, Identifier, Signature, SigningPackage,
+    round1::SigningCommitments, round2::SignatureShare, Ciphersuite, Error, Identifier, Signature,
+    SigningPackage, VerifyingKey,
 };
 use std::collections::BTreeMap;
 use uniffi;
@@ -29,7 +27,7 @@ pub struct Message {
     pub data: Vec<u8>,
 }
 
-#[derive(uniffi::Record)]
+#[derive(uniffi::Record, Clone)]
 pub struct FrostSignature {
     data: Vec<u8>,
 }
@@ -53,6 +51,34 @@ pub enum CoordinationError {
     #[cfg(feature = "redpallas")]
     #[error("An invalid Randomizer was provided.")]
     InvalidRandomizer,
+    /// A signature share failed validation during aggregation.
+    #[error("Signature share of participant {culprit:?} is invalid.")]
+    InvalidSignatureShare {
+        /// The identifier of the signer whose share validation failed.
+        culprit: ParticipantIdentifier,
+    },
+    /// The signer has no verifying share in the public key package.
+    #[error("Participant {identifier:?} is not part of the public key package.")]
+    UnknownParticipant { identifier: ParticipantIdentifier },
+}
+
+impl CoordinationError {
+    /// Maps an aggregation or signature share verification error, keeping
+    /// track of the participant that sent an invalid signature share when
+    /// frost identified one.
+    pub(crate) fn map_aggregation_err<C: Ciphersuite>(e: Error<C>) -> Self {
+        match e {
+            Error::InvalidSignatureShare { culprit } => {
+                match ParticipantIdentifier::from_identifier(culprit) {
+                    Ok(p) => Self::InvalidSignatureShare { culprit: p },
+                    Err(_) => Self::IdentifierDeserializationError,
+                }
+            }
+            e => Self::SignatureShareAggregationFailed {
+                message: e.to_string(),
+            },
+        }
+    }
 }
 
 #[uniffi::export]
@@ -60,7 +86,14 @@ pub fn new_signing_package(
     message: Message,
     commitments: Vec<FrostSigningCommitments>,
 ) -> Result<FrostSigningPackage, CoordinationError> {
-    let mut signing_commitments: BTreeMap<Identifier<E>, SigningCommitments<E>> = BTreeMap::new();
+    new_signing_package_for::<E>(message, commitments)
+}
+
+pub(crate) fn new_signing_package_for<C: Ciphersuite>(
+    message: Message,
+    commitments: Vec<FrostSigningCommitments>,
+) -> Result<FrostSigningPackage, CoordinationError> {
+    let mut signing_commitments: BTreeMap<Identifier<C>, SigningCommitments<C>> = BTreeMap::new();
 
     for c in commitments.into_iter() {
         let commitment = c
@@ -81,18 +114,41 @@ pub fn new_signing_package(
     Ok(serialized_package)
 }
 
+/// Returns the message that `signing_package` signs, so that a signer can
+/// check that it is the message it agreed to sign before using its nonces.
+#[uniffi::export]
+pub fn signing_package_message(
+    signing_package: FrostSigningPackage,
+) -> Result<Message, CoordinationError> {
+    let signing_package = signing_package
+        .to_signing_package::<E>()
+        .map_err(|_| CoordinationError::SigningPackageSerializationError)?;
+
+    Ok(Message {
+        data: signing_package.message().to_vec(),
+    })
+}
+
 #[cfg(not(feature = "redpallas"))]
 #[uniffi::export]
 pub fn aggregate(
     signing_package: FrostSigningPackage,
     signature_shares: Vec<FrostSignatureShare>,
     pubkey_package: FrostPublicKeyPackage,
+) -> Result<FrostSignature, CoordinationError> {
+    aggregate_for::<E>(signing_package, signature_shares, pubkey_package)
+}
+
+pub(crate) fn aggregate_for<C: Ciphersuite>(
+    signing_package: FrostSigningPackage,
+    signature_shares: Vec<FrostSignatureShare>,
+    pubkey_package: FrostPublicKeyPackage,
 ) -> Result<FrostSignature, CoordinationError> {
     let signing_package = signing_package
         .to_signing_package()
         .map_err(|_| CoordinationError::FailedToCreateSigningPackage)?;
 
-    let mut shares: BTreeMap<Identifier<E>, SignatureShare<E>> = BTreeMap::new();
+    let mut shares: BTreeMap<Identifier<C>, SignatureShare<C>> = BTreeMap::new();
 
     for share in signature_shares {
         shares.insert(
@@ -101,21 +157,17 @@ pub fn aggregate(
                 .into_identifier()
                 .map_err(|_| CoordinationError::IdentifierDeserializationError)?,
             share
-                .to_signature_share::<E>()
+                .to_signature_share::<C>()
                 .map_err(|_| CoordinationError::SignatureShareDeserializationError)?,
         );
     }
 
     let public_key_package = pubkey_package
-        .into_public_key_package()
+        .to_public_key_package::<C>()
         .map_err(|_| CoordinationError::PublicKeyPackageDeserializationError)?;
 
-    let signature =
-        frost::aggregate(&signing_package, &shares, &public_key_package).map_err(|e| {
-            CoordinationError::SignatureShareAggregationFailed {
-                message: e.to_string(),
-            }
-        })?;
+    let signature = frost::aggregate(&signing_package, &shares, &public_key_package)
+        .map_err(CoordinationError::map_aggregation_err)?;
 
     Ok(FrostSignature {
         data: signature.serialize().map_err(|e| {
@@ -126,12 +178,65 @@ pub fn aggregate(
     })
 }
 
+/// Verifies a single signature share against the verifying share of its
+/// signer, so that a coordinator can reject it before aggregation.
+#[cfg(not(feature = "redpallas"))]
+#[uniffi::export]
+pub fn verify_signature_share(
+    signing_package: FrostSigningPackage,
+    signature_share: FrostSignatureShare,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), CoordinationError> {
+    verify_signature_share_for::<E>(signing_package, signature_share, pubkey_package)
+}
+
+pub(crate) fn verify_signature_share_for<C: Ciphersuite>(
+    signing_package: FrostSigningPackage,
+    signature_share: FrostSignatureShare,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), CoordinationError> {
+    let signing_package = signing_package
+        .to_signing_package()
+        .map_err(|_| CoordinationError::FailedToCreateSigningPackage)?;
+
+    let identifier = signature_share
+        .identifier
+        .into_identifier::<C>()
+        .map_err(|_| CoordinationError::IdentifierDeserializationError)?;
+
+    let share = signature_share
+        .to_signature_share::<C>()
+        .map_err(|_| CoordinationError::SignatureShareDeserializationError)?;
+
+    let public_key_package = pubkey_package
+        .to_public_key_package::<C>()
+        .map_err(|_| CoordinationError::PublicKeyPackageDeserializationError)?;
+
+    let verifying_share = public_key_package
+        .verifying_shares()
+        .get(&identifier)
+        .ok_or(CoordinationError::UnknownParticipant {
+            identifier: signature_share.identifier,
+        })?;
+
+    frost::verify_signature_share(
+        identifier,
+        verifying_share,
+        &share,
+        &signing_package,
+        public_key_package.verifying_key(),
+    )
+    .map_err(CoordinationError::map_aggregation_err)
+}
+
 #[derive(Debug, uniffi::Error, thiserror::Error)]
 pub enum FrostSignatureVerificationError {
     #[error("Public Key Package is invalid")]
     InvalidPublicKeyPackage,
     #[error("FROST signature is invalid. Reason: {reason:?}")]
     ValidationFailed { reason: String },
+    #[error("Verifying key is invalid")]
+    InvalidVerifyingKey,
 }
 
 #[uniffi::export]
@@ -140,14 +245,22 @@ pub fn verify_signature(
     signature: FrostSignature,
     pubkey: FrostPublicKeyPackage,
 ) -> Result<(), FrostSignatureVerificationError> {
-    let signature = signature.to_signature::<E>().map_err(|e| {
+    verify_signature_for::<E>(message, signature, pubkey)
+}
+
+pub(crate) fn verify_signature_for<C: Ciphersuite>(
+    message: Message,
+    signature: FrostSignature,
+    pubkey: FrostPublicKeyPackage,
+) -> Result<(), FrostSignatureVerificationError> {
+    let signature = signature.to_signature::<C>().map_err(|e| {
         FrostSignatureVerificationError::ValidationFailed {
             reason: e.to_string(),
         }
     })?;
 
     let pubkey = pubkey
-        .into_public_key_package()
+        .to_public_key_package::<C>()
         .map_err(|_| FrostSignatureVerificationError::InvalidPublicKeyPackage)?;
 
     pubkey
@@ -158,12 +271,50 @@ pub fn verify_signature(
         })
 }
 
+/// Verifies a signature of `message` made by `sign_with_signing_share`
+/// against the verifying share of `identifier` in `pubkey_package`.
+#[uniffi::export]
+pub fn verify_signature_with_verifying_share(
+    message: Message,
+    signature: FrostSignature,
+    identifier: ParticipantIdentifier,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), FrostSignatureVerificationError> {
+    verify_signature_with_verifying_share_for::<E>(message, signature, identifier, pubkey_package)
+}
+
+pub(crate) fn verify_signature_with_verifying_share_for<C: Ciphersuite>(
+    message: Message,
+    signature: FrostSignature,
+    identifier: ParticipantIdentifier,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), FrostSignatureVerificationError> {
+    let signature = signature.to_signature::<C>().map_err(|e| {
+        FrostSignatureVerificationError::ValidationFailed {
+            reason: e.to_string(),
+        }
+    })?;
+
+    let public_key_package = pubkey_package
+        .to_public_key_package::<C>()
+        .map_err(|_| FrostSignatureVerificationError::InvalidPublicKeyPackage)?;
+
+    let verifying_share = identifier
+        .into_identifier::<C>()
+        .ok()
+        .and_then(|identifier| public_key_package.verifying_shares().get(&identifier))
+        .ok_or(FrostSignatureVerificationError::InvalidVerifyingKey)?;
+
+    VerifyingKey::<C>::new(verifying_share.to_element())
+        .verify(&message.data, &signature)
+        .map_err(|e| FrostSignatureVerificationError::ValidationFailed {
+            reason: e.to_string(),
+        })
+}
+
 impl FrostSignature {
-    pub fn to_signature<C: Ciphersuite>(&self) -> Result<Signature<E>, Error<E>> {
-        let bytes: [u8; 64] = self.data[0..64]
-            .try_into()
-            .map_err(|_| Error::DeserializationError)?;
-        Signature::<E>::deserialize(&bytes)
+    pub fn to_signature<C: Ciphersuite>(&self) -> Result<Signature<C>, Error<C>> {
+        Signature::<C>::deserialize(&self.data)
     }
 
     pub fn from_signature<C: Ciphersuite>(
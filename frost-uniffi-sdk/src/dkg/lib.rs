@@ -17,6 +17,7 @@ use frost::{
 use rand::thread_rng;
 
 use std::{
+    any::Any,
     collections::{BTreeMap, HashMap},
     sync::Arc,
 };
@@ -45,7 +46,7 @@ pub struct DKGRound2Package {
 }
 
 impl DKGRound2Package {
-    fn from_package<C: Ciphersuite>(
+    pub(crate) fn from_package<C: Ciphersuite>(
         identifier: ParticipantIdentifier,
         package: round2::Package<C>,
     ) -> Result<DKGRound2Package, Error<C>> {
@@ -55,7 +56,7 @@ impl DKGRound2Package {
         })
     }
 
-    fn to_package<C: Ciphersuite>(&self) -> Result<round2::Package<C>, Error<C>> {
+    pub(crate) fn to_package<C: Ciphersuite>(&self) -> Result<round2::Package<C>, Error<C>> {
         round2::Package::deserialize(&self.data)
     }
 }
@@ -66,22 +67,93 @@ pub struct DKGPart1Result {
     pub package: DKGRound1Package,
 }
 
+#[uniffi::export]
+impl DKGPart1Result {
+    /// The secret package to keep (secretly) until part 2.
+    pub fn secret(&self) -> Arc<DKGRound1SecretPackage> {
+        Arc::new(self.secret.clone())
+    }
+
+    /// The round 1 package to broadcast to every other participant.
+    pub fn package(&self) -> DKGRound1Package {
+        self.package.clone()
+    }
+}
+
+#[uniffi::export]
+impl DKGPart2Result {
+    /// The secret package to keep (secretly) until part 3.
+    pub fn secret(&self) -> Arc<DKGRound2SecretPackage> {
+        Arc::new(self.secret.clone())
+    }
+
+    /// The round 2 packages, each one to be sent privately to the
+    /// participant it is addressed to.
+    pub fn packages(&self) -> Vec<DKGRound2Package> {
+        self.packages.clone()
+    }
+}
+
+// The secret packages hold the one of any ciphersuite, so that the same
+// objects can be used for every ciphersuite this library exports.
 #[derive(uniffi::Object, Clone)]
 pub struct DKGRound2SecretPackage {
-    data: round2::SecretPackage<E>,
+    data: Arc<dyn Any + Send + Sync>,
+}
+
+impl DKGRound2SecretPackage {
+    pub(crate) fn from_secret_package<C: Ciphersuite>(
+        secret_package: round2::SecretPackage<C>,
+    ) -> DKGRound2SecretPackage
+    where
+        round2::SecretPackage<C>: Any + Send + Sync,
+    {
+        DKGRound2SecretPackage {
+            data: Arc::new(secret_package),
+        }
+    }
+
+    /// Fails if the secret package is the one of another ciphersuite.
+    pub(crate) fn to_secret_package<C: Ciphersuite>(
+        &self,
+    ) -> Result<round2::SecretPackage<C>, FrostError>
+    where
+        round2::SecretPackage<C>: Any,
+    {
+        self.data
+            .downcast_ref::<round2::SecretPackage<C>>()
+            .cloned()
+            .ok_or(FrostError::DeserializationError)
+    }
 }
 
 #[derive(uniffi::Object, Clone)]
 pub struct DKGRound1SecretPackage {
-    data: SecretPackage<E>,
+    data: Arc<dyn Any + Send + Sync>,
 }
 
 impl DKGRound1SecretPackage {
-    fn from_secret_package(secret_package: SecretPackage<E>) -> DKGRound1SecretPackage {
+    pub(crate) fn from_secret_package<C: Ciphersuite>(
+        secret_package: SecretPackage<C>,
+    ) -> DKGRound1SecretPackage
+    where
+        SecretPackage<C>: Any + Send + Sync,
+    {
         DKGRound1SecretPackage {
-            data: secret_package,
+            data: Arc::new(secret_package),
         }
     }
+
+    /// Fails if the secret package is the one of another ciphersuite.
+    pub(crate) fn to_secret_package<C: Ciphersuite>(&self) -> Result<SecretPackage<C>, FrostError>
+    where
+        SecretPackage<C>: Any,
+    {
+        self.data
+            .downcast_ref::<SecretPackage<C>>()
+            .cloned()
+            .ok_or(FrostError::DeserializationError)
+    }
 }
 
 #[derive(uniffi::Record, Clone)]
@@ -91,11 +163,11 @@ pub struct DKGRound1Package {
 }
 
 impl DKGRound1Package {
-    fn to_package<C: Ciphersuite>(&self) -> Result<Package<C>, Error<C>> {
+    pub(crate) fn to_package<C: Ciphersuite>(&self) -> Result<Package<C>, Error<C>> {
         Package::deserialize(&self.data)
     }
 
-    fn from_package<C: Ciphersuite>(
+    pub(crate) fn from_package<C: Ciphersuite>(
         identifier: ParticipantIdentifier,
         package: Package<C>,
     ) -> Result<DKGRound1Package, Error<C>> {
@@ -112,8 +184,19 @@ pub fn part_1(
     max_signers: u16,
     min_signers: u16,
 ) -> Result<Arc<DKGPart1Result>, FrostError> {
+    part_1_for::<E>(participant_identifier, max_signers, min_signers)
+}
+
+pub(crate) fn part_1_for<C: Ciphersuite>(
+    participant_identifier: ParticipantIdentifier,
+    max_signers: u16,
+    min_signers: u16,
+) -> Result<Arc<DKGPart1Result>, FrostError>
+where
+    SecretPackage<C>: Any + Send + Sync,
+{
     let identifier = participant_identifier
-        .into_identifier()
+        .into_identifier::<C>()
         .map_err(FrostError::map_err)?;
     let rng = thread_rng();
     let part_one = part1(identifier, max_signers, min_signers, rng).map_err(FrostError::map_err)?;
@@ -124,6 +207,61 @@ pub fn part_1(
 
     Ok(Arc::new(DKGPart1Result { secret, package }))
 }
+
+/// Serializes the secret package of round 1 to JSON so that a
+/// participant can checkpoint a DKG and resume it after a restart.
+///
+/// **This is secret material.** It must be stored encrypted and
+/// deleted once the DKG is completed.
+#[uniffi::export]
+pub fn dkg_round1_secret_package_to_json(
+    secret_package: Arc<DKGRound1SecretPackage>,
+) -> Result<String, FrostError> {
+    serde_json::to_string(&secret_package.to_secret_package::<E>()?)
+        .map_err(|_| FrostError::SerializationError)
+}
+
+/// Restores a secret package of round 1 serialized with
+/// `dkg_round1_secret_package_to_json`.
+#[uniffi::export]
+pub fn json_to_dkg_round1_secret_package(
+    secret_package_json: String,
+) -> Result<Arc<DKGRound1SecretPackage>, FrostError> {
+    let secret_package: SecretPackage<E> =
+        serde_json::from_str(&secret_package_json).map_err(|_| FrostError::DeserializationError)?;
+
+    Ok(Arc::new(DKGRound1SecretPackage::from_secret_package(
+        secret_package,
+    )))
+}
+
+/// Serializes the secret package of round 2 to JSON so that a
+/// participant can checkpoint a DKG and resume it after a restart.
+///
+/// **This is secret material.** It must be stored encrypted and
+/// deleted once the DKG is completed.
+#[uniffi::export]
+pub fn dkg_round2_secret_package_to_json(
+    secret_package: Arc<DKGRound2SecretPackage>,
+) -> Result<String, FrostError> {
+    serde_json::to_string(&secret_package.to_secret_package::<E>()?)
+        .map_err(|_| FrostError::SerializationError)
+}
+
+/// Restores a secret package of round 2 serialized with
+/// `dkg_round2_secret_package_to_json`.
+#[uniffi::export]
+pub fn json_to_dkg_round2_secret_package(
+    secret_package_json: String,
+) -> Result<Arc<DKGRound2SecretPackage>, FrostError> {
+    let secret_package: round2::SecretPackage<E> =
+        serde_json::from_str(&secret_package_json).map_err(|_| FrostError::DeserializationError)?;
+
+    Ok(Arc::new(DKGRound2SecretPackage::from_secret_package(
+        secret_package,
+    )))
+}
+
 /// DKG Part 2
 /// receives a SecretPackage from round one generated by the same
 /// participant and kept in-memory (and secretly) until now.
@@ -142,9 +280,20 @@ pub fn part_2(
     secret_package: Arc<DKGRound1SecretPackage>,
     round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
 ) -> Result<Arc<DKGPart2Result>, FrostError> {
-    let secret_package = secret_package.data.clone();
+    part_2_for::<E>(secret_package, round1_packages)
+}
+
+pub(crate) fn part_2_for<C: Ciphersuite>(
+    secret_package: Arc<DKGRound1SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+) -> Result<Arc<DKGPart2Result>, FrostError>
+where
+    SecretPackage<C>: Any,
+    round2::SecretPackage<C>: Any + Send + Sync,
+{
+    let secret_package = secret_package.to_secret_package::<C>()?;
 
-    let mut packages: BTreeMap<Identifier<E>, Package<E>> = BTreeMap::new();
+    let mut packages: BTreeMap<Identifier<C>, Package<C>> = BTreeMap::new();
 
     for (id, pkg) in round1_packages.into_iter() {
         let package = pkg
@@ -164,7 +313,7 @@ pub fn part_2(
 
     let mut packages: Vec<DKGRound2Package> = Vec::new();
 
-    let secret = DKGRound2SecretPackage { data: secret };
+    let secret = DKGRound2SecretPackage::from_secret_package(secret);
 
     for pkg in round2_packages.into_iter() {
         let identifier = ParticipantIdentifier::from_identifier(pkg.0)
@@ -185,9 +334,20 @@ pub fn part_3(
     round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
     round2_packages: HashMap<ParticipantIdentifier, DKGRound2Package>,
 ) -> Result<DKGPart3Result, FrostError> {
-    let secret_package = secret_package.data.clone();
+    part_3_for::<E>(secret_package, round1_packages, round2_packages)
+}
+
+pub(crate) fn part_3_for<C: Ciphersuite>(
+    secret_package: Arc<DKGRound2SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+    round2_packages: HashMap<ParticipantIdentifier, DKGRound2Package>,
+) -> Result<DKGPart3Result, FrostError>
+where
+    round2::SecretPackage<C>: Any,
+{
+    let secret_package = secret_package.to_secret_package::<C>()?;
 
-    let mut round1_pkg: BTreeMap<Identifier<E>, Package<E>> = BTreeMap::new();
+    let mut round1_pkg: BTreeMap<Identifier<C>, Package<C>> = BTreeMap::new();
 
     for (id, pkg) in round1_packages.into_iter() {
         let package = pkg
@@ -199,7 +359,7 @@ pub fn part_3(
         round1_pkg.insert(identifier, package);
     }
 
-    let mut round2_pkg: BTreeMap<Identifier<E>, round2::Package<E>> = BTreeMap::new();
+    let mut round2_pkg: BTreeMap<Identifier<C>, round2::Package<C>> = BTreeMap::new();
 
     for (id, pkg) in round2_packages.into_iter() {
         let package = pkg
@@ -0,0 +1,106 @@
+// Ed25519 FROST, exported next to the ciphersuite this library is built
+// with, so that a single build can sign Ed25519 messages alongside
+// (re-randomized) RedPallas ones.
+//
+// The records are the same as the ones of the main API but hold Ed25519
+// values: they can't be mixed with the ones of another ciphersuite.
+use crate::{
+    coordinator::{
+        aggregate_for, new_signing_package_for, verify_signature_for, verify_signature_share_for,
+        CoordinationError, FrostSignature, FrostSignatureVerificationError, FrostSigningPackage,
+        Message,
+    },
+    participant::{
+        generate_nonces_and_commitments_for, sign_for, FirstRoundCommitment, FrostSignatureShare,
+        FrostSigningCommitments, FrostSigningNonces, Round1Error, Round2Error,
+    },
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, FrostError, FrostKeyPackage, FrostPublicKeyPackage, FrostSecretKeyShare,
+    TrustedKeyGeneration,
+};
+
+type Ed25519 = frost_ed25519::Ed25519Sha512;
+
+/// Ed25519 counterpart of `trusted_dealer_keygen_from`.
+#[uniffi::export]
+pub fn ed25519_trusted_dealer_keygen_from(
+    configuration: Configuration,
+) -> Result<TrustedKeyGeneration, FrostError> {
+    let (pubkey, secret_shares) =
+        trusted_dealer_keygen_from_configuration::<Ed25519>(&configuration)
+            .map_err(FrostError::map_err)?;
+
+    Ok(TrustedKeyGeneration {
+        public_key_package: pubkey,
+        secret_shares,
+    })
+}
+
+/// Ed25519 counterpart of `verify_and_get_key_package_from`.
+#[uniffi::export]
+pub fn ed25519_verify_and_get_key_package_from(
+    secret_share: FrostSecretKeyShare,
+) -> Result<FrostKeyPackage, FrostError> {
+    secret_share
+        .into_key_package::<Ed25519>()
+        .map_err(|_| FrostError::InvalidSecretKey)
+}
+
+/// Ed25519 counterpart of `generate_nonces_and_commitments`.
+#[uniffi::export]
+pub fn ed25519_generate_nonces_and_commitments(
+    key_package: FrostKeyPackage,
+) -> Result<FirstRoundCommitment, Round1Error> {
+    generate_nonces_and_commitments_for::<Ed25519>(key_package)
+}
+
+/// Ed25519 counterpart of `new_signing_package`.
+#[uniffi::export]
+pub fn ed25519_new_signing_package(
+    message: Message,
+    commitments: Vec<FrostSigningCommitments>,
+) -> Result<FrostSigningPackage, CoordinationError> {
+    new_signing_package_for::<Ed25519>(message, commitments)
+}
+
+/// Ed25519 signing. Unlike RedPallas signing, it takes no randomizer.
+#[uniffi::export]
+pub fn ed25519_sign(
+    signing_package: FrostSigningPackage,
+    nonces: FrostSigningNonces,
+    key_package: FrostKeyPackage,
+) -> Result<FrostSignatureShare, Round2Error> {
+    sign_for::<Ed25519>(signing_package, nonces, key_package)
+}
+
+/// Ed25519 aggregation. Unlike RedPallas aggregation, it takes no
+/// randomizer.
+#[uniffi::export]
+pub fn ed25519_aggregate(
+    signing_package: FrostSigningPackage,
+    signature_shares: Vec<FrostSignatureShare>,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<FrostSignature, CoordinationError> {
+    aggregate_for::<Ed25519>(signing_package, signature_shares, pubkey_package)
+}
+
+/// Verifies a single Ed25519 signature share against the verifying share
+/// of its signer, so that a coordinator can reject it before aggregation.
+#[uniffi::export]
+pub fn ed25519_verify_signature_share(
+    signing_package: FrostSigningPackage,
+    signature_share: FrostSignatureShare,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), CoordinationError> {
+    verify_signature_share_for::<Ed25519>(signing_package, signature_share, pubkey_package)
+}
+
+/// Ed25519 counterpart of `verify_signature`.
+#[uniffi::export]
+pub fn ed25519_verify_signature(
+    message: Message,
+    signature: FrostSignature,
+    pubkey: FrostPublicKeyPackage,
+) -> Result<(), FrostSignatureVerificationError> {
+    verify_signature_for::<Ed25519>(message, signature, pubkey)
+}
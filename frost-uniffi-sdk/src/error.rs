@@ -23,7 +23,7 @@ impl FrostError {
             Error::IncorrectNumberOfCommitments => Self::IncorrectNumberOfCommitments,
             Error::InvalidSignatureShare { culprit } => {
                 match ParticipantIdentifier::from_identifier(culprit) {
-                    Ok(p) => Self::InvalidProofOfKnowledge { culprit: p },
+                    Ok(p) => Self::InvalidSignatureShare { culprit: p },
                     Err(_) => Self::MalformedIdentifier,
                 }
             }
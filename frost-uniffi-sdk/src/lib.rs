@@ -4,11 +4,17 @@ type E = frost_ed25519::Ed25519Sha512;
 type E = reddsa::frost::redpallas::PallasBlake2b512;
 pub mod coordinator;
 pub mod dkg;
+pub mod ed25519;
 pub mod error;
 pub mod orchard;
 pub mod participant;
 #[cfg(feature = "redpallas")]
 pub mod randomized;
+pub mod reconstruct;
+pub mod refresh;
+pub mod repair;
+pub mod reshare;
+pub mod secp256k1_tr;
 pub mod serialization;
 pub mod trusted_dealer;
 use crate::trusted_dealer::{trusted_dealer_keygen, trusted_dealer_keygen_from_configuration};
@@ -390,27 +396,25 @@ impl FrostPublicKeyPackage {
     }
 
     pub fn into_public_key_package(&self) -> Result<PublicKeyPackage<E>, Error<E>> {
+        self.to_public_key_package::<E>()
+    }
+
+    pub fn to_public_key_package<C: Ciphersuite>(&self) -> Result<PublicKeyPackage<C>, Error<C>> {
         let raw_verifying_key =
             hex::decode(self.verifying_key.clone()).map_err(|_| Error::DeserializationError)?;
 
-        let verifying_key_bytes = raw_verifying_key[0..32]
-            .try_into()
+        // verifying keys and shares don't have the same length for every
+        // ciphersuite
+        let verifying_key = VerifyingKey::deserialize(&raw_verifying_key)
             .map_err(|_| Error::DeserializationError)?;
 
-        let verifying_key = VerifyingKey::deserialize(verifying_key_bytes)
-            .map_err(|_| Error::DeserializationError)?;
-
-        let mut btree_map: BTreeMap<Identifier<E>, VerifyingShare<E>> = BTreeMap::new();
+        let mut btree_map: BTreeMap<Identifier<C>, VerifyingShare<C>> = BTreeMap::new();
         for (k, v) in self.verifying_shares.clone() {
             let identifier = k.into_identifier()?;
 
             let raw_verifying_share = hex::decode(v).map_err(|_| Error::DeserializationError)?;
 
-            let verifying_share_bytes: [u8; 32] = raw_verifying_share[0..32]
-                .try_into()
-                .map_err(|_| Error::DeserializationError)?;
-
-            let verifying_share = VerifyingShare::deserialize(&verifying_share_bytes)?;
+            let verifying_share = VerifyingShare::deserialize(&raw_verifying_share)?;
 
             btree_map.insert(identifier, verifying_share);
         }
@@ -108,6 +108,18 @@ impl OrchardKeyParts {
             rivk: rivk.to_bytes().to_vec(),
         }))
     }
+
+    /// Returns the [`OrchardNullifierDerivingKey`] `nk` of these parts to
+    /// back it up along with the key shares of the group.
+    pub fn nk(&self) -> Result<Arc<OrchardNullifierDerivingKey>, OrchardKeyError> {
+        OrchardNullifierDerivingKey::new(self.nk.clone())
+    }
+
+    /// Returns the [`OrchardCommitIvkRandomness`] `rivk` of these parts to
+    /// back it up along with the key shares of the group.
+    pub fn rivk(&self) -> Result<Arc<OrchardCommitIvkRandomness>, OrchardKeyError> {
+        OrchardCommitIvkRandomness::new(self.rivk.clone())
+    }
 }
 
 /// An Zcash Orchard Address and its associated network type.
@@ -1,2 +1,6 @@
 mod keys;
 pub use self::keys::*;
+#[cfg(feature = "redpallas")]
+mod pczt_signer;
+#[cfg(feature = "redpallas")]
+pub use self::pczt_signer::*;
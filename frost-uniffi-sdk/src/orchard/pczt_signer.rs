@@ -0,0 +1,137 @@
+use orchard::primitives::redpallas::{Signature, SpendAuth};
+use pczt::{roles::signer::Signer, Pczt};
+use uniffi;
+
+use crate::orchard::OrchardKeyError;
+
+/// An Orchard action of a PCZT as seen by its signers: its `index` in the
+/// Orchard bundle, the randomized verifying key `rk` of its spend, the
+/// spend authorization randomizer `alpha` when the PCZT carries it, and
+/// its `spend_auth_sig` once signed.
+#[derive(uniffi::Record, Clone)]
+pub struct PcztOrchardAction {
+    pub index: u32,
+    pub rk: Vec<u8>,
+    pub alpha: Option<Vec<u8>>,
+    pub spend_auth_sig: Option<Vec<u8>>,
+}
+
+/// The Orchard spends of a PCZT to authorize: the 32-byte shielded sighash
+/// of the transaction and every action of its Orchard bundle.
+#[derive(uniffi::Record, Clone)]
+pub struct PcztOrchardSpendAuth {
+    pub sighash: Vec<u8>,
+    pub actions: Vec<PcztOrchardAction>,
+}
+
+/// Parses a PCZT (partially created Zcash transaction) handed to the signer
+/// role and returns what its Orchard spend authorization signatures are
+/// computed over.
+///
+/// Returns [`OrchardKeyError::DeserializationError`] when `pczt` can't be
+/// parsed and [`OrchardKeyError::OtherError`] when it isn't ready to be
+/// signed, e.g. because it lacks the data the sighash commits to.
+#[uniffi::export]
+pub fn orchard_spend_auth_from_pczt(
+    pczt: Vec<u8>,
+) -> Result<PcztOrchardSpendAuth, OrchardKeyError> {
+    let pczt = Pczt::parse(&pczt).map_err(|_| OrchardKeyError::DeserializationError)?;
+
+    let actions = pczt
+        .orchard()
+        .actions()
+        .iter()
+        .enumerate()
+        .map(|(index, action)| PcztOrchardAction {
+            index: index as u32,
+            rk: action.spend().rk().to_vec(),
+            alpha: action.spend().alpha().as_ref().map(|alpha| alpha.to_vec()),
+            spend_auth_sig: action
+                .spend()
+                .spend_auth_sig()
+                .as_ref()
+                .map(|signature| signature.to_vec()),
+        })
+        .collect();
+
+    let signer = Signer::new(pczt).map_err(signer_error)?;
+
+    Ok(PcztOrchardSpendAuth {
+        sighash: signer.shielded_sighash().to_vec(),
+        actions,
+    })
+}
+
+/// Writes the `spend_auth_sig` of each of `actions` that has one in the
+/// Orchard action of `pczt` at its `index`, and returns the updated PCZT.
+///
+/// The `rk` of each action must be the one of the action of `pczt` at that
+/// index and its signature must verify against it, otherwise
+/// [`OrchardKeyError::OtherError`] is returned and no signature is written.
+#[uniffi::export]
+pub fn pczt_with_orchard_spend_auth_signatures(
+    pczt: Vec<u8>,
+    actions: Vec<PcztOrchardAction>,
+) -> Result<Vec<u8>, OrchardKeyError> {
+    let pczt = Pczt::parse(&pczt).map_err(|_| OrchardKeyError::DeserializationError)?;
+
+    for action in actions.iter() {
+        let same_rk = pczt
+            .orchard()
+            .actions()
+            .get(action.index as usize)
+            .is_some_and(|pczt_action| pczt_action.spend().rk()[..] == action.rk[..]);
+
+        if !same_rk {
+            return Err(OrchardKeyError::OtherError {
+                error_message: format!("rk of action {} doesn't match the PCZT", action.index),
+            });
+        }
+    }
+
+    let mut signer = Signer::new(pczt).map_err(signer_error)?;
+
+    for action in actions.iter() {
+        let Some(spend_auth_sig) = &action.spend_auth_sig else {
+            continue;
+        };
+
+        let signature: [u8; 64] = spend_auth_sig[..]
+            .try_into()
+            .map_err(|_| OrchardKeyError::DeserializationError)?;
+
+        signer
+            .apply_orchard_signature(
+                action.index as usize,
+                Signature::<SpendAuth>::from(signature),
+            )
+            .map_err(signer_error)?;
+    }
+
+    Ok(signer.finish().serialize())
+}
+
+fn signer_error(e: pczt::roles::signer::Error) -> OrchardKeyError {
+    OrchardKeyError::OtherError {
+        error_message: format!("{:?}", e),
+    }
+}
+
+#[cfg(test)]
+mod tests {
+    use super::{orchard_spend_auth_from_pczt, pczt_with_orchard_spend_auth_signatures};
+    use crate::orchard::OrchardKeyError;
+
+    #[test]
+    fn test_malformed_pczt_is_rejected() {
+        assert!(matches!(
+            orchard_spend_auth_from_pczt(b"PCZT".to_vec()),
+            Err(OrchardKeyError::DeserializationError)
+        ));
+
+        assert!(matches!(
+            pczt_with_orchard_spend_auth_signatures(vec![0; 64], vec![]),
+            Err(OrchardKeyError::DeserializationError)
+        ));
+    }
+}
@@ -3,7 +3,7 @@ use frost_core as frost;
 use frost::{
     round1::{SigningCommitments, SigningNonces},
     round2::SignatureShare,
-    Ciphersuite, Error, Identifier,
+    Ciphersuite, Error, Identifier, SigningKey,
 };
 
 #[cfg(feature = "redpallas")]
@@ -16,8 +16,7 @@ use uniffi;
 
 use crate::{FrostKeyPackage, ParticipantIdentifier};
 
-#[cfg(not(feature = "redpallas"))]
-use crate::coordinator::FrostSigningPackage;
+use crate::coordinator::{FrostSignature, FrostSigningPackage, Message};
 
 #[derive(uniffi::Record, Clone)]
 pub struct FrostSigningNonces {
@@ -95,11 +94,17 @@ pub struct FirstRoundCommitment {
 #[uniffi::export]
 pub fn generate_nonces_and_commitments(
     key_package: FrostKeyPackage,
+) -> Result<FirstRoundCommitment, Round1Error> {
+    generate_nonces_and_commitments_for::<E>(key_package)
+}
+
+pub(crate) fn generate_nonces_and_commitments_for<C: Ciphersuite>(
+    key_package: FrostKeyPackage,
 ) -> Result<FirstRoundCommitment, Round1Error> {
     let mut rng = thread_rng();
 
     let key_package = key_package
-        .into_key_package::<E>()
+        .into_key_package::<C>()
         .map_err(|_| Round1Error::InvalidKeyPackage)?;
 
     let signing_share = key_package.signing_share();
@@ -116,20 +121,19 @@ pub fn generate_nonces_and_commitments(
     })
 }
 
-#[derive(uniffi::Record)]
+#[derive(uniffi::Record, Clone)]
 pub struct FrostSignatureShare {
     pub identifier: ParticipantIdentifier,
     pub data: Vec<u8>,
 }
 
 impl FrostSignatureShare {
-    pub fn to_signature_share<C: Ciphersuite>(&self) -> Result<SignatureShare<E>, Error<E>> {
+    pub fn to_signature_share<C: Ciphersuite>(&self) -> Result<SignatureShare<C>, Error<C>> {
         let bytes: [u8; 32] = self.data[0..32]
             .try_into()
             .map_err(|_| Error::DeserializationError)?;
 
-        // TODO: Do not define the underlying curve inside the function
-        SignatureShare::<E>::deserialize(&bytes)
+        SignatureShare::<C>::deserialize(&bytes)
     }
 
     pub fn from_signature_share<C: Ciphersuite>(
@@ -149,9 +153,17 @@ pub fn sign(
     signing_package: FrostSigningPackage,
     nonces: FrostSigningNonces,
     key_package: FrostKeyPackage,
+) -> Result<FrostSignatureShare, Round2Error> {
+    sign_for::<E>(signing_package, nonces, key_package)
+}
+
+pub(crate) fn sign_for<C: Ciphersuite>(
+    signing_package: FrostSigningPackage,
+    nonces: FrostSigningNonces,
+    key_package: FrostKeyPackage,
 ) -> Result<FrostSignatureShare, Round2Error> {
     let signing_package = signing_package
-        .to_signing_package::<E>()
+        .to_signing_package::<C>()
         .map_err(|_| Round2Error::SigningPackageDeserializationError)?;
 
     let nonces = nonces
@@ -176,3 +188,34 @@ pub fn sign(
         }
     })
 }
+
+/// Signs `message` with the signing share of `key_package` alone, outside
+/// of a FROST signing session. The signature verifies against the
+/// verifying share of the participant with
+/// `verify_signature_with_verifying_share`, which lets a coordinator
+/// authenticate the requests of its signers.
+#[uniffi::export]
+pub fn sign_with_signing_share(
+    key_package: FrostKeyPackage,
+    message: Message,
+) -> Result<FrostSignature, Round2Error> {
+    sign_with_signing_share_for::<E>(key_package, message)
+}
+
+pub(crate) fn sign_with_signing_share_for<C: Ciphersuite>(
+    key_package: FrostKeyPackage,
+    message: Message,
+) -> Result<FrostSignature, Round2Error> {
+    let key_package = key_package
+        .into_key_package::<C>()
+        .map_err(|_| Round2Error::InvalidKeyPackage)?;
+
+    let signing_key = SigningKey::<C>::from_scalar(key_package.signing_share().to_scalar())
+        .map_err(|_| Round2Error::InvalidKeyPackage)?;
+
+    let signature = signing_key.sign(thread_rng(), &message.data);
+
+    FrostSignature::from_signature(signature).map_err(|e| Round2Error::SigningFailed {
+        message: e.to_string(),
+    })
+}
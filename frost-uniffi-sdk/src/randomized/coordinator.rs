@@ -19,7 +19,7 @@ use crate::{
     FrostPublicKeyPackage,
 };
 
-use frost::{round2::SignatureShare, Identifier};
+use frost::{keys::VerifyingShare, round2::SignatureShare, Identifier, VerifyingKey};
 use std::collections::BTreeMap;
 use uniffi;
 
@@ -65,14 +65,68 @@ pub fn aggregate(
         #[cfg(feature = "redpallas")]
         &FrostRandomizer::randomizer_params(randomizer, &public_key_package),
     )
-    .map_err(|e| CoordinationError::SignatureShareAggregationFailed {
-        message: e.to_string(),
-    })?;
+    .map_err(CoordinationError::map_aggregation_err)?;
 
     FrostSignature::from_signature(signature)
         .map_err(|_| CoordinationError::SigningPackageSerializationError)
 }
 
+/// Verifies a single signature share against the verifying share of its
+/// signer, re-randomized with the randomizer of the signing round, so that
+/// a coordinator can reject it before aggregation.
+#[cfg(feature = "redpallas")]
+#[uniffi::export]
+pub fn verify_signature_share(
+    signing_package: FrostSigningPackage,
+    signature_share: FrostSignatureShare,
+    pubkey_package: FrostPublicKeyPackage,
+    randomizer: FrostRandomizer,
+) -> Result<(), CoordinationError> {
+    let signing_package = signing_package
+        .to_signing_package()
+        .map_err(|_| CoordinationError::FailedToCreateSigningPackage)?;
+
+    let identifier = signature_share
+        .identifier
+        .into_identifier::<E>()
+        .map_err(|_| CoordinationError::IdentifierDeserializationError)?;
+
+    let share = signature_share
+        .to_signature_share::<E>()
+        .map_err(|_| CoordinationError::SignatureShareDeserializationError)?;
+
+    let public_key_package = pubkey_package
+        .into_public_key_package()
+        .map_err(|_| CoordinationError::PublicKeyPackageDeserializationError)?;
+
+    let randomizer = randomizer
+        .into_randomizer::<E>()
+        .map_err(|_| CoordinationError::InvalidRandomizer)?;
+
+    let verifying_share = public_key_package
+        .verifying_shares()
+        .get(&identifier)
+        .ok_or(CoordinationError::UnknownParticipant {
+            identifier: signature_share.identifier,
+        })?;
+
+    let randomized_params = FrostRandomizer::randomizer_params(randomizer, &public_key_package);
+
+    // signers sign with their signing share plus the randomizer, so their
+    // verifying share is offset by the randomizer element as well.
+    let randomized_verifying_share =
+        VerifyingShare::new(verifying_share.to_element() + *randomized_params.randomizer_element());
+
+    frost_core::verify_signature_share(
+        identifier,
+        &randomized_verifying_share,
+        &share,
+        &signing_package,
+        randomized_params.randomized_verifying_key(),
+    )
+    .map_err(CoordinationError::map_aggregation_err)
+}
+
 #[uniffi::export]
 pub fn verify_randomized_signature(
     randomizer: FrostRandomizer,
@@ -105,3 +159,28 @@ pub fn verify_randomized_signature(
             reason: e.to_string(),
         })
 }
+
+/// Verifies a signature of `message` against a randomized verifying key
+/// `rk` alone, such as the `rk` of a Zcash action, without the public key
+/// package of the group that signed it.
+#[uniffi::export]
+pub fn verify_signature_with_randomized_verifying_key(
+    randomized_verifying_key: Vec<u8>,
+    message: Message,
+    signature: FrostSignature,
+) -> Result<(), FrostSignatureVerificationError> {
+    let randomized_verifying_key = VerifyingKey::deserialize(&randomized_verifying_key)
+        .map_err(|_| FrostSignatureVerificationError::InvalidVerifyingKey)?;
+
+    let signature = signature.to_signature::<E>().map_err(|e| {
+        FrostSignatureVerificationError::ValidationFailed {
+            reason: e.to_string(),
+        }
+    })?;
+
+    randomized_verifying_key
+        .verify(&message.data, &signature)
+        .map_err(|e| FrostSignatureVerificationError::ValidationFailed {
+            reason: e.to_string(),
+        })
+}
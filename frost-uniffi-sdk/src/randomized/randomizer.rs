@@ -52,6 +52,51 @@ pub fn randomized_params_from_public_key_and_signing_package(
     Ok(Arc::new(r))
 }
 
+/// Creates the [`FrostRandomizedParams`] of `public_key` for a randomizer
+/// chosen by the caller instead of a random one, such as the one created
+/// by [`randomizer_from_bytes`] out of the `alpha` of a Zcash action.
+#[uniffi::export]
+pub fn randomized_params_from_public_key_and_randomizer(
+    public_key: FrostPublicKeyPackage,
+    randomizer: FrostRandomizer,
+) -> Result<Arc<FrostRandomizedParams>, FrostError> {
+    let public_key_package = public_key
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let randomizer = randomizer
+        .into_randomizer::<E>()
+        .map_err(FrostError::map_err)?;
+
+    Ok(Arc::new(FrostRandomizedParams {
+        params: FrostRandomizer::randomizer_params(randomizer, &public_key_package),
+    }))
+}
+
+#[uniffi::export]
+impl FrostRandomizedParams {
+    /// The randomized verifying key `rk` that the signatures created with
+    /// these params are verified against.
+    pub fn randomized_verifying_key(&self) -> Result<Vec<u8>, FrostError> {
+        self.params
+            .randomized_verifying_key()
+            .serialize()
+            .map_err(FrostError::map_err)
+    }
+
+    /// The randomized verifying key `rk`, hex-encoded like the verifying
+    /// key of a [`FrostPublicKeyPackage`].
+    pub fn randomized_verifying_key_hex(&self) -> Result<String, FrostError> {
+        Ok(hex::encode(self.randomized_verifying_key()?))
+    }
+
+    /// The randomizer these params were created with.
+    pub fn randomizer(&self) -> Result<FrostRandomizer, FrostError> {
+        FrostRandomizer::from_randomizer::<E>(*self.params.randomizer())
+            .map_err(FrostError::map_err)
+    }
+}
+
 #[uniffi::export]
 pub fn randomizer_from_params(
     randomized_params: Arc<FrostRandomizedParams>,
@@ -82,6 +127,23 @@ pub fn from_hex_string(hex_string: String) -> Result<FrostRandomizer, FrostError
     FrostRandomizer::from_randomizer::<E>(randomizer).map_err(FrostError::map_err)
 }
 
+/// Creates a [`FrostRandomizer`] out of a randomizer chosen by the caller,
+/// a 32-byte little-endian encoded scalar. Fails if it isn't a canonical
+/// scalar.
+///
+/// The randomizer of an Orchard action is its spend authorization
+/// randomizer `alpha`, chosen by the transaction builder: the randomized
+/// verifying key of a FROST group signing with it is the `rk` of the
+/// action and its signature is the `spendAuthSig` of the action. Each
+/// action of a bundle has its own `alpha` and needs its own signing round.
+#[cfg(feature = "redpallas")]
+#[uniffi::export]
+pub fn randomizer_from_bytes(bytes: Vec<u8>) -> Result<FrostRandomizer, FrostError> {
+    let randomizer = Randomizer::deserialize(&bytes).map_err(FrostError::map_err)?;
+
+    FrostRandomizer::from_randomizer::<E>(randomizer).map_err(FrostError::map_err)
+}
+
 impl FrostRandomizer {
     pub fn into_randomizer<C: Ciphersuite>(&self) -> Result<Randomizer, Error<E>> {
         Randomizer::deserialize(&self.data)
@@ -0,0 +1,70 @@
+use frost_core as frost;
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+use frost::{
+    keys::{reconstruct, KeyPackage, VerifyingShare},
+    Identifier, VerifyingKey,
+};
+
+use std::collections::BTreeSet;
+
+use crate::{FrostError, FrostKeyPackage, FrostPublicKeyPackage};
+
+/// Reconstructs the signing key of the group of `public_key_package` from
+/// the key packages of at least as many participants as required to sign.
+/// Each key package is checked against `public_key_package` and so is the
+/// reconstructed key. Returns the serialized signing key, which can be used
+/// as the `secret` of a `Configuration` to deal new shares of the group.
+///
+/// WARNING: the signing key is no longer protected by the threshold once
+/// reconstructed. This is only meant for disaster recovery, in an
+/// air-gapped environment.
+#[uniffi::export]
+pub fn reconstruct_signing_key(
+    key_packages: Vec<FrostKeyPackage>,
+    public_key_package: FrostPublicKeyPackage,
+) -> Result<Vec<u8>, FrostError> {
+    let public_key_package = public_key_package
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let mut identifiers: BTreeSet<Identifier<E>> = BTreeSet::new();
+    let mut secret_shares: Vec<KeyPackage<E>> = Vec::with_capacity(key_packages.len());
+    for key_package in key_packages.iter() {
+        let key_package: KeyPackage<E> = key_package
+            .into_key_package()
+            .map_err(|_| FrostError::DeserializationError)?;
+
+        if !identifiers.insert(*key_package.identifier()) {
+            return Err(FrostError::DuplicatedShares);
+        }
+
+        if key_package.verifying_key() != public_key_package.verifying_key() {
+            return Err(FrostError::InvalidKeyPackage);
+        }
+
+        // the signing share must be the one the group knows about
+        let verifying_share = VerifyingShare::from(*key_package.signing_share());
+        if public_key_package
+            .verifying_shares()
+            .get(key_package.identifier())
+            != Some(&verifying_share)
+        {
+            return Err(FrostError::InvalidKeyPackage);
+        }
+
+        secret_shares.push(key_package);
+    }
+
+    let signing_key = reconstruct(&secret_shares).map_err(FrostError::map_err)?;
+
+    if VerifyingKey::from(&signing_key) != *public_key_package.verifying_key() {
+        return Err(FrostError::InvalidSecretKey);
+    }
+
+    Ok(signing_key.serialize())
+}
@@ -0,0 +1,260 @@
+use frost_core::{self as frost, Ciphersuite};
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+use frost::{
+    keys::{
+        dkg::{round1, round2},
+        refresh::{
+            compute_refreshing_shares, refresh_dkg_part2, refresh_dkg_part_1, refresh_dkg_shares,
+            refresh_share,
+        },
+        KeyPackage,
+    },
+    Error, Identifier,
+};
+
+use rand::thread_rng;
+
+use std::{
+    collections::{BTreeMap, HashMap},
+    sync::Arc,
+};
+
+use crate::{
+    dkg::lib::{
+        DKGPart1Result, DKGPart2Result, DKGPart3Result, DKGRound1Package, DKGRound1SecretPackage,
+        DKGRound2Package, DKGRound2SecretPackage,
+    },
+    FrostError, FrostKeyPackage, FrostPublicKeyPackage, FrostSecretKeyShare, ParticipantIdentifier,
+    ParticipantList, TrustedKeyGeneration,
+};
+
+/// Trusted dealer refresh.
+/// Computes a refreshing share for each of the participants, which all
+/// hold a share of the group of `public_key_package`, along with the
+/// public key package the group will have once they applied them with
+/// `refresh_key_package`. The verifying key of the group doesn't change.
+///
+/// Refreshing shares are secret: each one must be sent privately to the
+/// participant it belongs to.
+#[uniffi::export]
+pub fn compute_refreshing_shares_from(
+    public_key_package: FrostPublicKeyPackage,
+    min_signers: u16,
+    participants: ParticipantList,
+) -> Result<TrustedKeyGeneration, FrostError> {
+    let public_key_package = public_key_package
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let mut identifiers: Vec<Identifier<E>> = Vec::with_capacity(participants.identifiers.len());
+    for identifier in participants.identifiers.iter() {
+        identifiers.push(
+            identifier
+                .into_identifier()
+                .map_err(|_| FrostError::MalformedIdentifier)?,
+        );
+    }
+
+    let max_signers =
+        u16::try_from(identifiers.len()).map_err(|_| FrostError::InvalidMaxSigners)?;
+
+    let mut rng = thread_rng();
+    let (shares, refreshed_public_key_package) = compute_refreshing_shares(
+        public_key_package,
+        max_signers,
+        min_signers,
+        &identifiers,
+        &mut rng,
+    )
+    .map_err(FrostError::map_err)?;
+
+    let mut secret_shares: HashMap<ParticipantIdentifier, FrostSecretKeyShare> = HashMap::new();
+    for share in shares {
+        secret_shares.insert(
+            ParticipantIdentifier::from_identifier(*share.identifier())
+                .map_err(FrostError::map_err)?,
+            FrostSecretKeyShare::from_secret_share(share).map_err(FrostError::map_err)?,
+        );
+    }
+
+    Ok(TrustedKeyGeneration {
+        secret_shares,
+        public_key_package: FrostPublicKeyPackage::from_public_key_package(
+            refreshed_public_key_package,
+        )
+        .map_err(FrostError::map_err)?,
+    })
+}
+
+/// Applies a refreshing share computed by `compute_refreshing_shares_from`
+/// to the current key package of its participant, which must be
+/// discarded in favour of the returned one.
+#[uniffi::export]
+pub fn refresh_key_package(
+    refreshing_share: FrostSecretKeyShare,
+    key_package: FrostKeyPackage,
+) -> Result<FrostKeyPackage, FrostError> {
+    let refreshing_share = refreshing_share
+        .to_secret_share::<E>()
+        .map_err(FrostError::map_err)?;
+
+    let key_package: KeyPackage<E> = key_package
+        .into_key_package()
+        .map_err(|_| FrostError::DeserializationError)?;
+
+    if refreshing_share.identifier() != key_package.identifier() {
+        return Err(FrostError::UnknownIdentifier);
+    }
+
+    let refreshed = refresh_share(refreshing_share, &key_package).map_err(FrostError::map_err)?;
+
+    FrostKeyPackage::from_key_package(&refreshed).map_err(FrostError::map_err)
+}
+
+/// Distributed refresh Part 1.
+/// Starts refreshing the share of `key_package` together with the other
+/// participants of the group, `max_signers` in total. It works like
+/// `part_1` of the DKG: the round 1 package must be broadcast to every
+/// other participant.
+#[uniffi::export]
+pub fn refresh_part_1(
+    key_package: FrostKeyPackage,
+    max_signers: u16,
+) -> Result<Arc<DKGPart1Result>, FrostError> {
+    let key_package: KeyPackage<E> = key_package
+        .into_key_package()
+        .map_err(|_| FrostError::DeserializationError)?;
+
+    let participant_identifier = ParticipantIdentifier::from_identifier(*key_package.identifier())
+        .map_err(FrostError::map_err)?;
+
+    let rng = thread_rng();
+    let (secret, package) = refresh_dkg_part_1(
+        *key_package.identifier(),
+        max_signers,
+        *key_package.min_signers(),
+        rng,
+    )
+    .map_err(FrostError::map_err)?;
+
+    let secret = DKGRound1SecretPackage::from_secret_package::<E>(secret);
+    let package = DKGRound1Package::from_package(participant_identifier, package)
+        .map_err(FrostError::map_err)?;
+
+    Ok(Arc::new(DKGPart1Result { secret, package }))
+}
+
+/// Distributed refresh Part 2.
+/// Works like `part_2` of the DKG, with the round 1 packages of all the
+/// other participants **except** itself.
+#[uniffi::export]
+pub fn refresh_part_2(
+    secret_package: Arc<DKGRound1SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+) -> Result<Arc<DKGPart2Result>, FrostError> {
+    let round1_packages = round1_packages_from(round1_packages)?;
+    let secret_package = secret_package.to_secret_package::<E>()?;
+
+    let (secret, round2_packages) =
+        refresh_dkg_part2(secret_package, &round1_packages).map_err(|e| match e {
+            Error::IncorrectNumberOfCommitments => FrostError::DKGPart2IncorrectNumberOfCommitments,
+            Error::IncorrectNumberOfPackages => FrostError::DKGPart2IncorrectNumberOfPackages,
+            e => FrostError::map_err(e),
+        })?;
+
+    let mut packages: Vec<DKGRound2Package> = Vec::new();
+    for (identifier, package) in round2_packages.into_iter() {
+        let identifier = ParticipantIdentifier::from_identifier(identifier)
+            .map_err(|_| FrostError::SerializationError)?;
+
+        packages.push(
+            DKGRound2Package::from_package(identifier, package)
+                .map_err(|_| FrostError::SerializationError)?,
+        );
+    }
+
+    Ok(Arc::new(DKGPart2Result {
+        secret: DKGRound2SecretPackage::from_secret_package::<E>(secret),
+        packages,
+    }))
+}
+
+/// Distributed refresh Part 3.
+/// Works like `part_3` of the DKG, and also takes the current key package
+/// of the participant and public key package of the group. Returns the
+/// refreshed ones, which must replace them: the verifying key of the
+/// group doesn't change.
+#[uniffi::export]
+pub fn refresh_part_3(
+    secret_package: Arc<DKGRound2SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+    round2_packages: HashMap<ParticipantIdentifier, DKGRound2Package>,
+    public_key_package: FrostPublicKeyPackage,
+    key_package: FrostKeyPackage,
+) -> Result<DKGPart3Result, FrostError> {
+    let round1_packages = round1_packages_from(round1_packages)?;
+    let secret_package = secret_package.to_secret_package::<E>()?;
+
+    let mut round2_pkg: BTreeMap<Identifier<E>, round2::Package<E>> = BTreeMap::new();
+    for (id, pkg) in round2_packages.into_iter() {
+        let package = pkg
+            .to_package()
+            .map_err(|_| FrostError::DeserializationError)?;
+        let identifier = id
+            .into_identifier()
+            .map_err(|_| FrostError::DeserializationError)?;
+        round2_pkg.insert(identifier, package);
+    }
+
+    let public_key_package = public_key_package
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let key_package: KeyPackage<E> = key_package
+        .into_key_package()
+        .map_err(|_| FrostError::DeserializationError)?;
+
+    let (key_package, public_key_package) = refresh_dkg_shares(
+        &secret_package,
+        &round1_packages,
+        &round2_pkg,
+        public_key_package,
+        key_package,
+    )
+    .map_err(|e| match e {
+        Error::IncorrectNumberOfPackages => FrostError::DKGPart3IncorrectNumberOfPackages,
+        Error::IncorrectPackage => FrostError::DKGPart3IncorrectRound1Packages,
+        Error::PackageNotFound => FrostError::DKGPart3PackageSendersMismatch,
+        e => FrostError::map_err(e),
+    })?;
+
+    Ok(DKGPart3Result {
+        public_key_package: FrostPublicKeyPackage::from_public_key_package(public_key_package)
+            .map_err(|_| FrostError::SerializationError)?,
+        key_package: FrostKeyPackage::from_key_package(&key_package)
+            .map_err(|_| FrostError::SerializationError)?,
+    })
+}
+
+fn round1_packages_from<C: Ciphersuite>(
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+) -> Result<BTreeMap<Identifier<C>, round1::Package<C>>, FrostError> {
+    let mut packages: BTreeMap<Identifier<C>, round1::Package<C>> = BTreeMap::new();
+
+    for (id, pkg) in round1_packages.into_iter() {
+        let package = pkg
+            .to_package()
+            .map_err(|_| FrostError::DeserializationError)?;
+        let identifier = id
+            .into_identifier()
+            .map_err(|_| FrostError::DeserializationError)?;
+        packages.insert(identifier, package);
+    }
+
+    Ok(packages)
+}
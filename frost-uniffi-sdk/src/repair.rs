@@ -0,0 +1,158 @@
+use frost_core::{self as frost, Ciphersuite};
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+use frost::{
+    keys::{
+        repairable, KeyPackage, SecretShare, SigningShare, VerifiableSecretSharingCommitment,
+        VerifyingShare,
+    },
+    Error, Identifier, Scalar,
+};
+
+use rand::thread_rng;
+use std::collections::HashMap;
+
+use crate::{FrostError, FrostKeyPackage, FrostPublicKeyPackage, ParticipantIdentifier};
+
+/// Repair Step 1.
+/// Run by each of the `helpers`, at least `min_signers` participants
+/// holding `key_package`, to help `participant` recover its lost share.
+/// Returns a delta for each helper, this one included, which must be
+/// sent privately to the helper it is addressed to.
+#[uniffi::export]
+pub fn repair_share_step_1(
+    helpers: Vec<ParticipantIdentifier>,
+    key_package: FrostKeyPackage,
+    participant: ParticipantIdentifier,
+) -> Result<HashMap<ParticipantIdentifier, String>, FrostError> {
+    let key_package: KeyPackage<E> = key_package
+        .into_key_package()
+        .map_err(|_| FrostError::DeserializationError)?;
+
+    let mut helper_identifiers: Vec<Identifier<E>> = Vec::with_capacity(helpers.len());
+    for helper in helpers.iter() {
+        helper_identifiers.push(
+            helper
+                .into_identifier()
+                .map_err(|_| FrostError::MalformedIdentifier)?,
+        );
+    }
+
+    if !helper_identifiers.contains(key_package.identifier()) {
+        return Err(FrostError::UnknownIdentifier);
+    }
+
+    if helper_identifiers.len() < *key_package.min_signers() as usize {
+        return Err(FrostError::IncorrectNumberOfIdentifiers);
+    }
+
+    let participant = participant
+        .into_identifier()
+        .map_err(|_| FrostError::MalformedIdentifier)?;
+
+    // only the identifier and signing share of the helper are involved in
+    // this step
+    let share = SecretShare::new(
+        *key_package.identifier(),
+        *key_package.signing_share(),
+        VerifiableSecretSharingCommitment::new(Vec::new()),
+    );
+
+    let mut rng = thread_rng();
+    let deltas =
+        repairable::repair_share_step_1(&helper_identifiers, &share, &mut rng, participant)
+            .map_err(FrostError::map_err)?;
+
+    let mut serialized: HashMap<ParticipantIdentifier, String> = HashMap::new();
+    for (identifier, delta) in deltas {
+        serialized.insert(
+            ParticipantIdentifier::from_identifier(identifier).map_err(FrostError::map_err)?,
+            serialize_scalar(delta),
+        );
+    }
+
+    Ok(serialized)
+}
+
+/// Repair Step 2.
+/// Run by each helper with the deltas addressed to it by every helper,
+/// itself included, by identifier of their sender. Returns the sigma to
+/// send privately to the participant being repaired.
+#[uniffi::export]
+pub fn repair_share_step_2(
+    deltas: HashMap<ParticipantIdentifier, String>,
+) -> Result<String, FrostError> {
+    let deltas = deserialize_scalars(deltas)?;
+
+    let sigma = repairable::repair_share_step_2::<E>(&deltas);
+
+    Ok(serialize_scalar(sigma))
+}
+
+/// Repair Step 3.
+/// Run by the participant being repaired with the sigmas of every helper,
+/// by identifier of their sender. Returns its key package for the group of
+/// `public_key_package`, checked against its verifying share.
+#[uniffi::export]
+pub fn repair_share_step_3(
+    sigmas: HashMap<ParticipantIdentifier, String>,
+    participant: ParticipantIdentifier,
+    public_key_package: FrostPublicKeyPackage,
+    min_signers: u16,
+) -> Result<FrostKeyPackage, FrostError> {
+    let sigmas = deserialize_scalars(sigmas)?;
+
+    let identifier = participant
+        .into_identifier()
+        .map_err(|_| FrostError::MalformedIdentifier)?;
+
+    let public_key_package = public_key_package
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let expected = public_key_package
+        .verifying_shares()
+        .get(&identifier)
+        .ok_or(FrostError::UnknownIdentifier)?;
+
+    // the signing share is the sum of the sigmas
+    let signing_share = SigningShare::new(repairable::repair_share_step_2::<E>(&sigmas));
+    let verifying_share = VerifyingShare::from(signing_share);
+
+    if verifying_share != *expected {
+        return Err(FrostError::InvalidSecretShare { culprit: None });
+    }
+
+    let key_package = KeyPackage::new(
+        identifier,
+        signing_share,
+        verifying_share,
+        *public_key_package.verifying_key(),
+        min_signers,
+    );
+
+    FrostKeyPackage::from_key_package(&key_package).map_err(FrostError::map_err)
+}
+
+fn serialize_scalar(scalar: Scalar<E>) -> String {
+    hex::encode(SigningShare::<E>::new(scalar).serialize())
+}
+
+fn deserialize_scalars<C: Ciphersuite>(
+    scalars: HashMap<ParticipantIdentifier, String>,
+) -> Result<Vec<Scalar<C>>, FrostError> {
+    let mut deserialized: Vec<Scalar<C>> = Vec::with_capacity(scalars.len());
+
+    for (_, scalar) in scalars {
+        let bytes = hex::decode(scalar).map_err(|_| FrostError::DeserializationError)?;
+        let share = SigningShare::<C>::deserialize(&bytes)
+            .map_err(|_: Error<C>| FrostError::DeserializationError)?;
+        deserialized.push(share.to_scalar());
+    }
+
+    Ok(deserialized)
+}
@@ -0,0 +1,248 @@
+use frost_core::{self as frost, Ciphersuite, Field, Group};
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+use frost::{
+    compute_lagrange_coefficient,
+    keys::{
+        split, sum_commitments, IdentifierList, KeyPackage, PublicKeyPackage, SecretShare,
+        SigningShare, VerifiableSecretSharingCommitment, VerifyingShare,
+    },
+    Identifier, SigningKey,
+};
+
+use rand::thread_rng;
+use std::collections::{BTreeMap, BTreeSet, HashMap};
+
+use crate::{
+    dkg::lib::DKGPart3Result, validate_config, Configuration, FrostError, FrostKeyPackage,
+    FrostPublicKeyPackage, FrostSecretKeyShare, ParticipantIdentifier,
+};
+
+/// Reshare Step 1.
+/// Run by each of the `old_signers`, at least as many as required to sign
+/// in the current group, to hand out its share to the `new_participants`
+/// of a group with the same verifying key and the given `configuration`.
+/// Returns a share for each new participant, which must be sent privately
+/// to the participant it belongs to.
+#[uniffi::export]
+pub fn reshare_step_1(
+    key_package: FrostKeyPackage,
+    old_signers: Vec<ParticipantIdentifier>,
+    configuration: Configuration,
+    new_participants: Vec<ParticipantIdentifier>,
+) -> Result<HashMap<ParticipantIdentifier, FrostSecretKeyShare>, FrostError> {
+    let key_package: KeyPackage<E> = key_package
+        .into_key_package()
+        .map_err(|_| FrostError::DeserializationError)?;
+
+    let old_signers = identifier_set(&old_signers)?;
+    let new_participants = identifier_list(&new_participants, &configuration)?;
+
+    if !old_signers.contains(key_package.identifier()) {
+        return Err(FrostError::UnknownIdentifier);
+    }
+
+    if old_signers.len() < *key_package.min_signers() as usize {
+        return Err(FrostError::IncorrectNumberOfIdentifiers);
+    }
+
+    // the shares of the old signers weighted by their Lagrange coefficient
+    // add up to the signing key of the group
+    let lambda = compute_lagrange_coefficient(&old_signers, None, *key_package.identifier())
+        .map_err(FrostError::map_err)?;
+    let weighted_share = key_package.signing_share().to_scalar() * lambda;
+
+    let signing_key = SigningKey::from_scalar(weighted_share).map_err(FrostError::map_err)?;
+
+    let mut rng = thread_rng();
+    let (shares, _) = split(
+        &signing_key,
+        configuration.max_signers,
+        configuration.min_signers,
+        IdentifierList::Custom(&new_participants),
+        &mut rng,
+    )
+    .map_err(FrostError::map_err)?;
+
+    let mut serialized: HashMap<ParticipantIdentifier, FrostSecretKeyShare> = HashMap::new();
+    for (identifier, share) in shares {
+        serialized.insert(
+            ParticipantIdentifier::from_identifier(identifier).map_err(FrostError::map_err)?,
+            FrostSecretKeyShare::from_secret_share(share).map_err(FrostError::map_err)?,
+        );
+    }
+
+    Ok(serialized)
+}
+
+/// Reshare Step 2.
+/// Run by each of the `new_participants` with the shares it received
+/// from every old signer, by identifier of their sender. Each share is
+/// checked against the verifying share of its sender in the current
+/// `public_key_package`. Returns the key package of `participant` and the
+/// public key package of the new group, which has the same verifying key.
+///
+/// New participants must make sure that they all derived the same public
+/// key package before using their key packages.
+#[uniffi::export]
+pub fn reshare_step_2(
+    shares: HashMap<ParticipantIdentifier, FrostSecretKeyShare>,
+    public_key_package: FrostPublicKeyPackage,
+    configuration: Configuration,
+    new_participants: Vec<ParticipantIdentifier>,
+    participant: ParticipantIdentifier,
+) -> Result<DKGPart3Result, FrostError> {
+    let public_key_package = public_key_package
+        .into_public_key_package()
+        .map_err(FrostError::map_err)?;
+
+    let new_participants: BTreeSet<Identifier<E>> =
+        identifier_list(&new_participants, &configuration)?
+            .into_iter()
+            .collect();
+
+    let identifier = participant
+        .into_identifier()
+        .map_err(|_| FrostError::MalformedIdentifier)?;
+
+    if !new_participants.contains(&identifier) {
+        return Err(FrostError::UnknownIdentifier);
+    }
+
+    let mut secret_shares: BTreeMap<Identifier<E>, SecretShare<E>> = BTreeMap::new();
+    for (sender, share) in shares.iter() {
+        let sender_identifier = sender
+            .into_identifier()
+            .map_err(|_| FrostError::MalformedIdentifier)?;
+
+        let invalid_share = || FrostError::InvalidSecretShare {
+            culprit: Some(sender.clone()),
+        };
+
+        let secret_share = share.to_secret_share::<E>().map_err(|_| invalid_share())?;
+
+        if *secret_share.identifier() != identifier {
+            return Err(invalid_share());
+        }
+
+        secret_share.verify().map_err(|_| invalid_share())?;
+
+        let coefficients = secret_share
+            .commitment()
+            .serialize()
+            .map_err(FrostError::map_err)?;
+
+        if coefficients.len() != configuration.min_signers as usize {
+            return Err(invalid_share());
+        }
+
+        secret_shares.insert(sender_identifier, secret_share);
+    }
+
+    let old_signers: BTreeSet<Identifier<E>> = secret_shares.keys().cloned().collect();
+
+    for (sender, secret_share) in secret_shares.iter() {
+        let culprit =
+            ParticipantIdentifier::from_identifier(*sender).map_err(FrostError::map_err)?;
+
+        let verifying_share = public_key_package
+            .verifying_shares()
+            .get(sender)
+            .ok_or(FrostError::UnknownIdentifier)?;
+
+        // the secret dealt by the sender must be its weighted share
+        let lambda = compute_lagrange_coefficient(&old_signers, None, *sender)
+            .map_err(FrostError::map_err)?;
+        let expected = verifying_share.to_element() * lambda;
+
+        let dealt = PublicKeyPackage::from_commitment(&new_participants, secret_share.commitment())
+            .map_err(FrostError::map_err)?;
+
+        if dealt.verifying_key().to_element() != expected {
+            return Err(FrostError::InvalidSecretShare {
+                culprit: Some(culprit),
+            });
+        }
+    }
+
+    let commitments: Vec<&VerifiableSecretSharingCommitment<E>> = secret_shares
+        .values()
+        .map(|secret_share| secret_share.commitment())
+        .collect();
+
+    let group_commitment = sum_commitments(&commitments).map_err(FrostError::map_err)?;
+
+    let new_public_key_package =
+        PublicKeyPackage::from_commitment(&new_participants, &group_commitment)
+            .map_err(FrostError::map_err)?;
+
+    // fewer old signers than required to sign deal another key
+    if new_public_key_package.verifying_key() != public_key_package.verifying_key() {
+        return Err(FrostError::IncorrectNumberOfShares);
+    }
+
+    let signing_share = SigningShare::new(
+        secret_shares
+            .values()
+            .map(|secret_share| secret_share.signing_share().to_scalar())
+            .fold(
+                <<E as Ciphersuite>::Group as Group>::Field::zero(),
+                |sum, scalar| sum + scalar,
+            ),
+    );
+
+    let verifying_share = VerifyingShare::from(signing_share);
+
+    if new_public_key_package.verifying_shares().get(&identifier) != Some(&verifying_share) {
+        return Err(FrostError::InvalidSecretShare { culprit: None });
+    }
+
+    let key_package = KeyPackage::new(
+        identifier,
+        signing_share,
+        verifying_share,
+        *new_public_key_package.verifying_key(),
+        configuration.min_signers,
+    );
+
+    Ok(DKGPart3Result {
+        public_key_package: FrostPublicKeyPackage::from_public_key_package(new_public_key_package)
+            .map_err(FrostError::map_err)?,
+        key_package: FrostKeyPackage::from_key_package(&key_package)
+            .map_err(FrostError::map_err)?,
+    })
+}
+
+fn identifier_set(
+    identifiers: &[ParticipantIdentifier],
+) -> Result<BTreeSet<Identifier<E>>, FrostError> {
+    let mut set: BTreeSet<Identifier<E>> = BTreeSet::new();
+
+    for identifier in identifiers {
+        let identifier = identifier
+            .into_identifier()
+            .map_err(|_| FrostError::MalformedIdentifier)?;
+        if !set.insert(identifier) {
+            return Err(FrostError::DuplicatedIdentifier);
+        }
+    }
+
+    Ok(set)
+}
+
+fn identifier_list(
+    identifiers: &[ParticipantIdentifier],
+    configuration: &Configuration,
+) -> Result<Vec<Identifier<E>>, FrostError> {
+    validate_config(configuration).map_err(|_| FrostError::InvalidMinSigners)?;
+
+    if configuration.max_signers as usize != identifiers.len() {
+        return Err(FrostError::InvalidMaxSigners);
+    }
+
+    Ok(identifier_set(identifiers)?.into_iter().collect())
+}
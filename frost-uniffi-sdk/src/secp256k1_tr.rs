@@ -0,0 +1,217 @@
+// FROST(secp256k1, SHA-256)-TR, exported next to the ciphersuite this
+// library is built with, so that a single build can produce BIP-340
+// Schnorr signatures. Plain signatures verify against the x-only form of
+// the untweaked verifying key of the group. The `_with_tweak` functions
+// apply the BIP-341 Taproot tweak, so that their signatures spend the key
+// path of a Taproot output.
+//
+// The records and objects are the same as the ones of the main API but
+// hold secp256k1 values: they can't be mixed with the ones of another
+// ciphersuite. Identifiers don't have the same encoding either.
+use std::{collections::HashMap, sync::Arc};
+
+use crate::{
+    coordinator::{
+        aggregate_for, new_signing_package_for, verify_signature_for, verify_signature_share_for,
+        CoordinationError, FrostSignature, FrostSignatureVerificationError, FrostSigningPackage,
+        Message,
+    },
+    dkg::lib::{
+        part_1_for, part_2_for, part_3_for, DKGPart1Result, DKGPart2Result, DKGPart3Result,
+        DKGRound1Package, DKGRound1SecretPackage, DKGRound2Package, DKGRound2SecretPackage,
+    },
+    participant::{
+        generate_nonces_and_commitments_for, sign_for, FirstRoundCommitment, FrostSignatureShare,
+        FrostSigningCommitments, FrostSigningNonces, Round1Error, Round2Error,
+    },
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, FrostError, FrostKeyPackage, FrostPublicKeyPackage, FrostSecretKeyShare,
+    ParticipantIdentifier, TrustedKeyGeneration,
+};
+
+use frost_core::Identifier;
+use frost_secp256k1_tr::keys::Tweak;
+
+type Secp256K1Tr = frost_secp256k1_tr::Secp256K1Sha256TR;
+
+/// secp256k1-TR counterpart of `identifier_from_uint16`.
+#[uniffi::export]
+pub fn secp256k1_tr_identifier_from_uint16(
+    unsigned_uint: u16,
+) -> Result<ParticipantIdentifier, FrostError> {
+    let identifier =
+        Identifier::<Secp256K1Tr>::try_from(unsigned_uint).map_err(FrostError::map_err)?;
+
+    ParticipantIdentifier::from_identifier(identifier).map_err(FrostError::map_err)
+}
+
+/// secp256k1-TR counterpart of `trusted_dealer_keygen_from`.
+#[uniffi::export]
+pub fn secp256k1_tr_trusted_dealer_keygen_from(
+    configuration: Configuration,
+) -> Result<TrustedKeyGeneration, FrostError> {
+    let (pubkey, secret_shares) =
+        trusted_dealer_keygen_from_configuration::<Secp256K1Tr>(&configuration)
+            .map_err(FrostError::map_err)?;
+
+    Ok(TrustedKeyGeneration {
+        public_key_package: pubkey,
+        secret_shares,
+    })
+}
+
+/// secp256k1-TR counterpart of `verify_and_get_key_package_from`.
+#[uniffi::export]
+pub fn secp256k1_tr_verify_and_get_key_package_from(
+    secret_share: FrostSecretKeyShare,
+) -> Result<FrostKeyPackage, FrostError> {
+    secret_share
+        .into_key_package::<Secp256K1Tr>()
+        .map_err(|_| FrostError::InvalidSecretKey)
+}
+
+/// secp256k1-TR counterpart of DKG `part_1`. The identifier must come from
+/// `secp256k1_tr_identifier_from_uint16`.
+#[uniffi::export]
+pub fn secp256k1_tr_part_1(
+    participant_identifier: ParticipantIdentifier,
+    max_signers: u16,
+    min_signers: u16,
+) -> Result<Arc<DKGPart1Result>, FrostError> {
+    part_1_for::<Secp256K1Tr>(participant_identifier, max_signers, min_signers)
+}
+
+/// secp256k1-TR counterpart of DKG `part_2`.
+#[uniffi::export]
+pub fn secp256k1_tr_part_2(
+    secret_package: Arc<DKGRound1SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+) -> Result<Arc<DKGPart2Result>, FrostError> {
+    part_2_for::<Secp256K1Tr>(secret_package, round1_packages)
+}
+
+/// secp256k1-TR counterpart of DKG `part_3`.
+#[uniffi::export]
+pub fn secp256k1_tr_part_3(
+    secret_package: Arc<DKGRound2SecretPackage>,
+    round1_packages: HashMap<ParticipantIdentifier, DKGRound1Package>,
+    round2_packages: HashMap<ParticipantIdentifier, DKGRound2Package>,
+) -> Result<DKGPart3Result, FrostError> {
+    part_3_for::<Secp256K1Tr>(secret_package, round1_packages, round2_packages)
+}
+
+/// secp256k1-TR counterpart of `generate_nonces_and_commitments`.
+#[uniffi::export]
+pub fn secp256k1_tr_generate_nonces_and_commitments(
+    key_package: FrostKeyPackage,
+) -> Result<FirstRoundCommitment, Round1Error> {
+    generate_nonces_and_commitments_for::<Secp256K1Tr>(key_package)
+}
+
+/// secp256k1-TR counterpart of `new_signing_package`. The message is
+/// usually a BIP-341 signature hash.
+#[uniffi::export]
+pub fn secp256k1_tr_new_signing_package(
+    message: Message,
+    commitments: Vec<FrostSigningCommitments>,
+) -> Result<FrostSigningPackage, CoordinationError> {
+    new_signing_package_for::<Secp256K1Tr>(message, commitments)
+}
+
+/// secp256k1-TR signing. Like Ed25519 signing, it takes no randomizer.
+#[uniffi::export]
+pub fn secp256k1_tr_sign(
+    signing_package: FrostSigningPackage,
+    nonces: FrostSigningNonces,
+    key_package: FrostKeyPackage,
+) -> Result<FrostSignatureShare, Round2Error> {
+    sign_for::<Secp256K1Tr>(signing_package, nonces, key_package)
+}
+
+/// secp256k1-TR aggregation. The signature is a 64-byte BIP-340 Schnorr
+/// signature.
+#[uniffi::export]
+pub fn secp256k1_tr_aggregate(
+    signing_package: FrostSigningPackage,
+    signature_shares: Vec<FrostSignatureShare>,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<FrostSignature, CoordinationError> {
+    aggregate_for::<Secp256K1Tr>(signing_package, signature_shares, pubkey_package)
+}
+
+/// Verifies a single secp256k1-TR signature share against the verifying
+/// share of its signer, so that a coordinator can reject it before
+/// aggregation.
+#[uniffi::export]
+pub fn secp256k1_tr_verify_signature_share(
+    signing_package: FrostSigningPackage,
+    signature_share: FrostSignatureShare,
+    pubkey_package: FrostPublicKeyPackage,
+) -> Result<(), CoordinationError> {
+    verify_signature_share_for::<Secp256K1Tr>(signing_package, signature_share, pubkey_package)
+}
+
+/// Verifies a BIP-340 signature of `message` by the group of `pubkey`.
+#[uniffi::export]
+pub fn secp256k1_tr_verify_signature(
+    message: Message,
+    signature: FrostSignature,
+    pubkey: FrostPublicKeyPackage,
+) -> Result<(), FrostSignatureVerificationError> {
+    verify_signature_for::<Secp256K1Tr>(message, signature, pubkey)
+}
+
+/// secp256k1-TR counterpart of `PublicKeyPackage::tweak`: the public key
+/// package of the group tweaked with `merkle_root`, the root of the script
+/// tree of a Taproot output, or with none for a BIP-86 output without
+/// scripts. Its verifying key is the output key, which the signatures of
+/// `secp256k1_tr_aggregate_with_tweak` verify against, and its verifying
+/// shares verify the signature shares of `secp256k1_tr_sign_with_tweak`.
+#[uniffi::export]
+pub fn secp256k1_tr_tweak_public_key_package(
+    pubkey_package: FrostPublicKeyPackage,
+    merkle_root: Option<Vec<u8>>,
+) -> Result<FrostPublicKeyPackage, FrostError> {
+    let pubkey_package = pubkey_package
+        .to_public_key_package::<Secp256K1Tr>()
+        .map_err(FrostError::map_err)?;
+
+    FrostPublicKeyPackage::from_public_key_package(pubkey_package.tweak(merkle_root.as_deref()))
+        .map_err(FrostError::map_err)
+}
+
+/// secp256k1-TR counterpart of `sign_with_tweak`: signs with the key
+/// package tweaked with `merkle_root`, like
+/// `secp256k1_tr_tweak_public_key_package`.
+#[uniffi::export]
+pub fn secp256k1_tr_sign_with_tweak(
+    signing_package: FrostSigningPackage,
+    nonces: FrostSigningNonces,
+    key_package: FrostKeyPackage,
+    merkle_root: Option<Vec<u8>>,
+) -> Result<FrostSignatureShare, Round2Error> {
+    let key_package = key_package
+        .into_key_package::<Secp256K1Tr>()
+        .map_err(|_| Round2Error::InvalidKeyPackage)?;
+
+    let key_package = FrostKeyPackage::from_key_package(&key_package.tweak(merkle_root.as_deref()))
+        .map_err(|_| Round2Error::InvalidKeyPackage)?;
+
+    sign_for::<Secp256K1Tr>(signing_package, nonces, key_package)
+}
+
+/// secp256k1-TR counterpart of `aggregate_with_tweak`: aggregates the
+/// signature shares of `secp256k1_tr_sign_with_tweak` into a BIP-340
+/// signature by the output key tweaked with `merkle_root`.
+#[uniffi::export]
+pub fn secp256k1_tr_aggregate_with_tweak(
+    signing_package: FrostSigningPackage,
+    signature_shares: Vec<FrostSignatureShare>,
+    pubkey_package: FrostPublicKeyPackage,
+    merkle_root: Option<Vec<u8>>,
+) -> Result<FrostSignature, CoordinationError> {
+    let pubkey_package = secp256k1_tr_tweak_public_key_package(pubkey_package, merkle_root)
+        .map_err(|_| CoordinationError::PublicKeyPackageDeserializationError)?;
+
+    aggregate_for::<Secp256K1Tr>(signing_package, signature_shares, pubkey_package)
+}
@@ -25,8 +25,9 @@ use frost_uniffi_sdk::{
 };
 
 use frost_uniffi_sdk::dkg::lib::{
-    part_1, part_2, part_3, DKGRound1Package, DKGRound1SecretPackage, DKGRound2Package,
-    DKGRound2SecretPackage,
+    dkg_round1_secret_package_to_json, dkg_round2_secret_package_to_json,
+    json_to_dkg_round1_secret_package, json_to_dkg_round2_secret_package, part_1, part_2, part_3,
+    DKGRound1Package, DKGRound1SecretPackage, DKGRound2Package, DKGRound2SecretPackage,
 };
 
 #[cfg(not(feature = "redpallas"))]
@@ -255,3 +256,45 @@ fn test_dkg_from_3_participants() {
         }
     }
 }
+
+#[test]
+fn test_dkg_resumes_from_serialized_secret_packages() {
+    let p1_identifier =
+        ParticipantIdentifier::from_identifier(Identifier::<E>::try_from(1).unwrap()).unwrap();
+    let p2_identifier =
+        ParticipantIdentifier::from_identifier(Identifier::<E>::try_from(2).unwrap()).unwrap();
+
+    let p1_part1 = part_1(p1_identifier.clone(), 2, 2).unwrap();
+    let p2_part1 = part_1(p2_identifier.clone(), 2, 2).unwrap();
+
+    // p1 restarts after broadcasting its round 1 package
+    let p1_secret1 = json_to_dkg_round1_secret_package(
+        dkg_round1_secret_package_to_json(p1_part1.secret()).unwrap(),
+    )
+    .unwrap();
+
+    let p1_round1_packages = HashMap::from([(p2_identifier.clone(), p2_part1.package())]);
+    let p2_round1_packages = HashMap::from([(p1_identifier.clone(), p1_part1.package())]);
+
+    let p1_part2 = part_2(p1_secret1, p1_round1_packages.clone()).unwrap();
+    let p2_part2 = part_2(p2_part1.secret(), p2_round1_packages.clone()).unwrap();
+
+    // p1 restarts again after sending its round 2 package
+    let p1_secret2 = json_to_dkg_round2_secret_package(
+        dkg_round2_secret_package_to_json(p1_part2.secret()).unwrap(),
+    )
+    .unwrap();
+
+    let p1_round2_packages =
+        HashMap::from([(p2_identifier.clone(), p2_part2.packages()[0].clone())]);
+    let p2_round2_packages =
+        HashMap::from([(p1_identifier.clone(), p1_part2.packages()[0].clone())]);
+
+    let p1_part3 = part_3(p1_secret2, p1_round1_packages, p1_round2_packages).unwrap();
+    let p2_part3 = part_3(p2_part2.secret(), p2_round1_packages, p2_round2_packages).unwrap();
+
+    assert_eq!(
+        p1_part3.public_key_package.verifying_key,
+        p2_part3.public_key_package.verifying_key
+    );
+}
@@ -0,0 +1,67 @@
+use frost_uniffi_sdk::{
+    coordinator::Message,
+    ed25519::{
+        ed25519_aggregate, ed25519_generate_nonces_and_commitments, ed25519_new_signing_package,
+        ed25519_sign, ed25519_trusted_dealer_keygen_from, ed25519_verify_and_get_key_package_from,
+        ed25519_verify_signature,
+    },
+    Configuration, FrostKeyPackage, ParticipantIdentifier,
+};
+use std::collections::HashMap;
+
+#[test]
+fn test_ed25519_signs_with_any_build() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let keygen = ed25519_trusted_dealer_keygen_from(config).unwrap();
+
+    let key_packages: HashMap<ParticipantIdentifier, FrostKeyPackage> = keygen
+        .secret_shares
+        .into_iter()
+        .map(|(identifier, share)| {
+            (
+                identifier,
+                ed25519_verify_and_get_key_package_from(share).unwrap(),
+            )
+        })
+        .collect();
+
+    let mut nonces = HashMap::new();
+    let mut commitments = Vec::new();
+    for (identifier, key_package) in key_packages.iter() {
+        let commitment = ed25519_generate_nonces_and_commitments(key_package.clone()).unwrap();
+        nonces.insert(identifier.clone(), commitment.nonces);
+        commitments.push(commitment.commitments);
+    }
+
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let signing_package = ed25519_new_signing_package(message.clone(), commitments).unwrap();
+
+    let signature_shares = key_packages
+        .iter()
+        .map(|(identifier, key_package)| {
+            ed25519_sign(
+                signing_package.clone(),
+                nonces[identifier].clone(),
+                key_package.clone(),
+            )
+            .unwrap()
+        })
+        .collect();
+
+    let signature = ed25519_aggregate(
+        signing_package,
+        signature_shares,
+        keygen.public_key_package.clone(),
+    )
+    .unwrap();
+
+    ed25519_verify_signature(message, signature, keygen.public_key_package).unwrap();
+}
@@ -93,3 +93,131 @@ fn check_keygen_with_dealer_with_secret_with_large_num_of_signers() {
 
     assert!(verify_signature(message, group_signature, pubkeys).is_ok())
 }
+
+#[cfg(not(feature = "redpallas"))]
+#[test]
+fn aggregate_identifies_invalid_signature_share() {
+    use frost_uniffi_sdk::coordinator::CoordinationError;
+
+    let mut rng = thread_rng();
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let (nonces, commitments) = round_1::<E>(&mut rng, &key_packages);
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let (signing_package, signature_shares) = round_2(&nonces, &key_packages, commitments, message);
+
+    let mut signature_shares: Vec<_> = signature_shares.into_iter().map(|s| s.1).collect();
+
+    // the cheater sends a copy of someone else's share as its own
+    signature_shares[1].data = signature_shares[0].data.clone();
+    let cheater = signature_shares[1].identifier.clone();
+
+    match aggregate(signing_package, signature_shares, pubkeys) {
+        Err(CoordinationError::InvalidSignatureShare { culprit }) => assert_eq!(culprit, cheater),
+        Err(e) => panic!("unexpected error: {e:?}"),
+        Ok(_) => panic!("aggregation should fail with an invalid signature share"),
+    }
+}
+
+#[cfg(not(feature = "redpallas"))]
+#[test]
+fn verify_signature_share_rejects_invalid_share() {
+    use frost_uniffi_sdk::coordinator::{verify_signature_share, CoordinationError};
+
+    let mut rng = thread_rng();
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let (nonces, commitments) = round_1::<E>(&mut rng, &key_packages);
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let (signing_package, signature_shares) = round_2(&nonces, &key_packages, commitments, message);
+
+    let mut signature_shares: Vec<_> = signature_shares.into_iter().map(|s| s.1).collect();
+
+    for share in &signature_shares {
+        assert!(
+            verify_signature_share(signing_package.clone(), share.clone(), pubkeys.clone()).is_ok()
+        );
+    }
+
+    signature_shares[1].data = signature_shares[0].data.clone();
+    let cheater = signature_shares[1].identifier.clone();
+
+    match verify_signature_share(signing_package, signature_shares.remove(1), pubkeys) {
+        Err(CoordinationError::InvalidSignatureShare { culprit }) => assert_eq!(culprit, cheater),
+        Err(e) => panic!("unexpected error: {e:?}"),
+        Ok(_) => panic!("verification should fail with an invalid signature share"),
+    }
+}
+
+#[cfg(not(feature = "redpallas"))]
+#[test]
+fn signature_with_signing_share_verifies_against_its_verifying_share_only() {
+    use frost_uniffi_sdk::{
+        coordinator::verify_signature_with_verifying_share, participant::sign_with_signing_share,
+    };
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let mut identifiers: Vec<_> = key_packages.keys().cloned().collect();
+    identifiers.sort_by(|a, b| a.data.cmp(&b.data));
+
+    let signer = &identifiers[0];
+    let signature = sign_with_signing_share(key_packages[signer].clone(), message.clone()).unwrap();
+
+    assert!(verify_signature_with_verifying_share(
+        message.clone(),
+        signature.clone(),
+        signer.clone(),
+        pubkeys.clone()
+    )
+    .is_ok());
+
+    assert!(verify_signature_with_verifying_share(
+        message,
+        signature.clone(),
+        identifiers[1].clone(),
+        pubkeys.clone()
+    )
+    .is_err());
+
+    let other_message = Message {
+        data: "i am another message".as_bytes().to_vec(),
+    };
+    assert!(verify_signature_with_verifying_share(
+        other_message,
+        signature,
+        signer.clone(),
+        pubkeys
+    )
+    .is_err());
+}
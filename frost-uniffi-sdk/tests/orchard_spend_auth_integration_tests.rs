@@ -0,0 +1,92 @@
+#![cfg(feature = "redpallas")]
+use frost_uniffi_sdk::{
+    coordinator::{new_signing_package, Message},
+    randomized::{coordinator::aggregate, participant::sign, randomizer::randomizer_from_bytes},
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration,
+};
+use rand::thread_rng;
+use reddsa::frost::redpallas::RandomizedParams;
+
+mod helpers;
+use helpers::{key_package, round_1};
+
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+
+/// the signature of a FROST group randomized with the alpha of an Orchard
+/// action is a valid spendAuthSig for the rk of the action
+#[test]
+fn test_randomizer_from_alpha_signs_spend_auth_signature() {
+    let mut rng = thread_rng();
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: hex::decode("0e2da4d6c0ee1e73e83d4e1c5f3a5bd2d6a8e55b53b02b7bb6c8d7a6f2e1c103")
+            .unwrap(),
+    };
+    let sighash =
+        hex::decode("5d2ab3dc1e48ff9b63d2a2d6e8fdd1cbb0bd80a7e84e1c8a84db83c5e3c5a2f7").unwrap();
+    let alpha =
+        hex::decode("4a6f9c1d2e3b5a7c8d9e0f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d1e").unwrap();
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let (nonces, commitments) = round_1::<E>(&mut rng, &key_packages);
+
+    let message = Message {
+        data: sighash.clone(),
+    };
+    let signing_package =
+        new_signing_package(message, commitments.into_values().collect()).unwrap();
+
+    let randomizer = randomizer_from_bytes(alpha).unwrap();
+
+    let signature_shares = nonces
+        .iter()
+        .map(|(identifier, nonces)| {
+            sign(
+                signing_package.clone(),
+                nonces.clone(),
+                key_packages[identifier].clone(),
+                &randomizer,
+            )
+            .unwrap()
+        })
+        .collect();
+
+    let signature = aggregate(
+        signing_package,
+        signature_shares,
+        pubkeys.clone(),
+        randomizer.clone(),
+    )
+    .unwrap();
+
+    let randomized_params = RandomizedParams::from_randomizer(
+        pubkeys.into_public_key_package().unwrap().verifying_key(),
+        randomizer.into_randomizer::<E>().unwrap(),
+    );
+    let rk: [u8; 32] = randomized_params
+        .randomized_verifying_key()
+        .serialize()
+        .unwrap()
+        .try_into()
+        .unwrap();
+
+    let rk = reddsa::VerificationKey::<reddsa::orchard::SpendAuth>::try_from(rk).unwrap();
+    let spend_auth_sig: [u8; 64] = signature
+        .to_signature::<E>()
+        .unwrap()
+        .serialize()
+        .unwrap()
+        .try_into()
+        .unwrap();
+
+    assert!(rk
+        .verify(
+            &sighash,
+            &reddsa::Signature::<reddsa::orchard::SpendAuth>::from(spend_auth_sig)
+        )
+        .is_ok());
+}
@@ -160,3 +160,127 @@ fn check_keygen_with_dealer_with_secret_with_large_num_of_signers() {
         verify_randomized_signature(frost_randomizer, message, group_signature, pubkeys).is_ok()
     )
 }
+
+#[cfg(feature = "redpallas")]
+#[test]
+fn test_randomized_params_from_caller_randomizer() {
+    use frost_uniffi_sdk::randomized::randomizer::{
+        randomized_params_from_public_key_and_randomizer, randomizer_from_bytes,
+    };
+
+    let mut rng = thread_rng();
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let (_, commitments) = round_1::<E>(&mut rng, &key_packages);
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let signing_package =
+        new_signing_package(message, commitments.into_values().collect()).unwrap();
+
+    let randomized_params = RandomizedParams::new(
+        pubkeys.into_public_key_package().unwrap().verifying_key(),
+        &signing_package.to_signing_package().unwrap(),
+        rng,
+    )
+    .unwrap();
+
+    let randomizer =
+        randomizer_from_bytes(randomized_params.randomizer().serialize().to_vec()).unwrap();
+
+    let params = randomized_params_from_public_key_and_randomizer(pubkeys, randomizer).unwrap();
+
+    assert_eq!(
+        params.randomized_verifying_key().unwrap(),
+        randomized_params
+            .randomized_verifying_key()
+            .serialize()
+            .unwrap()
+    );
+
+    assert!(randomizer_from_bytes(vec![0xff; 32]).is_err());
+    assert!(randomizer_from_bytes(vec![0; 31]).is_err());
+}
+
+#[cfg(feature = "redpallas")]
+#[test]
+fn test_signature_verifies_against_randomized_verifying_key() {
+    use frost_uniffi_sdk::randomized::{
+        coordinator::verify_signature_with_randomized_verifying_key,
+        randomizer::{randomized_params_from_public_key_and_randomizer, randomizer_from_bytes},
+    };
+
+    let mut rng = thread_rng();
+
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+    let (nonces, commitments) = round_1::<E>(&mut rng, &key_packages);
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let signing_package =
+        new_signing_package(message.clone(), commitments.into_values().collect()).unwrap();
+
+    let randomizer = randomizer_from_bytes(
+        hex::decode("c1b2a3948576a8b9cadbecfd0e1f20314253647586978a9bacbdcedfe0f10213").unwrap(),
+    )
+    .unwrap();
+
+    let params =
+        randomized_params_from_public_key_and_randomizer(pubkeys.clone(), randomizer.clone())
+            .unwrap();
+
+    let rk = params.randomized_verifying_key().unwrap();
+    assert_eq!(
+        params.randomized_verifying_key_hex().unwrap(),
+        hex::encode(&rk)
+    );
+
+    // the randomizer of the params yields the same randomized verifying key
+    let same_params = randomized_params_from_public_key_and_randomizer(
+        pubkeys.clone(),
+        params.randomizer().unwrap(),
+    )
+    .unwrap();
+    assert_eq!(same_params.randomized_verifying_key().unwrap(), rk);
+
+    let signature_shares = nonces
+        .iter()
+        .map(|(identifier, nonces)| {
+            sign(
+                signing_package.clone(),
+                nonces.clone(),
+                key_packages[identifier].clone(),
+                &randomizer,
+            )
+            .unwrap()
+        })
+        .collect();
+
+    let signature = aggregate(signing_package, signature_shares, pubkeys, randomizer).unwrap();
+
+    assert!(
+        verify_signature_with_randomized_verifying_key(rk.clone(), message, signature.clone())
+            .is_ok()
+    );
+
+    let other_message = Message {
+        data: "another message".as_bytes().to_vec(),
+    };
+    assert!(verify_signature_with_randomized_verifying_key(rk, other_message, signature).is_err());
+}
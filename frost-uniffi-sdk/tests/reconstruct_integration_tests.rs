@@ -0,0 +1,42 @@
+use frost_uniffi_sdk::{
+    reconstruct::reconstruct_signing_key, trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, FrostKeyPackage,
+};
+
+mod helpers;
+use helpers::key_package;
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+#[test]
+fn test_reconstructed_signing_key_deals_the_same_group() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+
+    let threshold: Vec<FrostKeyPackage> = key_packages
+        .values()
+        .take(config.min_signers as usize)
+        .cloned()
+        .collect();
+
+    let secret = reconstruct_signing_key(threshold, pubkeys.clone()).unwrap();
+
+    let recovered = Configuration {
+        min_signers: config.min_signers,
+        max_signers: config.max_signers,
+        secret,
+    };
+
+    let (recovered_pubkeys, _) = trusted_dealer_keygen_from_configuration::<E>(&recovered).unwrap();
+
+    assert_eq!(recovered_pubkeys.verifying_key, pubkeys.verifying_key);
+}
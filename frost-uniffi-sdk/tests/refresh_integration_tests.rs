@@ -0,0 +1,48 @@
+use frost_uniffi_sdk::{
+    refresh::{compute_refreshing_shares_from, refresh_key_package},
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, ParticipantList,
+};
+
+mod helpers;
+use helpers::key_package;
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+#[test]
+fn test_trusted_dealer_refresh_keeps_verifying_key() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+
+    let participants = ParticipantList {
+        identifiers: key_packages.keys().cloned().collect(),
+    };
+
+    let mut refreshing = compute_refreshing_shares_from(pubkeys.clone(), 2, participants).unwrap();
+
+    assert_eq!(
+        refreshing.public_key_package.verifying_key,
+        pubkeys.verifying_key
+    );
+
+    for (identifier, key_package) in key_packages {
+        let refreshing_share = refreshing.secret_shares.remove(&identifier).unwrap();
+        let refreshed = refresh_key_package(refreshing_share, key_package.clone()).unwrap();
+
+        assert_eq!(refreshed.identifier, identifier);
+        assert_ne!(refreshed.data, key_package.data);
+        assert_ne!(
+            refreshing.public_key_package.verifying_shares[&identifier],
+            pubkeys.verifying_shares[&identifier]
+        );
+    }
+}
@@ -0,0 +1,54 @@
+use frost_uniffi_sdk::{
+    repair::{repair_share_step_1, repair_share_step_2, repair_share_step_3},
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, ParticipantIdentifier,
+};
+use std::collections::HashMap;
+
+mod helpers;
+use helpers::key_package;
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+#[test]
+fn test_repair_recovers_lost_key_package() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+
+    let mut identifiers: Vec<ParticipantIdentifier> = key_packages.keys().cloned().collect();
+    let lost = identifiers.remove(0);
+    let helpers = identifiers;
+
+    // deltas received by each helper, by sender
+    let mut received: HashMap<ParticipantIdentifier, HashMap<ParticipantIdentifier, String>> =
+        HashMap::new();
+    for helper in helpers.iter() {
+        let deltas =
+            repair_share_step_1(helpers.clone(), key_packages[helper].clone(), lost.clone())
+                .unwrap();
+        for (recipient, delta) in deltas {
+            received
+                .entry(recipient)
+                .or_default()
+                .insert(helper.clone(), delta);
+        }
+    }
+
+    let mut sigmas: HashMap<ParticipantIdentifier, String> = HashMap::new();
+    for (helper, deltas) in received {
+        sigmas.insert(helper, repair_share_step_2(deltas).unwrap());
+    }
+
+    let repaired = repair_share_step_3(sigmas, lost.clone(), pubkeys, config.min_signers).unwrap();
+
+    assert_eq!(repaired.data, key_packages[&lost].data);
+}
@@ -0,0 +1,82 @@
+use frost_core::Identifier;
+use frost_uniffi_sdk::{
+    reshare::{reshare_step_1, reshare_step_2},
+    trusted_dealer::trusted_dealer_keygen_from_configuration,
+    Configuration, FrostSecretKeyShare, ParticipantIdentifier,
+};
+use std::collections::HashMap;
+
+mod helpers;
+use helpers::key_package;
+
+#[cfg(feature = "redpallas")]
+type E = reddsa::frost::redpallas::PallasBlake2b512;
+#[cfg(not(feature = "redpallas"))]
+type E = frost_ed25519::Ed25519Sha512;
+
+#[test]
+fn test_reshare_keeps_verifying_key() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let new_config = Configuration {
+        min_signers: 3,
+        max_signers: 4,
+        secret: vec![],
+    };
+
+    let (pubkeys, shares) = trusted_dealer_keygen_from_configuration::<E>(&config).unwrap();
+    let key_packages = key_package::<E>(&shares);
+
+    let old_signers: Vec<ParticipantIdentifier> = key_packages
+        .keys()
+        .take(config.min_signers as usize)
+        .cloned()
+        .collect();
+
+    let new_participants: Vec<ParticipantIdentifier> = (1..=new_config.max_signers)
+        .map(|i| {
+            ParticipantIdentifier::from_identifier(Identifier::<E>::try_from(i).unwrap()).unwrap()
+        })
+        .collect();
+
+    // shares received by each new participant, by sender
+    let mut received: HashMap<
+        ParticipantIdentifier,
+        HashMap<ParticipantIdentifier, FrostSecretKeyShare>,
+    > = HashMap::new();
+    for signer in old_signers.iter() {
+        let shares = reshare_step_1(
+            key_packages[signer].clone(),
+            old_signers.clone(),
+            new_config.clone(),
+            new_participants.clone(),
+        )
+        .unwrap();
+        for (participant, share) in shares {
+            received
+                .entry(participant)
+                .or_default()
+                .insert(signer.clone(), share);
+        }
+    }
+
+    for participant in new_participants.iter() {
+        let result = reshare_step_2(
+            received.remove(participant).unwrap(),
+            pubkeys.clone(),
+            new_config.clone(),
+            new_participants.clone(),
+            participant.clone(),
+        )
+        .unwrap();
+
+        assert_eq!(
+            result.public_key_package.verifying_key,
+            pubkeys.verifying_key
+        );
+    }
+}
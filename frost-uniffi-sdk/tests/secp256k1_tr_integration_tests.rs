@@ -0,0 +1,208 @@
+use frost_uniffi_sdk::{
+    coordinator::Message,
+    dkg::lib::{DKGRound1Package, DKGRound2Package},
+    secp256k1_tr::{
+        secp256k1_tr_aggregate, secp256k1_tr_aggregate_with_tweak,
+        secp256k1_tr_generate_nonces_and_commitments, secp256k1_tr_identifier_from_uint16,
+        secp256k1_tr_new_signing_package, secp256k1_tr_part_1, secp256k1_tr_part_2,
+        secp256k1_tr_part_3, secp256k1_tr_sign, secp256k1_tr_sign_with_tweak,
+        secp256k1_tr_trusted_dealer_keygen_from, secp256k1_tr_tweak_public_key_package,
+        secp256k1_tr_verify_and_get_key_package_from, secp256k1_tr_verify_signature,
+        secp256k1_tr_verify_signature_share,
+    },
+    Configuration, FrostKeyPackage, FrostPublicKeyPackage, ParticipantIdentifier,
+};
+use std::collections::HashMap;
+
+#[test]
+fn test_secp256k1_tr_dkg_and_sign() {
+    let max_signers = 3;
+    let min_signers = 2;
+
+    let identifiers: Vec<ParticipantIdentifier> = (1..=max_signers)
+        .map(|i| secp256k1_tr_identifier_from_uint16(i).unwrap())
+        .collect();
+
+    let part_1: HashMap<_, _> = identifiers
+        .iter()
+        .map(|identifier| {
+            (
+                identifier.clone(),
+                secp256k1_tr_part_1(identifier.clone(), max_signers, min_signers).unwrap(),
+            )
+        })
+        .collect();
+
+    let round1_packages = |receiver: &ParticipantIdentifier| {
+        part_1
+            .iter()
+            .filter(|(sender, _)| *sender != receiver)
+            .map(|(sender, result)| (sender.clone(), result.package()))
+            .collect::<HashMap<ParticipantIdentifier, DKGRound1Package>>()
+    };
+
+    let mut part_2 = HashMap::new();
+    let mut round2_packages: HashMap<
+        ParticipantIdentifier,
+        HashMap<ParticipantIdentifier, DKGRound2Package>,
+    > = HashMap::new();
+    for identifier in identifiers.iter() {
+        let result =
+            secp256k1_tr_part_2(part_1[identifier].secret(), round1_packages(identifier)).unwrap();
+
+        for package in result.packages() {
+            round2_packages
+                .entry(package.identifier.clone())
+                .or_default()
+                .insert(identifier.clone(), package);
+        }
+
+        part_2.insert(identifier.clone(), result);
+    }
+
+    let mut public_key_package: Option<FrostPublicKeyPackage> = None;
+    let mut key_packages: HashMap<ParticipantIdentifier, FrostKeyPackage> = HashMap::new();
+    for identifier in identifiers.iter() {
+        let result = secp256k1_tr_part_3(
+            part_2[identifier].secret(),
+            round1_packages(identifier),
+            round2_packages[identifier].clone(),
+        )
+        .unwrap();
+
+        if let Some(public_key_package) = public_key_package.as_ref() {
+            assert_eq!(
+                public_key_package.verifying_key,
+                result.public_key_package.verifying_key
+            );
+        }
+
+        public_key_package = Some(result.public_key_package);
+        if key_packages.len() < min_signers as usize {
+            key_packages.insert(identifier.clone(), result.key_package);
+        }
+    }
+
+    let public_key_package = public_key_package.unwrap();
+
+    // a 33-byte compressed point
+    assert_eq!(public_key_package.verifying_key.len(), 66);
+
+    let mut nonces = HashMap::new();
+    let mut commitments = Vec::new();
+    for (identifier, key_package) in key_packages.iter() {
+        let commitment = secp256k1_tr_generate_nonces_and_commitments(key_package.clone()).unwrap();
+        nonces.insert(identifier.clone(), commitment.nonces);
+        commitments.push(commitment.commitments);
+    }
+
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let signing_package = secp256k1_tr_new_signing_package(message.clone(), commitments).unwrap();
+
+    let signature_shares = key_packages
+        .iter()
+        .map(|(identifier, key_package)| {
+            secp256k1_tr_sign(
+                signing_package.clone(),
+                nonces[identifier].clone(),
+                key_package.clone(),
+            )
+            .unwrap()
+        })
+        .collect();
+
+    let signature = secp256k1_tr_aggregate(
+        signing_package,
+        signature_shares,
+        public_key_package.clone(),
+    )
+    .unwrap();
+
+    // BIP-340 signatures are 64 bytes long
+    assert_eq!(signature.data.len(), 64);
+
+    assert!(secp256k1_tr_verify_signature(message, signature, public_key_package).is_ok());
+}
+
+#[test]
+fn test_secp256k1_tr_sign_with_tweak() {
+    let config = Configuration {
+        min_signers: 2,
+        max_signers: 3,
+        secret: vec![],
+    };
+
+    let keygen = secp256k1_tr_trusted_dealer_keygen_from(config).unwrap();
+    let public_key_package = keygen.public_key_package;
+    let key_packages: Vec<FrostKeyPackage> = keygen
+        .secret_shares
+        .into_values()
+        .take(2)
+        .map(|secret_share| secp256k1_tr_verify_and_get_key_package_from(secret_share).unwrap())
+        .collect();
+
+    let merkle_root = vec![7; 32];
+
+    let output_package = secp256k1_tr_tweak_public_key_package(
+        public_key_package.clone(),
+        Some(merkle_root.clone()),
+    )
+    .unwrap();
+    assert_ne!(
+        output_package.verifying_key,
+        public_key_package.verifying_key
+    );
+
+    let mut nonces = Vec::new();
+    let mut commitments = Vec::new();
+    for key_package in key_packages.iter() {
+        let commitment = secp256k1_tr_generate_nonces_and_commitments(key_package.clone()).unwrap();
+        nonces.push(commitment.nonces);
+        commitments.push(commitment.commitments);
+    }
+
+    let message = Message {
+        data: "i am a message".as_bytes().to_vec(),
+    };
+
+    let signing_package = secp256k1_tr_new_signing_package(message.clone(), commitments).unwrap();
+
+    let signature_shares: Vec<_> = key_packages
+        .iter()
+        .zip(nonces)
+        .map(|(key_package, nonces)| {
+            secp256k1_tr_sign_with_tweak(
+                signing_package.clone(),
+                nonces,
+                key_package.clone(),
+                Some(merkle_root.clone()),
+            )
+            .unwrap()
+        })
+        .collect();
+
+    for signature_share in signature_shares.iter() {
+        assert!(secp256k1_tr_verify_signature_share(
+            signing_package.clone(),
+            signature_share.clone(),
+            output_package.clone()
+        )
+        .is_ok());
+    }
+
+    let signature = secp256k1_tr_aggregate_with_tweak(
+        signing_package,
+        signature_shares,
+        public_key_package.clone(),
+        Some(merkle_root),
+    )
+    .unwrap();
+
+    assert!(
+        secp256k1_tr_verify_signature(message.clone(), signature.clone(), output_package).is_ok()
+    );
+    assert!(secp256k1_tr_verify_signature(message, signature, public_key_package).is_err());
+}
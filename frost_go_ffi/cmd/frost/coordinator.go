@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	frost "frost_go_ffi/frost_go_ffi"
+	"frost_go_ffi/frost_go_ffi/coordinatorhttp"
+)
+
+func runCoordinator(args []string, stdout io.Writer) error {
+	flags := newFlagSet("coordinator", "-public-key <public_key_package.json> -min <n> -token <file> [-listen <address>]")
+	publicKeyPackagePath := flags.String("public-key", "", "public key package of the group")
+	minSigners := uint16Flag(flags, "min", "minimum number of signers of the group")
+	tokenPath := flags.String("token", "", "file holding the bearer token required to create sessions")
+	listen := flags.String("listen", "localhost:8080", "address to listen on")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "public-key", "token"); err != nil {
+		return err
+	}
+
+	publicKeyPackage, err := readPublicKeyPackage(*publicKeyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	token, err := os.ReadFile(*tokenPath)
+	if err != nil {
+		return err
+	}
+
+	config := frost.Configuration{
+		MinSigners: *minSigners,
+		MaxSigners: uint16(len(publicKeyPackage.VerifyingShares)),
+		Secret:     []byte{},
+	}
+
+	server, err := coordinatorhttp.NewServer(config, publicKeyPackage, strings.TrimSpace(string(token)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "coordinating group %s on %s\n", publicKeyPackage.VerifyingKey, *listen)
+
+	return http.ListenAndServe(*listen, server)
+}
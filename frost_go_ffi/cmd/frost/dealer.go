@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+const publicKeyPackageFileName = "public_key_package.json"
+
+func runDealer(args []string, stdout io.Writer) error {
+	flags := newFlagSet("dealer", "-min <n> -max <n> -out <dir> [-identifiers <ids>] [-secret <hex>]")
+	minSigners := uint16Flag(flags, "min", "minimum number of signers")
+	maxSigners := uint16Flag(flags, "max", "number of participants")
+	identifiers := flags.String("identifiers", "", "comma separated identifiers of the participants. Numbers are used as is, any other string is hashed into an identifier. Defaults to 1..max")
+	secret := flags.String("secret", "", "hex encoded group signing key to split. A random one is generated by default")
+	out := flags.String("out", "", "directory to write the key packages to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "out"); err != nil {
+		return err
+	}
+
+	config := frost.Configuration{
+		MinSigners: *minSigners,
+		MaxSigners: *maxSigners,
+		Secret:     []byte{},
+	}
+
+	if *secret != "" {
+		secretBytes, err := decodeHex("secret", *secret)
+		if err != nil {
+			return err
+		}
+		config.Secret = secretBytes
+	}
+
+	// labels name the key package file of each participant
+	labels := make(map[frost.ParticipantIdentifier]string)
+	var keygen frost.TrustedKeyGeneration
+	var err error
+
+	if *identifiers != "" {
+		var participants frost.ParticipantList
+		for _, label := range splitList(*identifiers) {
+			identifier, err := parseParticipant(label)
+			if err != nil {
+				return err
+			}
+			labels[identifier] = label
+			participants.Identifiers = append(participants.Identifiers, identifier)
+		}
+		keygen, err = frost.TrustedDealerKeygenWithIdentifiers(config, participants)
+	} else {
+		for i := 1; i <= int(config.MaxSigners); i++ {
+			identifier, err := frost.IdentifierFromUint16(uint16(i))
+			if err != nil {
+				return err
+			}
+			labels[identifier] = strconv.Itoa(i)
+		}
+		keygen, err = frost.TrustedDealerKeygenFrom(config)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(*out, 0o700); err != nil {
+		return err
+	}
+
+	publicKeyPackageJson, err := frost.PublicKeyPackageToJson(keygen.PublicKeyPackage)
+	if err != nil {
+		return err
+	}
+
+	publicKeyPackagePath := filepath.Join(*out, publicKeyPackageFileName)
+	if err := writeFile(publicKeyPackagePath, []byte(publicKeyPackageJson), false); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "verifying key %s\n", keygen.PublicKeyPackage.VerifyingKey)
+	fmt.Fprintf(stdout, "wrote %s\n", publicKeyPackagePath)
+
+	for identifier, secretShare := range keygen.SecretShares {
+		keyPackage, err := frost.VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			return err
+		}
+
+		keyPackageJson, err := frost.KeyPackageToJson(keyPackage)
+		if err != nil {
+			return err
+		}
+
+		label, ok := labels[identifier]
+		if !ok || filepath.Base(label) != label {
+			label = identifierHex(identifier)
+		}
+
+		keyPackagePath := filepath.Join(*out, fmt.Sprintf("key_package_%s.json", label))
+		if err := writeFile(keyPackagePath, []byte(keyPackageJson), true); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(stdout, "wrote %s for participant %s\n", keyPackagePath, identifierHex(identifier))
+	}
+
+	return nil
+}
+
+// parseParticipant turns a label given on the command line into an
+// identifier.
+func parseParticipant(label string) (frost.ParticipantIdentifier, error) {
+	if n, err := strconv.ParseUint(label, 10, 16); err == nil {
+		return frost.IdentifierFromUint16(uint16(n))
+	}
+
+	return frost.IdentifierFromString(label)
+}
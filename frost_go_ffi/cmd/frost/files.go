@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// The commitment and signature share serializations of the bindings do
+// not include the identifier of the participant, so the files exchanged
+// with the coordinator wrap them together with it.
+
+type commitmentFile struct {
+	Identifier json.RawMessage `json:"identifier"`
+	Commitment json.RawMessage `json:"commitment"`
+}
+
+type signatureShareFile struct {
+	Identifier     json.RawMessage `json:"identifier"`
+	SignatureShare json.RawMessage `json:"signature_share"`
+}
+
+// signingPackageFile is sent by the coordinator to every signer.
+type signingPackageFile struct {
+	SigningPackage string          `json:"signing_package"`
+	Randomizer     json.RawMessage `json:"randomizer"`
+}
+
+// signatureFile holds a group signature along with the randomizer needed
+// to verify it.
+type signatureFile struct {
+	Signature  string          `json:"signature"`
+	Randomizer json.RawMessage `json:"randomizer"`
+}
+
+// nonces are secret and written with restricted permissions.
+type noncesFile struct {
+	Identifier json.RawMessage `json:"identifier"`
+	Nonces     string          `json:"nonces"`
+}
+
+func readJson(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+func writeJson(path string, v any, secret bool) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFile(path, append(data, '\n'), secret)
+}
+
+func writeFile(path string, data []byte, secret bool) error {
+	perm := os.FileMode(0o644)
+	if secret {
+		perm = 0o600
+	}
+
+	return os.WriteFile(path, data, perm)
+}
+
+func readKeyPackage(path string) (frost.FrostKeyPackage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return frost.FrostKeyPackage{}, err
+	}
+
+	return frost.JsonToKeyPackage(string(data))
+}
+
+func readPublicKeyPackage(path string) (frost.FrostPublicKeyPackage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return frost.FrostPublicKeyPackage{}, err
+	}
+
+	return frost.JsonToPublicKeyPackage(string(data))
+}
+
+func readMessage(path string) (frost.Message, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return frost.Message{}, err
+	}
+
+	return frost.Message{Data: data}, nil
+}
+
+func parseIdentifier(raw json.RawMessage) (frost.ParticipantIdentifier, error) {
+	identifier := frost.IdentifierFromJsonString(string(raw))
+	if identifier == nil {
+		return frost.ParticipantIdentifier{}, fmt.Errorf("malformed identifier %s", raw)
+	}
+
+	return *identifier, nil
+}
+
+// identifierHex returns the hex encoding of identifier, without the
+// quotes of its JSON serialization.
+func identifierHex(identifier frost.ParticipantIdentifier) string {
+	var s string
+	if err := json.Unmarshal([]byte(identifier.Data), &s); err != nil {
+		return identifier.Data
+	}
+	return s
+}
+
+func decodeHex(name string, s string) ([]byte, error) {
+	data, err := hex.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, fmt.Errorf("malformed %s: %w", name, err)
+	}
+
+	return data, nil
+}
+
+// splitList splits a comma separated flag value.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,128 @@
+// Command frost runs FROST ceremonies with the frost_uniffi_sdk Go bindings.
+//
+// Key material and every message exchanged between the participants and
+// the coordinator are read from and written to files, using the JSON
+// serializations of the bindings wherever they exist:
+//
+//	frost dealer           trusted dealer key generation
+//	frost commit           round 1: signing nonces and commitment
+//	frost coordinator      coordinator HTTP server for remote signers
+//	frost signing-package  coordinator: signing package and randomizer
+//	frost sign             round 2: signature share
+//	frost aggregate        coordinator: group signature
+//	frost verify           signature verification
+//
+// Run `frost <command> -h` for the flags of each command.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+)
+
+type command struct {
+	summary string
+	run     func(args []string, stdout io.Writer) error
+}
+
+var commands = map[string]command{
+	"dealer":          {"generate the key packages of a group with a trusted dealer", runDealer},
+	"commit":          {"generate the signing nonces and commitment of a participant", runCommit},
+	"coordinator":     {"serve the coordinator of a group over HTTP", runCoordinator},
+	"signing-package": {"create the signing package from the participants' commitments", runSigningPackage},
+	"sign":            {"produce the signature share of a participant", runSign},
+	"aggregate":       {"aggregate the signature shares into the group signature", runAggregate},
+	"verify":          {"verify a group signature", runVerify},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "frost: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	if len(args) == 0 {
+		usage(os.Stderr)
+		return flag.ErrHelp
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		usage(os.Stderr)
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+
+	return cmd.run(args[1:], stdout)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: frost <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(w, "  %-16s %s\n", name, commands[name].summary)
+	}
+}
+
+// newFlagSet returns a flag set for the named command that reports
+// errors instead of exiting, so that commands can be run from tests.
+func newFlagSet(name string, usage string) *flag.FlagSet {
+	flags := flag.NewFlagSet("frost "+name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: frost %s %s\n\n", name, usage)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// requireFlags fails with the usage of flags when any of the named
+// string flags is empty.
+func requireFlags(flags *flag.FlagSet, names ...string) error {
+	for _, name := range names {
+		if flags.Lookup(name).Value.String() == "" {
+			flags.Usage()
+			return fmt.Errorf("missing required flag -%s", name)
+		}
+	}
+	return nil
+}
+
+// uint16Value is a flag.Value for the numbers of signers, which the
+// bindings hold in a uint16: larger values are rejected instead of being
+// truncated.
+type uint16Value uint16
+
+func (v *uint16Value) String() string {
+	return strconv.FormatUint(uint64(*v), 10)
+}
+
+func (v *uint16Value) Set(s string) error {
+	n, err := strconv.ParseUint(s, 10, 16)
+	if err != nil {
+		return err
+	}
+	*v = uint16Value(n)
+	return nil
+}
+
+func uint16Flag(flags *flag.FlagSet, name string, usage string) *uint16 {
+	var v uint16
+	flags.Var((*uint16Value)(&v), name, usage)
+	return &v
+}
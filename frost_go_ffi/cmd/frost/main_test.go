@@ -0,0 +1,117 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func runFrost(t *testing.T, args ...string) {
+	t.Helper()
+
+	if err := run(args, io.Discard); err != nil {
+		t.Fatalf("Failed to run frost %s: %v", strings.Join(args, " "), err)
+	}
+}
+
+func TestCeremonyFromDealerToVerification(t *testing.T) {
+	dir := t.TempDir()
+	path := func(name string) string { return filepath.Join(dir, name) }
+
+	if err := os.WriteFile(path("message"), []byte("i am a message"), 0o644); err != nil {
+		t.Fatalf("Failed to write message: %v", err)
+	}
+
+	runFrost(t, "dealer", "-min", "2", "-max", "3", "-identifiers", "1,alice,bob", "-out", dir)
+
+	for _, signer := range []string{"1", "alice", "bob"} {
+		runFrost(t, "commit",
+			"-key", path("key_package_"+signer+".json"),
+			"-nonces", path("nonces_"+signer+".json"),
+			"-commitment", path("commitment_"+signer+".json"),
+		)
+	}
+
+	runFrost(t, "signing-package",
+		"-public-key", path(publicKeyPackageFileName),
+		"-message", path("message"),
+		"-commitments", path("commitment_1.json")+","+path("commitment_alice.json"),
+		"-out", path("signing_package.json"),
+	)
+
+	for _, signer := range []string{"1", "alice"} {
+		runFrost(t, "sign",
+			"-key", path("key_package_"+signer+".json"),
+			"-nonces", path("nonces_"+signer+".json"),
+			"-signing-package", path("signing_package.json"),
+			"-out", path("share_"+signer+".json"),
+		)
+
+		if _, err := os.Stat(path("nonces_" + signer + ".json")); !os.IsNotExist(err) {
+			t.Fatalf("Expected used nonces to be deleted, got %v", err)
+		}
+	}
+
+	// bob isn't part of the signing package, the nonces are gone anyway
+	err := run([]string{"sign",
+		"-key", path("key_package_bob.json"),
+		"-nonces", path("nonces_bob.json"),
+		"-signing-package", path("signing_package.json"),
+		"-out", path("share_bob.json"),
+	}, io.Discard)
+	if err == nil {
+		t.Fatalf("Expected signing without a commitment in the signing package to fail")
+	}
+
+	if _, err := os.Stat(path("nonces_bob.json")); !os.IsNotExist(err) {
+		t.Fatalf("Expected nonces of a failed signing to be deleted, got %v", err)
+	}
+
+	runFrost(t, "aggregate",
+		"-public-key", path(publicKeyPackageFileName),
+		"-signing-package", path("signing_package.json"),
+		"-shares", path("share_1.json")+","+path("share_alice.json"),
+		"-out", path("signature.json"),
+	)
+
+	runFrost(t, "verify",
+		"-public-key", path(publicKeyPackageFileName),
+		"-message", path("message"),
+		"-signature", path("signature.json"),
+	)
+
+	if err := os.WriteFile(path("message"), []byte("i am another message"), 0o644); err != nil {
+		t.Fatalf("Failed to write message: %v", err)
+	}
+
+	err = run([]string{"verify",
+		"-public-key", path(publicKeyPackageFileName),
+		"-message", path("message"),
+		"-signature", path("signature.json"),
+	}, io.Discard)
+	if err == nil {
+		t.Fatalf("Expected verification of another message to fail")
+	}
+}
+
+func TestDealerRejectsSignerCountsOutOfRange(t *testing.T) {
+	dir := t.TempDir()
+
+	// 65537 would be truncated to 1
+	for _, args := range [][]string{
+		{"dealer", "-min", "2", "-max", "65537", "-out", dir},
+		{"dealer", "-min", "65538", "-max", "3", "-out", dir},
+		{"dealer", "-min", "-1", "-max", "3", "-out", dir},
+	} {
+		err := run(args, io.Discard)
+		if err == nil || !strings.Contains(err.Error(), "out of range") && !strings.Contains(err.Error(), "invalid syntax") {
+			t.Fatalf("Expected frost %s to fail, got %v", strings.Join(args[1:], " "), err)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, publicKeyPackageFileName)); !os.IsNotExist(err) {
+		t.Fatalf("Expected no key packages to be written, got %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+func runCommit(args []string, stdout io.Writer) error {
+	flags := newFlagSet("commit", "-key <key_package.json> -nonces <file> -commitment <file>")
+	keyPackagePath := flags.String("key", "", "key package of the participant")
+	noncesPath := flags.String("nonces", "", "file to write the secret signing nonces to. frost sign deletes it before using it")
+	commitmentPath := flags.String("commitment", "", "file to write the commitment to, to be sent to the coordinator")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "key", "nonces", "commitment"); err != nil {
+		return err
+	}
+
+	keyPackage, err := readKeyPackage(*keyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	firstRoundCommitment, err := frost.GenerateNoncesAndCommitments(keyPackage)
+	if err != nil {
+		return err
+	}
+
+	commitmentJson, err := frost.CommitmentToJson(firstRoundCommitment.Commitments)
+	if err != nil {
+		return err
+	}
+
+	identifier := json.RawMessage(keyPackage.Identifier.Data)
+
+	err = writeJson(*noncesPath, noncesFile{
+		Identifier: identifier,
+		Nonces:     hex.EncodeToString(firstRoundCommitment.Nonces.Data),
+	}, true)
+	if err != nil {
+		return err
+	}
+
+	err = writeJson(*commitmentPath, commitmentFile{
+		Identifier: identifier,
+		Commitment: json.RawMessage(commitmentJson),
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "wrote %s and %s for participant %s\n", *noncesPath, *commitmentPath, identifierHex(keyPackage.Identifier))
+
+	return nil
+}
+
+func runSigningPackage(args []string, stdout io.Writer) error {
+	flags := newFlagSet("signing-package", "-public-key <public_key_package.json> -message <file> -commitments <files> -out <file>")
+	publicKeyPackagePath := flags.String("public-key", "", "public key package of the group")
+	messagePath := flags.String("message", "", "file holding the message to sign")
+	commitmentPaths := flags.String("commitments", "", "comma separated commitment files of the signers")
+	out := flags.String("out", "", "file to write the signing package to, to be sent to every signer")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "public-key", "message", "commitments", "out"); err != nil {
+		return err
+	}
+
+	publicKeyPackage, err := readPublicKeyPackage(*publicKeyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	message, err := readMessage(*messagePath)
+	if err != nil {
+		return err
+	}
+
+	var commitments []frost.FrostSigningCommitments
+	for _, path := range splitList(*commitmentPaths) {
+		var file commitmentFile
+		if err := readJson(path, &file); err != nil {
+			return err
+		}
+
+		identifier, err := parseIdentifier(file.Identifier)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		commitment, err := frost.JsonToCommitment(string(file.Commitment), identifier)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		commitments = append(commitments, commitment)
+	}
+
+	signingPackage, err := frost.NewSigningPackage(message, commitments)
+	if err != nil {
+		return err
+	}
+
+	randomizedParams, err := frost.RandomizedParamsFromPublicKeyAndSigningPackage(publicKeyPackage, signingPackage)
+	if err != nil {
+		return err
+	}
+	defer randomizedParams.Destroy()
+
+	randomizer, err := frost.RandomizerFromParams(randomizedParams)
+	if err != nil {
+		return err
+	}
+
+	randomizerJson, err := frost.RandomizerToJson(randomizer)
+	if err != nil {
+		return err
+	}
+
+	err = writeJson(*out, signingPackageFile{
+		SigningPackage: hex.EncodeToString(signingPackage.Data),
+		Randomizer:     json.RawMessage(randomizerJson),
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "wrote %s for %d signers\n", *out, len(commitments))
+
+	return nil
+}
+
+func runSign(args []string, stdout io.Writer) error {
+	flags := newFlagSet("sign", "-key <key_package.json> -nonces <file> -signing-package <file> -out <file>")
+	keyPackagePath := flags.String("key", "", "key package of the participant")
+	noncesPath := flags.String("nonces", "", "signing nonces written by frost commit")
+	signingPackagePath := flags.String("signing-package", "", "signing package sent by the coordinator")
+	out := flags.String("out", "", "file to write the signature share to, to be sent to the coordinator")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "key", "nonces", "signing-package", "out"); err != nil {
+		return err
+	}
+
+	keyPackage, err := readKeyPackage(*keyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	var nonces noncesFile
+	if err := readJson(*noncesPath, &nonces); err != nil {
+		return err
+	}
+
+	noncesIdentifier, err := parseIdentifier(nonces.Identifier)
+	if err != nil {
+		return fmt.Errorf("%s: %w", *noncesPath, err)
+	}
+
+	if noncesIdentifier != keyPackage.Identifier {
+		return fmt.Errorf("nonces of participant %s can't be used with the key package of participant %s",
+			identifierHex(noncesIdentifier), identifierHex(keyPackage.Identifier))
+	}
+
+	noncesData, err := decodeHex("nonces", nonces.Nonces)
+	if err != nil {
+		return err
+	}
+
+	signingPackage, randomizer, err := readSigningPackage(*signingPackagePath)
+	if err != nil {
+		return err
+	}
+
+	// nonces must never be used twice, not even when signing fails, so
+	// they are deleted before they are used
+	if err := os.Remove(*noncesPath); err != nil {
+		return fmt.Errorf("failed to delete nonces before using them: %w", err)
+	}
+
+	signatureShare, err := frost.Sign(signingPackage, frost.FrostSigningNonces{Data: noncesData}, keyPackage, randomizer)
+	if err != nil {
+		return err
+	}
+
+	signatureShareJson, err := frost.SignatureSharePackageToJson(signatureShare)
+	if err != nil {
+		return err
+	}
+
+	err = writeJson(*out, signatureShareFile{
+		Identifier:     json.RawMessage(signatureShare.Identifier.Data),
+		SignatureShare: json.RawMessage(signatureShareJson),
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "wrote %s for participant %s\n", *out, identifierHex(signatureShare.Identifier))
+
+	return nil
+}
+
+func runAggregate(args []string, stdout io.Writer) error {
+	flags := newFlagSet("aggregate", "-public-key <public_key_package.json> -signing-package <file> -shares <files> -out <file>")
+	publicKeyPackagePath := flags.String("public-key", "", "public key package of the group")
+	signingPackagePath := flags.String("signing-package", "", "signing package written by frost signing-package")
+	sharePaths := flags.String("shares", "", "comma separated signature share files of the signers")
+	out := flags.String("out", "", "file to write the group signature to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "public-key", "signing-package", "shares", "out"); err != nil {
+		return err
+	}
+
+	publicKeyPackage, err := readPublicKeyPackage(*publicKeyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	signingPackage, randomizer, err := readSigningPackage(*signingPackagePath)
+	if err != nil {
+		return err
+	}
+
+	var signatureShares []frost.FrostSignatureShare
+	var invalid []string
+	for _, path := range splitList(*sharePaths) {
+		var file signatureShareFile
+		if err := readJson(path, &file); err != nil {
+			return err
+		}
+
+		identifier, err := parseIdentifier(file.Identifier)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		signatureShare, err := frost.JsonToSignatureShare(string(file.SignatureShare), identifier)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		// check each share on its own to point at every faulty signer
+		err = frost.VerifySignatureShare(signingPackage, signatureShare, publicKeyPackage, randomizer)
+		if err != nil {
+			fmt.Fprintf(stdout, "invalid signature share from participant %s in %s: %v\n", identifierHex(identifier), path, err)
+			invalid = append(invalid, identifierHex(identifier))
+			continue
+		}
+
+		signatureShares = append(signatureShares, signatureShare)
+	}
+
+	if len(invalid) > 0 {
+		return fmt.Errorf("%d invalid signature shares from %v", len(invalid), invalid)
+	}
+
+	signature, err := frost.Aggregate(signingPackage, signatureShares, publicKeyPackage, randomizer)
+	if err != nil {
+		return err
+	}
+
+	randomizerJson, err := frost.RandomizerToJson(randomizer)
+	if err != nil {
+		return err
+	}
+
+	err = writeJson(*out, signatureFile{
+		Signature:  hex.EncodeToString(signature.Data),
+		Randomizer: json.RawMessage(randomizerJson),
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(stdout, "wrote %s\n", *out)
+
+	return nil
+}
+
+func runVerify(args []string, stdout io.Writer) error {
+	flags := newFlagSet("verify", "-public-key <public_key_package.json> -message <file> -signature <file>")
+	publicKeyPackagePath := flags.String("public-key", "", "public key package of the group")
+	messagePath := flags.String("message", "", "file holding the signed message")
+	signaturePath := flags.String("signature", "", "signature written by frost aggregate")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := requireFlags(flags, "public-key", "message", "signature"); err != nil {
+		return err
+	}
+
+	publicKeyPackage, err := readPublicKeyPackage(*publicKeyPackagePath)
+	if err != nil {
+		return err
+	}
+
+	message, err := readMessage(*messagePath)
+	if err != nil {
+		return err
+	}
+
+	var file signatureFile
+	if err := readJson(*signaturePath, &file); err != nil {
+		return err
+	}
+
+	signatureData, err := decodeHex("signature", file.Signature)
+	if err != nil {
+		return err
+	}
+
+	if file.Randomizer == nil {
+		return errors.New("signature file has no randomizer")
+	}
+
+	randomizer, err := frost.JsonToRandomizer(string(file.Randomizer))
+	if err != nil {
+		return err
+	}
+
+	err = frost.VerifyRandomizedSignature(randomizer, message, frost.FrostSignature{Data: signatureData}, publicKeyPackage)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(stdout, "signature is valid")
+
+	return nil
+}
+
+func readSigningPackage(path string) (frost.FrostSigningPackage, frost.FrostRandomizer, error) {
+	var file signingPackageFile
+	if err := readJson(path, &file); err != nil {
+		return frost.FrostSigningPackage{}, frost.FrostRandomizer{}, err
+	}
+
+	signingPackageData, err := decodeHex("signing package", file.SigningPackage)
+	if err != nil {
+		return frost.FrostSigningPackage{}, frost.FrostRandomizer{}, err
+	}
+
+	randomizer, err := frost.JsonToRandomizer(string(file.Randomizer))
+	if err != nil {
+		return frost.FrostSigningPackage{}, frost.FrostRandomizer{}, err
+	}
+
+	return frost.FrostSigningPackage{Data: signingPackageData}, randomizer, nil
+}
@@ -0,0 +1,97 @@
+// Package commkey holds the communication keys of the participants of a
+// FROST group, X25519 keys unrelated to FROST key material, and the
+// Noise_K cipher that encrypts the messages they exchange. They are used
+// both by the frostd package, to log in to a frostd server and talk
+// through it, and by the DkgChannel of the frost_uniffi_sdk bindings.
+package commkey
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// ErrMalformedPublicKey is returned for communication keys that are not
+// 32 bytes long.
+var ErrMalformedPublicKey = errors.New("malformed communication public key")
+
+// PublicKey is the X25519 communication key that identifies a
+// participant, e.g. a user of a frostd server. It is hex encoded on the
+// wire.
+type PublicKey []byte
+
+// ParsePublicKey parses the hex encoding of a communication key.
+func ParsePublicKey(s string) (PublicKey, error) {
+	var key PublicKey
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (k PublicKey) String() string {
+	return hex.EncodeToString(k)
+}
+
+// Equal tells whether k and other are the same key.
+func (k PublicKey) Equal(other PublicKey) bool {
+	return bytes.Equal(k, other)
+}
+
+func (k PublicKey) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+func (k *PublicKey) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil || len(decoded) != curve25519.PointSize {
+		return ErrMalformedPublicKey
+	}
+	*k = decoded
+	return nil
+}
+
+// KeyPair is the communication key pair of a participant. It encrypts the
+// messages to other participants and signs the challenges of a frostd
+// login.
+type KeyPair struct {
+	Public  PublicKey
+	private []byte
+}
+
+// GenerateKeyPair generates a random communication key pair.
+func GenerateKeyPair() (*KeyPair, error) {
+	private := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(private); err != nil {
+		return nil, err
+	}
+	return NewKeyPair(private)
+}
+
+// NewKeyPair returns the key pair of an X25519 private key.
+func NewKeyPair(private []byte) (*KeyPair, error) {
+	public, err := curve25519.X25519(private, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{Public: public, private: append([]byte{}, private...)}, nil
+}
+
+// Private returns the X25519 private key, to be stored as a secret.
+func (k *KeyPair) Private() []byte {
+	return append([]byte{}, k.private...)
+}
+
+// Sign produces the XEdDSA signature of message used to log in to a
+// frostd server.
+func (k *KeyPair) Sign(message []byte) ([]byte, error) {
+	return xeddsaSign(k.private, message, rand.Reader)
+}
+
+// VerifySignature checks an XEdDSA signature of message by publicKey.
+func VerifySignature(publicKey PublicKey, message []byte, signature []byte) bool {
+	return xeddsaVerify(publicKey, message, signature)
+}
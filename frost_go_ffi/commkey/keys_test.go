@@ -0,0 +1,241 @@
+package commkey
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+)
+
+func TestCalculateKeyPairMatchesX25519(t *testing.T) {
+	for _, seed := range []byte{1, 7, 42} {
+		keyPair, err := NewKeyPair(bytes.Repeat([]byte{seed}, 32))
+		if err != nil {
+			t.Fatalf("Failed to create key pair: %v", err)
+		}
+
+		publicKey, a, err := calculateKeyPair(keyPair.Private())
+		if err != nil {
+			t.Fatalf("Failed to calculate key pair: %v", err)
+		}
+
+		point, err := new(edwards25519.Point).SetBytes(publicKey)
+		if err != nil {
+			t.Fatalf("Failed to decode Edwards public key: %v", err)
+		}
+
+		if !bytes.Equal(point.BytesMontgomery(), keyPair.Public) {
+			t.Fatalf("Expected Montgomery form %x, got %x", []byte(keyPair.Public), point.BytesMontgomery())
+		}
+
+		if new(edwards25519.Point).ScalarBaseMult(a).Equal(point) != 1 {
+			t.Fatalf("Expected the public key of the scalar")
+		}
+	}
+}
+
+func TestKeyPairSignatures(t *testing.T) {
+	keyPair, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	other, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	message := []byte("i am a challenge")
+
+	signature, err := keyPair.Sign(message)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	if !VerifySignature(keyPair.Public, message, signature) {
+		t.Fatalf("Expected the signature to verify")
+	}
+
+	if VerifySignature(other.Public, message, signature) {
+		t.Fatalf("Expected the signature not to verify with another key")
+	}
+
+	if VerifySignature(keyPair.Public, []byte("i am another challenge"), signature) {
+		t.Fatalf("Expected the signature not to verify for another message")
+	}
+}
+
+func TestCipherRoundTrip(t *testing.T) {
+	alice, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	bob, err := GenerateKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to generate key pair: %v", err)
+	}
+
+	aliceCipher := NewCipher(alice, []PublicKey{bob.Public})
+	bobCipher := NewCipher(bob, []PublicKey{alice.Public})
+
+	// the first message carries the handshake, the others don't
+	for _, plaintext := range []string{"handshake", "transport", "another one"} {
+		ciphertext, err := aliceCipher.Encrypt(bob.Public, []byte(plaintext))
+		if err != nil {
+			t.Fatalf("Failed to encrypt: %v", err)
+		}
+
+		decrypted, err := bobCipher.Decrypt(alice.Public, ciphertext)
+		if err != nil {
+			t.Fatalf("Failed to decrypt: %v", err)
+		}
+
+		if string(decrypted) != plaintext {
+			t.Fatalf("Expected %q, got %q", plaintext, decrypted)
+		}
+	}
+
+	ciphertext, err := bobCipher.Encrypt(alice.Public, []byte("reply"))
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	ciphertext[len(ciphertext)-1] ^= 1
+	if _, err := aliceCipher.Decrypt(bob.Public, ciphertext); !errors.Is(err, ErrCipherDecryption) {
+		t.Fatalf("Expected ErrCipherDecryption, got %v", err)
+	}
+
+	if _, err := aliceCipher.Encrypt(alice.Public, []byte("to myself")); !errors.Is(err, ErrCipherUnknownPeer) {
+		t.Fatalf("Expected ErrCipherUnknownPeer, got %v", err)
+	}
+}
+
+// XEdDSA signatures are Ed25519 signatures by the Edwards form of the
+// X25519 key with a sign bit of 0, so crypto/ed25519 verifies them.
+func TestXEdDSASignaturesVerifyAsEd25519(t *testing.T) {
+	for _, seed := range []byte{1, 7, 42} {
+		keyPair, err := NewKeyPair(bytes.Repeat([]byte{seed}, 32))
+		if err != nil {
+			t.Fatalf("Failed to create key pair: %v", err)
+		}
+
+		message := []byte("i am a challenge")
+		signature, err := keyPair.Sign(message)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		// y = (u - 1) / (u + 1)
+		u, err := new(field.Element).SetBytes(keyPair.Public)
+		if err != nil {
+			t.Fatalf("Failed to decode public key: %v", err)
+		}
+		one := new(field.Element).One()
+		y := new(field.Element).Subtract(u, one)
+		y.Multiply(y, new(field.Element).Invert(new(field.Element).Add(u, one)))
+
+		if !ed25519.Verify(y.Bytes(), message, signature) {
+			t.Fatalf("Expected the signature to verify as an Ed25519 signature")
+		}
+	}
+}
+
+// TestCipherNoiseKVectors checks the Noise_K_25519_ChaChaPoly_BLAKE2s
+// vectors of vectors.txt in github.com/flynn/noise v1.1.0, generated with
+// the cacophony reference implementation: the handshake message and the
+// first transport message, from the initiator, without and with a
+// prologue.
+func TestCipherNoiseKVectors(t *testing.T) {
+	mustHex := func(s string) []byte {
+		decoded, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatalf("Failed to decode %s: %v", s, err)
+		}
+		return decoded
+	}
+
+	initiator, err := NewKeyPair(mustHex("000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f"))
+	if err != nil {
+		t.Fatalf("Failed to create key pair: %v", err)
+	}
+
+	responder, err := NewKeyPair(mustHex("0102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f20"))
+	if err != nil {
+		t.Fatalf("Failed to create key pair: %v", err)
+	}
+
+	ephemeral := mustHex("202122232425262728292a2b2c2d2e2f303132333435363738393a3b3c3d3e3f")
+
+	type vectorMessage struct {
+		payload    []byte
+		ciphertext []byte
+	}
+
+	vectors := []struct {
+		prologue []byte
+		messages []vectorMessage
+	}{
+		{
+			messages: []vectorMessage{
+				{
+					payload:    mustHex("746573745f6d73675f30"),
+					ciphertext: mustHex("358072d6365880d1aeea329adf9121383851ed21a28e3b75e965d0d2cd166254b9404e5db0f97c582cc85908ec714e1ab58dc4d2a93b13af4017"),
+				},
+				{
+					payload:    mustHex("79656c6c6f777375626d6172696e65"),
+					ciphertext: mustHex("a191382d64297aedea686db4812eb3415ac6a86b283fa7df9091fe8d985d11"),
+				},
+			},
+		},
+		{
+			prologue: mustHex("6e6f74736563726574"),
+			messages: []vectorMessage{
+				{
+					payload:    mustHex("746573745f6d73675f30"),
+					ciphertext: mustHex("358072d6365880d1aeea329adf9121383851ed21a28e3b75e965d0d2cd166254b9404e5db0f97c582cc8df110972e1eea4a2774b24609ba244af"),
+				},
+				{
+					payload:    mustHex("79656c6c6f777375626d6172696e65"),
+					ciphertext: mustHex("a191382d64297aedea686db4812eb3415ac6a86b283fa7df9091fe8d985d11"),
+				},
+			},
+		},
+	}
+
+	for _, vector := range vectors {
+		initiatorCipher := NewCipherWithPrologue(initiator, []PublicKey{responder.Public}, vector.prologue)
+		initiatorCipher.random = bytes.NewReader(ephemeral)
+		responderCipher := NewCipherWithPrologue(responder, []PublicKey{initiator.Public}, vector.prologue)
+
+		for _, message := range vector.messages {
+			ciphertext, err := initiatorCipher.Encrypt(responder.Public, message.payload)
+			if err != nil {
+				t.Fatalf("Failed to encrypt: %v", err)
+			}
+
+			if !bytes.Equal(ciphertext, message.ciphertext) {
+				t.Fatalf("Expected ciphertext %x, got %x", message.ciphertext, ciphertext)
+			}
+
+			plaintext, err := responderCipher.Decrypt(initiator.Public, message.ciphertext)
+			if err != nil {
+				t.Fatalf("Failed to decrypt: %v", err)
+			}
+
+			if !bytes.Equal(plaintext, message.payload) {
+				t.Fatalf("Expected payload %x, got %x", message.payload, plaintext)
+			}
+		}
+	}
+
+	// a handshake only decrypts with its prologue
+	ciphertext := vectors[1].messages[0].ciphertext
+	if _, err := NewCipher(responder, []PublicKey{initiator.Public}).Decrypt(initiator.Public, ciphertext); !errors.Is(err, ErrCipherDecryption) {
+		t.Fatalf("Expected ErrCipherDecryption, got %v", err)
+	}
+}
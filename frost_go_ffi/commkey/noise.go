@@ -0,0 +1,283 @@
+package commkey
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"io"
+	"sync"
+
+	"golang.org/x/crypto/blake2s"
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/curve25519"
+)
+
+// Messages between the participants of a session are encrypted end to end
+// with the one-way Noise_K_25519_ChaChaPoly_BLAKE2s pattern
+// (https://noiseprotocol.org/noise.html), whose handshake authenticates
+// both communication keys. The first message to a peer carries the
+// handshake, the following ones use the transport keys it established.
+
+const noiseProtocolName = "Noise_K_25519_ChaChaPoly_BLAKE2s"
+
+// maxNoiseMessage is the largest message Noise allows.
+const maxNoiseMessage = 65535
+
+// Err* are used for checking Cipher errors with `errors.Is`
+var ErrCipherUnknownPeer = errors.New("no communication key for peer")
+var ErrCipherDecryption = errors.New("message failed to decrypt")
+var ErrCipherMessageTooLarge = errors.New("message too large for a Noise message")
+
+type noiseCipherState struct {
+	key   [chacha20poly1305.KeySize]byte
+	nonce uint64
+}
+
+func (c *noiseCipherState) seal(ad, plaintext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(c.key[:])
+	if err != nil {
+		return nil, err
+	}
+	ciphertext := aead.Seal(nil, c.nonceBytes(), plaintext, ad)
+	c.nonce++
+	return ciphertext, nil
+}
+
+func (c *noiseCipherState) open(ad, ciphertext []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.New(c.key[:])
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, c.nonceBytes(), ciphertext, ad)
+	if err != nil {
+		return nil, ErrCipherDecryption
+	}
+	c.nonce++
+	return plaintext, nil
+}
+
+func (c *noiseCipherState) nonceBytes() []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.LittleEndian.PutUint64(nonce[4:], c.nonce)
+	return nonce
+}
+
+// noiseHandshake is the symmetric state of a Noise K handshake.
+type noiseHandshake struct {
+	ck     [blake2s.Size]byte
+	h      [blake2s.Size]byte
+	cipher *noiseCipherState
+}
+
+func newBlake2s() hash.Hash {
+	h, _ := blake2s.New256(nil)
+	return h
+}
+
+// newNoiseHandshake initializes the symmetric state with prologue and
+// the static keys of the initiator and of the responder as pre-messages.
+func newNoiseHandshake(prologue, initiator, responder []byte) *noiseHandshake {
+	var hs noiseHandshake
+	copy(hs.h[:], noiseProtocolName)
+	hs.ck = hs.h
+	hs.mixHash(prologue)
+	hs.mixHash(initiator)
+	hs.mixHash(responder)
+	return &hs
+}
+
+func (hs *noiseHandshake) mixHash(data []byte) {
+	h := newBlake2s()
+	h.Write(hs.h[:])
+	h.Write(data)
+	copy(hs.h[:], h.Sum(nil))
+}
+
+func (hs *noiseHandshake) hkdf(ikm []byte) ([]byte, []byte) {
+	mac := hmac.New(newBlake2s, hs.ck[:])
+	mac.Write(ikm)
+	temp := mac.Sum(nil)
+
+	mac = hmac.New(newBlake2s, temp)
+	mac.Write([]byte{1})
+	out1 := mac.Sum(nil)
+
+	mac = hmac.New(newBlake2s, temp)
+	mac.Write(out1)
+	mac.Write([]byte{2})
+	return out1, mac.Sum(nil)
+}
+
+func (hs *noiseHandshake) mixKey(ikm []byte) {
+	ck, key := hs.hkdf(ikm)
+	copy(hs.ck[:], ck)
+	hs.cipher = &noiseCipherState{}
+	copy(hs.cipher.key[:], key)
+}
+
+func (hs *noiseHandshake) encryptAndHash(plaintext []byte) ([]byte, error) {
+	ciphertext, err := hs.cipher.seal(hs.h[:], plaintext)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixHash(ciphertext)
+	return ciphertext, nil
+}
+
+func (hs *noiseHandshake) decryptAndHash(ciphertext []byte) ([]byte, error) {
+	plaintext, err := hs.cipher.open(hs.h[:], ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixHash(ciphertext)
+	return plaintext, nil
+}
+
+// split returns the transport cipher from the initiator to the responder,
+// the only direction of a one-way pattern.
+func (hs *noiseHandshake) split() *noiseCipherState {
+	key, _ := hs.hkdf(nil)
+	cipher := &noiseCipherState{}
+	copy(cipher.key[:], key)
+	return cipher
+}
+
+// Cipher encrypts the messages exchanged with the peers of a session. It
+// keeps a Noise state for each direction of each peer, so every message to
+// and from a peer must go through the same Cipher, in order.
+//
+// A Cipher is safe for concurrent use by multiple goroutines.
+type Cipher struct {
+	mu       sync.Mutex
+	keyPair  *KeyPair
+	prologue []byte
+	peers    map[string]PublicKey
+	send     map[string]*noiseCipherState
+	receive  map[string]*noiseCipherState
+	// random is where ephemeral keys are read from
+	random io.Reader
+}
+
+// NewCipher creates a Cipher for the messages of keyPair with peers.
+func NewCipher(keyPair *KeyPair, peers []PublicKey) *Cipher {
+	return NewCipherWithPrologue(keyPair, peers, nil)
+}
+
+// NewCipherWithPrologue creates a Cipher whose handshakes are bound to
+// prologue: a handshake message only decrypts with the prologue it was
+// encrypted with.
+func NewCipherWithPrologue(keyPair *KeyPair, peers []PublicKey, prologue []byte) *Cipher {
+	c := &Cipher{
+		keyPair:  keyPair,
+		prologue: append([]byte{}, prologue...),
+		peers:    make(map[string]PublicKey, len(peers)),
+		send:     make(map[string]*noiseCipherState),
+		receive:  make(map[string]*noiseCipherState),
+		random:   rand.Reader,
+	}
+	for _, peer := range peers {
+		c.peers[peer.String()] = peer
+	}
+	return c
+}
+
+// Encrypt encrypts plaintext for recipient.
+func (c *Cipher) Encrypt(recipient PublicKey, plaintext []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.peers[recipient.String()]; !ok {
+		return nil, ErrCipherUnknownPeer
+	}
+
+	if transport, ok := c.send[recipient.String()]; ok {
+		if len(plaintext)+chacha20poly1305.Overhead > maxNoiseMessage {
+			return nil, ErrCipherMessageTooLarge
+		}
+		return transport.seal(nil, plaintext)
+	}
+
+	// -> e, es, ss
+	if len(plaintext)+len(c.keyPair.Public)+chacha20poly1305.Overhead > maxNoiseMessage {
+		return nil, ErrCipherMessageTooLarge
+	}
+
+	ephemeral := make([]byte, curve25519.ScalarSize)
+	if _, err := io.ReadFull(c.random, ephemeral); err != nil {
+		return nil, err
+	}
+	ephemeralPublic, err := curve25519.X25519(ephemeral, curve25519.Basepoint)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := newNoiseHandshake(c.prologue, c.keyPair.Public, recipient)
+	hs.mixHash(ephemeralPublic)
+
+	es, err := curve25519.X25519(ephemeral, recipient)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixKey(es)
+
+	ss, err := curve25519.X25519(c.keyPair.private, recipient)
+	if err != nil {
+		return nil, err
+	}
+	hs.mixKey(ss)
+
+	ciphertext, err := hs.encryptAndHash(plaintext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.send[recipient.String()] = hs.split()
+
+	return append(ephemeralPublic, ciphertext...), nil
+}
+
+// Decrypt decrypts a message from sender.
+func (c *Cipher) Decrypt(sender PublicKey, ciphertext []byte) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.peers[sender.String()]; !ok {
+		return nil, ErrCipherUnknownPeer
+	}
+
+	if transport, ok := c.receive[sender.String()]; ok {
+		return transport.open(nil, ciphertext)
+	}
+
+	// <- e, es, ss
+	if len(ciphertext) < curve25519.PointSize+chacha20poly1305.Overhead {
+		return nil, ErrCipherDecryption
+	}
+	ephemeralPublic, ciphertext := ciphertext[:curve25519.PointSize], ciphertext[curve25519.PointSize:]
+
+	hs := newNoiseHandshake(c.prologue, sender, c.keyPair.Public)
+	hs.mixHash(ephemeralPublic)
+
+	es, err := curve25519.X25519(c.keyPair.private, ephemeralPublic)
+	if err != nil {
+		return nil, ErrCipherDecryption
+	}
+	hs.mixKey(es)
+
+	ss, err := curve25519.X25519(c.keyPair.private, sender)
+	if err != nil {
+		return nil, ErrCipherDecryption
+	}
+	hs.mixKey(ss)
+
+	plaintext, err := hs.decryptAndHash(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+
+	c.receive[sender.String()] = hs.split()
+
+	return plaintext, nil
+}
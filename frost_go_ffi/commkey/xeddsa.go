@@ -0,0 +1,119 @@
+package commkey
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"io"
+
+	"filippo.io/edwards25519"
+	"filippo.io/edwards25519/field"
+)
+
+// XEdDSA signatures (https://signal.org/docs/specifications/xeddsa/) let
+// a communication key, an X25519 key, sign the challenges of the frostd
+// login. Signing handles the private communication key with the constant
+// time arithmetic of filippo.io/edwards25519; verification only handles
+// public values.
+
+// calculateKeyPair returns the Edwards public key of the X25519
+// privateKey, with a sign bit of 0, and the scalar it is the public key
+// of.
+func calculateKeyPair(privateKey []byte) ([]byte, *edwards25519.Scalar, error) {
+	k, err := edwards25519.NewScalar().SetBytesWithClamping(privateKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey := new(edwards25519.Point).ScalarBaseMult(k).Bytes()
+
+	// the sign of the public key isn't secret
+	a := k
+	if publicKey[31]&0x80 != 0 {
+		a = edwards25519.NewScalar().Negate(k)
+		publicKey[31] &= 0x7f
+	}
+
+	return publicKey, a, nil
+}
+
+// hashToScalar reduces the SHA-512 digest of parts modulo the order of
+// the base point.
+func hashToScalar(parts ...[]byte) *edwards25519.Scalar {
+	h := sha512.New()
+	for _, part := range parts {
+		h.Write(part)
+	}
+
+	s, err := edwards25519.NewScalar().SetUniformBytes(h.Sum(nil))
+	if err != nil {
+		panic("commkey: SHA-512 digest is not 64 bytes long")
+	}
+	return s
+}
+
+// xeddsaSign signs message with the X25519 privateKey, reading the 64
+// bytes of nonce randomness from random.
+func xeddsaSign(privateKey []byte, message []byte, random io.Reader) ([]byte, error) {
+	z := make([]byte, 64)
+	if _, err := io.ReadFull(random, z); err != nil {
+		return nil, err
+	}
+
+	publicKey, a, err := calculateKeyPair(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// hash_1 prefixes its input with 2^256 - 2 in little endian
+	prefix := bytes.Repeat([]byte{0xff}, 32)
+	prefix[0] = 0xfe
+
+	r := hashToScalar(prefix, a.Bytes(), message, z)
+	rPoint := new(edwards25519.Point).ScalarBaseMult(r).Bytes()
+	h := hashToScalar(rPoint, publicKey, message)
+
+	s := edwards25519.NewScalar().MultiplyAdd(h, a, r)
+
+	return append(rPoint, s.Bytes()...), nil
+}
+
+// xeddsaVerify checks an XEdDSA signature of message by the X25519
+// publicKey.
+func xeddsaVerify(publicKey []byte, message []byte, signature []byte) bool {
+	if len(publicKey) != 32 || len(signature) != 64 {
+		return false
+	}
+
+	rBytes, sBytes := signature[:32], signature[32:]
+
+	// u must be reduced and s below 2^253
+	u, err := new(field.Element).SetBytes(publicKey)
+	if err != nil || !bytes.Equal(u.Bytes(), publicKey) || sBytes[31]&0xe0 != 0 {
+		return false
+	}
+
+	s, err := edwards25519.NewScalar().SetCanonicalBytes(sBytes)
+	if err != nil {
+		return false
+	}
+
+	// convert_mont: y = (u - 1) / (u + 1), with a sign bit of 0
+	one := new(field.Element).One()
+	denominator := new(field.Element).Add(u, one)
+	if denominator.Equal(new(field.Element).Zero()) == 1 {
+		return false
+	}
+	y := new(field.Element).Subtract(u, one)
+	y.Multiply(y, new(field.Element).Invert(denominator))
+
+	a, err := new(edwards25519.Point).SetBytes(y.Bytes())
+	if err != nil {
+		return false
+	}
+
+	h := hashToScalar(rBytes, a.Bytes(), message)
+
+	// R == sB - hA
+	check := new(edwards25519.Point).VarTimeDoubleScalarBaseMult(edwards25519.NewScalar().Negate(h), a, s)
+	return bytes.Equal(check.Bytes(), rBytes)
+}
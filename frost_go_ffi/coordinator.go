@@ -0,0 +1,469 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// CoordinatorPhase is the stage of the signing round a Coordinator is in.
+type CoordinatorPhase int
+
+const (
+	// CoordinatorPhaseCollectingCommitments is the initial phase, where the
+	// coordinator gathers round 1 commitments from the participants.
+	CoordinatorPhaseCollectingCommitments CoordinatorPhase = iota
+	// CoordinatorPhaseRound2 starts once the signing package was created.
+	// The coordinator gathers signature shares from the participants.
+	CoordinatorPhaseRound2
+	// CoordinatorPhaseAggregated is the final phase, reached when the
+	// signature shares were aggregated into a signature.
+	CoordinatorPhaseAggregated
+)
+
+func (p CoordinatorPhase) String() string {
+	switch p {
+	case CoordinatorPhaseCollectingCommitments:
+		return "CollectingCommitments"
+	case CoordinatorPhaseRound2:
+		return "Round2"
+	case CoordinatorPhaseAggregated:
+		return "Aggregated"
+	default:
+		return fmt.Sprintf("CoordinatorPhase(%d)", int(p))
+	}
+}
+
+// Err* are used for checking Coordinator errors with `errors.Is`
+var ErrCoordinatorUnknownIdentifier = errors.New("participant identifier is not part of the public key package")
+var ErrCoordinatorRepeatedCommitment = errors.New("commitment already received from participant")
+var ErrCoordinatorRepeatedSignatureShare = errors.New("signature share already received from participant")
+var ErrCoordinatorUnexpectedSignatureShare = errors.New("participant did not send a commitment for this signing package")
+var ErrCoordinatorNotEnoughParticipants = errors.New("public key package has fewer participants than the minimum number of signers")
+var ErrCoordinatorIncorrectNumberOfCommitments = errors.New("incorrect number of commitments")
+var ErrCoordinatorIncorrectNumberOfSignatureShares = errors.New("incorrect number of signature shares")
+var ErrCoordinatorInvalidPhase = errors.New("operation not allowed in the current coordinator phase")
+var ErrCoordinatorInvalidSignatureShare = errors.New("invalid signature share")
+
+// CoordinatorIdentifierError is returned when the input received from a
+// given participant is rejected by the Coordinator.
+type CoordinatorIdentifierError struct {
+	Identifier ParticipantIdentifier
+	Err        error
+}
+
+func (e *CoordinatorIdentifierError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Identifier.Data)
+}
+
+func (e *CoordinatorIdentifierError) Unwrap() error {
+	return e.Err
+}
+
+// CoordinatorCountError is returned when the number of commitments or
+// signature shares received doesn't meet the bounds of the Configuration.
+type CoordinatorCountError struct {
+	Min   uint16
+	Max   uint16
+	Found int
+	Err   error
+}
+
+func (e *CoordinatorCountError) Error() string {
+	return fmt.Sprintf("%s: expected between %d and %d, found %d", e.Err.Error(), e.Min, e.Max, e.Found)
+}
+
+func (e *CoordinatorCountError) Unwrap() error {
+	return e.Err
+}
+
+// CoordinatorPhaseError is returned when an operation is attempted on a
+// Coordinator that is not in the phase required to perform it.
+type CoordinatorPhaseError struct {
+	Expected CoordinatorPhase
+	Actual   CoordinatorPhase
+}
+
+func (e *CoordinatorPhaseError) Error() string {
+	return fmt.Sprintf("%s: expected %s, coordinator is in %s", ErrCoordinatorInvalidPhase.Error(), e.Expected, e.Actual)
+}
+
+func (e *CoordinatorPhaseError) Unwrap() error {
+	return ErrCoordinatorInvalidPhase
+}
+
+// CoordinatorCulpritError is returned when one or more signature shares
+// are invalid. Culprits are the participants that sent them, which should
+// be excluded from the next signing attempt.
+type CoordinatorCulpritError struct {
+	Culprits []ParticipantIdentifier
+	Err      error
+}
+
+func (e *CoordinatorCulpritError) Error() string {
+	culprits := make([]string, len(e.Culprits))
+	for i, culprit := range e.Culprits {
+		culprits[i] = culprit.Data
+	}
+	return fmt.Sprintf("%s: %s", ErrCoordinatorInvalidSignatureShare.Error(), strings.Join(culprits, ", "))
+}
+
+func (e *CoordinatorCulpritError) Unwrap() []error {
+	return []error{ErrCoordinatorInvalidSignatureShare, e.Err}
+}
+
+// SignatureShareCulprits returns the participants blamed by err for
+// sending invalid signature shares, or nil if err doesn't identify any.
+func SignatureShareCulprits(err error) []ParticipantIdentifier {
+	var culpritError *CoordinatorCulpritError
+	if errors.As(err, &culpritError) {
+		return culpritError.Culprits
+	}
+
+	var coordinationError *CoordinationErrorInvalidSignatureShare
+	if errors.As(err, &coordinationError) {
+		return []ParticipantIdentifier{coordinationError.Culprit}
+	}
+
+	var frostError *FrostErrorInvalidSignatureShare
+	if errors.As(err, &frostError) {
+		return []ParticipantIdentifier{frostError.Culprit}
+	}
+
+	return nil
+}
+
+// Round2Configuration is what every participant of Round 2 needs from the
+// coordinator: the SigningPackage and the Randomizer for Re-Randomized
+// FROST. If participants don't use the same randomizer the signature
+// creation will fail.
+type Round2Configuration struct {
+	SigningPackage FrostSigningPackage
+	Randomizer     FrostRandomizer
+}
+
+// Coordinator keeps track of the commitments and signature shares of a
+// FROST signing round and produces the signature once all shares are in.
+// The coordinator does not participate in the signature.
+//
+// Phases move forward only: CollectingCommitments -> Round2 -> Aggregated.
+// A Coordinator is safe for concurrent use by multiple goroutines.
+//
+// Note: fallback because of misbehaving participants is not handled here.
+// Aggregate identifies them with a *CoordinatorCulpritError so that callers
+// can start a new round without them.
+type Coordinator struct {
+	mu               sync.Mutex
+	configuration    Configuration
+	publicKeyPackage FrostPublicKeyPackage
+	message          Message
+	phase            CoordinatorPhase
+	commitments      map[ParticipantIdentifier]FrostSigningCommitments
+	signatureShares  map[ParticipantIdentifier]FrostSignatureShare
+	randomizer       *FrostRandomizer
+	round2Config     *Round2Configuration
+}
+
+// NewCoordinator creates a Coordinator that will get the given message
+// signed by the participants of publicKeyPackage.
+func NewCoordinator(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, message Message) (*Coordinator, error) {
+	if err := ValidateConfig(configuration); err != nil {
+		return nil, err
+	}
+
+	if len(publicKeyPackage.VerifyingShares) < int(configuration.MinSigners) {
+		return nil, &CoordinatorCountError{
+			Min:   configuration.MinSigners,
+			Max:   configuration.MaxSigners,
+			Found: len(publicKeyPackage.VerifyingShares),
+			Err:   ErrCoordinatorNotEnoughParticipants,
+		}
+	}
+
+	return &Coordinator{
+		configuration:    configuration,
+		publicKeyPackage: publicKeyPackage,
+		message:          message,
+		phase:            CoordinatorPhaseCollectingCommitments,
+		commitments:      make(map[ParticipantIdentifier]FrostSigningCommitments),
+		signatureShares:  make(map[ParticipantIdentifier]FrostSignatureShare),
+	}, nil
+}
+
+// NewCoordinatorWithRandomizer creates a Coordinator like NewCoordinator
+// that signs with the given randomizer instead of a random one, e.g. the
+// Randomizer of a Randomization or the one of an Orchard action created
+// with RandomizerFromBytes.
+func NewCoordinatorWithRandomizer(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, message Message, randomizer FrostRandomizer) (*Coordinator, error) {
+	c, err := NewCoordinator(configuration, publicKeyPackage, message)
+	if err != nil {
+		return nil, err
+	}
+
+	c.randomizer = &randomizer
+
+	return c, nil
+}
+
+// Configuration of the FROST threshold scheme of this coordinator.
+func (c *Coordinator) Configuration() Configuration {
+	return c.configuration
+}
+
+// PublicKeyPackage that represents this signature scheme.
+func (c *Coordinator) PublicKeyPackage() FrostPublicKeyPackage {
+	return c.publicKeyPackage
+}
+
+// Message to be signed by the participants.
+func (c *Coordinator) Message() Message {
+	return c.message
+}
+
+// Phase returns the current phase of the signing round.
+func (c *Coordinator) Phase() CoordinatorPhase {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.phase
+}
+
+// ReceiveCommitment receives a signing commitment from a participant.
+// Commitments from identifiers that are not part of the public key
+// package, repeated commitments, and commitments beyond MaxSigners are
+// rejected.
+func (c *Coordinator) ReceiveCommitment(commitment FrostSigningCommitments) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.requirePhase(CoordinatorPhaseCollectingCommitments); err != nil {
+		return err
+	}
+
+	identifier := commitment.Identifier
+
+	if _, ok := c.publicKeyPackage.VerifyingShares[identifier]; !ok {
+		return &CoordinatorIdentifierError{Identifier: identifier, Err: ErrCoordinatorUnknownIdentifier}
+	}
+
+	if _, ok := c.commitments[identifier]; ok {
+		return &CoordinatorIdentifierError{Identifier: identifier, Err: ErrCoordinatorRepeatedCommitment}
+	}
+
+	if len(c.commitments) >= int(c.configuration.MaxSigners) {
+		return c.countError(len(c.commitments)+1, ErrCoordinatorIncorrectNumberOfCommitments)
+	}
+
+	c.commitments[identifier] = commitment
+
+	return nil
+}
+
+// CreateSigningPackage creates the Round2Configuration with the commitments
+// received so far and moves the coordinator to CoordinatorPhaseRound2.
+// It fails if fewer than MinSigners commitments were received.
+func (c *Coordinator) CreateSigningPackage() (Round2Configuration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.requirePhase(CoordinatorPhaseCollectingCommitments); err != nil {
+		return Round2Configuration{}, err
+	}
+
+	if err := c.validateNumberOf(len(c.commitments), ErrCoordinatorIncorrectNumberOfCommitments); err != nil {
+		return Round2Configuration{}, err
+	}
+
+	commitments := make([]FrostSigningCommitments, 0, len(c.commitments))
+	for _, commitment := range c.commitments {
+		commitments = append(commitments, commitment)
+	}
+
+	signingPackage, err := NewSigningPackage(c.message, commitments)
+	if err != nil {
+		return Round2Configuration{}, err
+	}
+
+	randomizer, err := c.signingRandomizer(signingPackage)
+	if err != nil {
+		return Round2Configuration{}, err
+	}
+
+	c.round2Config = &Round2Configuration{
+		SigningPackage: signingPackage,
+		Randomizer:     randomizer,
+	}
+	c.phase = CoordinatorPhaseRound2
+
+	return *c.round2Config, nil
+}
+
+// Round2Configuration returns the configuration created by
+// CreateSigningPackage.
+func (c *Coordinator) Round2Configuration() (Round2Configuration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.round2Config == nil {
+		return Round2Configuration{}, &CoordinatorPhaseError{Expected: CoordinatorPhaseRound2, Actual: c.phase}
+	}
+
+	return *c.round2Config, nil
+}
+
+// ReceiveSignatureShare receives the signature share of a participant.
+// Only participants that sent a commitment for the signing package can
+// send a share, and only once.
+//
+// The share is verified as soon as it arrives. An invalid share is not
+// recorded and a *CoordinatorCulpritError is returned, so the participant
+// can be asked to send it again.
+func (c *Coordinator) ReceiveSignatureShare(signatureShare FrostSignatureShare) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.checkSignatureShare(signatureShare); err != nil {
+		return err
+	}
+
+	c.signatureShares[signatureShare.Identifier] = signatureShare
+
+	return nil
+}
+
+// validateSignatureShare returns the error ReceiveSignatureShare would
+// return for signatureShare, without recording it.
+func (c *Coordinator) validateSignatureShare(signatureShare FrostSignatureShare) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.checkSignatureShare(signatureShare)
+}
+
+// recordSignatureShare records a share accepted by
+// validateSignatureShare.
+func (c *Coordinator) recordSignatureShare(signatureShare FrostSignatureShare) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.signatureShares[signatureShare.Identifier] = signatureShare
+}
+
+// checkSignatureShare runs the checks of ReceiveSignatureShare. The caller
+// holds c.mu.
+func (c *Coordinator) checkSignatureShare(signatureShare FrostSignatureShare) error {
+	if err := c.requirePhase(CoordinatorPhaseRound2); err != nil {
+		return err
+	}
+
+	identifier := signatureShare.Identifier
+
+	if _, ok := c.publicKeyPackage.VerifyingShares[identifier]; !ok {
+		return &CoordinatorIdentifierError{Identifier: identifier, Err: ErrCoordinatorUnknownIdentifier}
+	}
+
+	if _, ok := c.commitments[identifier]; !ok {
+		return &CoordinatorIdentifierError{Identifier: identifier, Err: ErrCoordinatorUnexpectedSignatureShare}
+	}
+
+	if _, ok := c.signatureShares[identifier]; ok {
+		return &CoordinatorIdentifierError{Identifier: identifier, Err: ErrCoordinatorRepeatedSignatureShare}
+	}
+
+	err := VerifySignatureShare(c.round2Config.SigningPackage, signatureShare, c.publicKeyPackage, c.round2Config.Randomizer)
+	if err != nil {
+		return &CoordinatorCulpritError{Culprits: []ParticipantIdentifier{identifier}, Err: err}
+	}
+
+	return nil
+}
+
+// Aggregate aggregates the signature shares into a FROST signature once
+// every participant of the signing package has sent its share, and moves
+// the coordinator to CoordinatorPhaseAggregated. When a signature share is
+// invalid a *CoordinatorCulpritError naming its sender is returned.
+func (c *Coordinator) Aggregate() (FrostSignature, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.requirePhase(CoordinatorPhaseRound2); err != nil {
+		return FrostSignature{}, err
+	}
+
+	if len(c.signatureShares) != len(c.commitments) {
+		return FrostSignature{}, &CoordinatorCountError{
+			Min:   uint16(len(c.commitments)),
+			Max:   uint16(len(c.commitments)),
+			Found: len(c.signatureShares),
+			Err:   ErrCoordinatorIncorrectNumberOfSignatureShares,
+		}
+	}
+
+	shares := make([]FrostSignatureShare, 0, len(c.signatureShares))
+	for _, share := range c.signatureShares {
+		shares = append(shares, share)
+	}
+
+	signature, err := Aggregate(c.round2Config.SigningPackage, shares, c.publicKeyPackage, c.round2Config.Randomizer)
+	if err != nil {
+		if culprits := SignatureShareCulprits(err); culprits != nil {
+			return FrostSignature{}, &CoordinatorCulpritError{Culprits: culprits, Err: err}
+		}
+		return FrostSignature{}, err
+	}
+
+	c.phase = CoordinatorPhaseAggregated
+
+	return signature, nil
+}
+
+// Verify verifies a signature of the coordinator's message against the
+// re-randomized public key of this signing round.
+func (c *Coordinator) Verify(signature FrostSignature) error {
+	round2Config, err := c.Round2Configuration()
+	if err != nil {
+		return err
+	}
+
+	return VerifyRandomizedSignature(round2Config.Randomizer, c.message, signature, c.publicKeyPackage)
+}
+
+func (c *Coordinator) signingRandomizer(signingPackage FrostSigningPackage) (FrostRandomizer, error) {
+	if c.randomizer != nil {
+		return *c.randomizer, nil
+	}
+
+	randomizedParams, err := RandomizedParamsFromPublicKeyAndSigningPackage(c.publicKeyPackage, signingPackage)
+	if err != nil {
+		return FrostRandomizer{}, err
+	}
+	defer randomizedParams.Destroy()
+
+	return RandomizerFromParams(randomizedParams)
+}
+
+func (c *Coordinator) requirePhase(phase CoordinatorPhase) error {
+	if c.phase != phase {
+		return &CoordinatorPhaseError{Expected: phase, Actual: c.phase}
+	}
+
+	return nil
+}
+
+func (c *Coordinator) validateNumberOf(found int, err error) error {
+	if found < int(c.configuration.MinSigners) || found > int(c.configuration.MaxSigners) {
+		return c.countError(found, err)
+	}
+
+	return nil
+}
+
+func (c *Coordinator) countError(found int, err error) error {
+	return &CoordinatorCountError{
+		Min:   c.configuration.MinSigners,
+		Max:   c.configuration.MaxSigners,
+		Found: found,
+		Err:   err,
+	}
+}
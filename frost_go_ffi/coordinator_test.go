@@ -0,0 +1,264 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func trustedDealerKeyPackages(t *testing.T, config Configuration) (FrostPublicKeyPackage, map[ParticipantIdentifier]FrostKeyPackage) {
+	t.Helper()
+
+	keygen, err := TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for identifier, secretShare := range keygen.SecretShares {
+		keyPackage, err := VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+		keyPackages[identifier] = keyPackage
+	}
+
+	return keygen.PublicKeyPackage, keyPackages
+}
+
+func TestCoordinatorSignsWithThresholdParticipants(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewCoordinator(config, publicKey, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	nonces := make(map[ParticipantIdentifier]FrostSigningNonces)
+
+	// commitments arrive concurrently from the participants
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	for identifier, keyPackage := range keyPackages {
+		wg.Add(1)
+		go func(identifier ParticipantIdentifier, keyPackage FrostKeyPackage) {
+			defer wg.Done()
+			firstRoundCommitment, err := GenerateNoncesAndCommitments(keyPackage)
+			if err != nil {
+				t.Errorf("Failed to generate nonces and commitments: %v", err)
+				return
+			}
+			mu.Lock()
+			nonces[identifier] = firstRoundCommitment.Nonces
+			mu.Unlock()
+			if err := coordinator.ReceiveCommitment(firstRoundCommitment.Commitments); err != nil {
+				t.Errorf("Failed to receive commitment: %v", err)
+			}
+		}(identifier, keyPackage)
+	}
+	wg.Wait()
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	if coordinator.Phase() != CoordinatorPhaseRound2 {
+		t.Fatalf("Expected phase %s, got %s", CoordinatorPhaseRound2, coordinator.Phase())
+	}
+
+	for identifier, keyPackage := range keyPackages {
+		signatureShare, err := Sign(round2Config.SigningPackage, nonces[identifier], keyPackage, round2Config.Randomizer)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+
+		err = coordinator.ReceiveSignatureShare(signatureShare)
+		if !errors.Is(err, ErrCoordinatorRepeatedSignatureShare) {
+			t.Fatalf("Expected repeated signature share error, got %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if coordinator.Phase() != CoordinatorPhaseAggregated {
+		t.Fatalf("Expected phase %s, got %s", CoordinatorPhaseAggregated, coordinator.Phase())
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+func TestCoordinatorRejectsInvalidCommitments(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewCoordinator(config, publicKey, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var commitments []FrostSigningCommitments
+	for _, keyPackage := range keyPackages {
+		firstRoundCommitment, err := GenerateNoncesAndCommitments(keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to generate nonces and commitments: %v", err)
+		}
+		commitments = append(commitments, firstRoundCommitment.Commitments)
+	}
+
+	if err := coordinator.ReceiveCommitment(commitments[0]); err != nil {
+		t.Fatalf("Failed to receive commitment: %v", err)
+	}
+
+	var identifierError *CoordinatorIdentifierError
+	err = coordinator.ReceiveCommitment(commitments[0])
+	if !errors.Is(err, ErrCoordinatorRepeatedCommitment) || !errors.As(err, &identifierError) {
+		t.Fatalf("Expected repeated commitment error, got %v", err)
+	}
+
+	if identifierError.Identifier != commitments[0].Identifier {
+		t.Fatalf("Expected identifier %s, got %s", commitments[0].Identifier.Data, identifierError.Identifier.Data)
+	}
+
+	unknown, err := IdentifierFromUint16(42)
+	if err != nil {
+		t.Fatalf("Failed to create identifier: %v", err)
+	}
+
+	err = coordinator.ReceiveCommitment(FrostSigningCommitments{Identifier: unknown, Data: commitments[1].Data})
+	if !errors.Is(err, ErrCoordinatorUnknownIdentifier) {
+		t.Fatalf("Expected unknown identifier error, got %v", err)
+	}
+
+	_, err = coordinator.CreateSigningPackage()
+	var countError *CoordinatorCountError
+	if !errors.As(err, &countError) || countError.Found != 1 {
+		t.Fatalf("Expected incorrect number of commitments error, got %v", err)
+	}
+
+	_, err = coordinator.Aggregate()
+	if !errors.Is(err, ErrCoordinatorInvalidPhase) {
+		t.Fatalf("Expected invalid phase error, got %v", err)
+	}
+}
+
+func TestCoordinatorRejectsGroupWithoutEnoughParticipants(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	// a group of 3 can't meet a threshold of 4
+	_, err := NewCoordinator(Configuration{MinSigners: 4, MaxSigners: 5, Secret: []byte{}}, publicKey, message)
+	var countError *CoordinatorCountError
+	if !errors.Is(err, ErrCoordinatorNotEnoughParticipants) || !errors.As(err, &countError) || countError.Found != 3 {
+		t.Fatalf("Expected ErrCoordinatorNotEnoughParticipants, got %v", err)
+	}
+
+	if errors.Is(err, ErrCoordinatorIncorrectNumberOfCommitments) {
+		t.Fatalf("Expected a group configuration error, got %v", err)
+	}
+}
+
+func TestCoordinatorRejectsInvalidSignatureShare(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewCoordinator(config, publicKey, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		if len(participants) == int(config.MinSigners) {
+			break
+		}
+
+		participant := NewSigningParticipant(keyPackage)
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	honestShare, err := participants[0].Sign(round2Config)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	if err := VerifySignatureShare(round2Config.SigningPackage, honestShare, publicKey, round2Config.Randomizer); err != nil {
+		t.Fatalf("Failed to verify signature share: %v", err)
+	}
+
+	if err := coordinator.ReceiveSignatureShare(honestShare); err != nil {
+		t.Fatalf("Failed to receive signature share: %v", err)
+	}
+
+	cheater := participants[1].Identifier()
+	cheaterShare, err := participants[1].Sign(round2Config)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	// the cheater sends a copy of the honest share as its own
+	invalidShare := FrostSignatureShare{Identifier: cheater, Data: honestShare.Data}
+
+	err = VerifySignatureShare(round2Config.SigningPackage, invalidShare, publicKey, round2Config.Randomizer)
+	if !errors.Is(err, ErrCoordinationErrorInvalidSignatureShare) {
+		t.Fatalf("Expected invalid signature share error, got %v", err)
+	}
+
+	err = coordinator.ReceiveSignatureShare(invalidShare)
+
+	var culpritError *CoordinatorCulpritError
+	if !errors.Is(err, ErrCoordinatorInvalidSignatureShare) || !errors.As(err, &culpritError) {
+		t.Fatalf("Expected invalid signature share error, got %v", err)
+	}
+
+	culprits := SignatureShareCulprits(err)
+	if len(culprits) != 1 || culprits[0] != cheater {
+		t.Fatalf("Expected %s to be the culprit, got %v", cheater.Data, culprits)
+	}
+
+	// the rejected share was not recorded, the signer can send it again
+	if err := coordinator.ReceiveSignatureShare(cheaterShare); err != nil {
+		t.Fatalf("Failed to receive signature share: %v", err)
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
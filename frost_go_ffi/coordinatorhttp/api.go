@@ -0,0 +1,236 @@
+// Package coordinatorhttp exposes the FROST coordinator role over
+// HTTP/JSON so that signers running anywhere (mobile apps, backends) can
+// meet at a common rendezvous point.
+//
+// A signing session goes through these requests:
+//
+//	POST /sessions                          create a session to sign a message, with the creator token
+//	GET  /sessions?signer={id}&wait={d}     sessions waiting for input from a signer
+//	GET  /sessions/{id}                     status of the session
+//	POST /sessions/{id}/signers             register a signer
+//	POST /sessions/{id}/commitments         send the commitment of a signer
+//	GET  /sessions/{id}/signing-package     signing package, randomizer and commitments
+//	POST /sessions/{id}/signature-shares    send the signature share of a signer
+//	GET  /sessions/{id}/signature           aggregated and verified signature
+//
+// Commitments, signature shares and randomizers use the JSON formats of
+// CommitmentToJson, SignatureSharePackageToJson and RandomizerToJson.
+// Identifiers use the JSON format of ParticipantIdentifier.Data, or its
+// unquoted hex string in query parameters. Other binary values are base64
+// encoded.
+//
+// Signers prove that they hold the signing share of their identifier when
+// they register and when they send their commitment, with a signature
+// checked against their verifying share: see RegisterRequest and
+// CommitmentRequest. Signature shares need no such proof, they are
+// verified against the commitment of their signer.
+//
+// Signers are expected to use a Client, which handles retries and never
+// reuses signing nonces.
+package coordinatorhttp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// SessionPhase is the stage a signing session is in.
+type SessionPhase string
+
+const (
+	// SessionPhaseCommitments is the phase where signers register and
+	// send their commitments.
+	SessionPhaseCommitments SessionPhase = "commitments"
+	// SessionPhaseSignatureShares starts once every registered signer sent
+	// its commitment and the signing package is available.
+	SessionPhaseSignatureShares SessionPhase = "signature_shares"
+	// SessionPhaseCompleted is reached when the signature shares were
+	// aggregated into a verified signature.
+	SessionPhaseCompleted SessionPhase = "completed"
+	// SessionPhaseFailed is reached when the signing package couldn't be
+	// created or the signature shares couldn't be aggregated into a
+	// verified signature. The session can't be completed anymore.
+	SessionPhaseFailed SessionPhase = "failed"
+)
+
+// CreateSessionRequest is the body of POST /sessions.
+type CreateSessionRequest struct {
+	Message []byte `json:"message"`
+	// Signers is the number of signers that will take part in the
+	// signature. Zero means the minimum number of signers of the group.
+	Signers uint16 `json:"signers,omitempty"`
+}
+
+// Session is the status of a signing session.
+type Session struct {
+	ID         string            `json:"id"`
+	Message    []byte            `json:"message"`
+	Signers    uint16            `json:"signers"`
+	Phase      SessionPhase      `json:"phase"`
+	Registered []json.RawMessage `json:"registered"`
+	Committed  []json.RawMessage `json:"committed"`
+	Signed     []json.RawMessage `json:"signed"`
+	// Expires is when the server evicts the session.
+	Expires time.Time `json:"expires"`
+	// Failure is why the session failed, in SessionPhaseFailed.
+	Failure string `json:"failure,omitempty"`
+}
+
+// RegisterRequest is the body of POST /sessions/{id}/signers.
+type RegisterRequest struct {
+	Identifier json.RawMessage `json:"identifier"`
+	// Proof is the signature of RegistrationProofMessage made by the
+	// signer with frost.SignWithSigningShare.
+	Proof []byte `json:"proof"`
+}
+
+// NewRegisterRequest returns the request registering the signer of
+// keyPackage in the session sessionID.
+func NewRegisterRequest(sessionID string, keyPackage frost.FrostKeyPackage) (RegisterRequest, error) {
+	proof, err := frost.SignWithSigningShare(keyPackage, RegistrationProofMessage(sessionID, keyPackage.Identifier))
+	if err != nil {
+		return RegisterRequest{}, err
+	}
+
+	return RegisterRequest{
+		Identifier: IdentifierToJson(keyPackage.Identifier),
+		Proof:      proof.Data,
+	}, nil
+}
+
+// CommitmentRequest is the body of POST /sessions/{id}/commitments.
+type CommitmentRequest struct {
+	Identifier json.RawMessage `json:"identifier"`
+	Commitment json.RawMessage `json:"commitment"`
+	// Proof is the signature of CommitmentProofMessage made by the signer
+	// with frost.SignWithSigningShare. It is not part of the commitments
+	// listed in a SigningPackageResponse.
+	Proof []byte `json:"proof,omitempty"`
+}
+
+// NewCommitmentRequest returns the request sending commitment, made by
+// the signer of keyPackage, in the session sessionID.
+func NewCommitmentRequest(sessionID string, keyPackage frost.FrostKeyPackage, commitment frost.FrostSigningCommitments) (CommitmentRequest, error) {
+	commitmentJson, err := frost.CommitmentToJson(commitment)
+	if err != nil {
+		return CommitmentRequest{}, err
+	}
+
+	proof, err := frost.SignWithSigningShare(keyPackage, CommitmentProofMessage(sessionID, commitment))
+	if err != nil {
+		return CommitmentRequest{}, err
+	}
+
+	return CommitmentRequest{
+		Identifier: IdentifierToJson(commitment.Identifier),
+		Commitment: json.RawMessage(commitmentJson),
+		Proof:      proof.Data,
+	}, nil
+}
+
+// RegistrationProofMessage is the message a signer signs to register
+// with identifier in the session sessionID.
+func RegistrationProofMessage(sessionID string, identifier frost.ParticipantIdentifier) frost.Message {
+	return proofMessage("register", sessionID, identifier, nil)
+}
+
+// CommitmentProofMessage is the message a signer signs to send
+// commitment in the session sessionID. It covers the serialized
+// commitment, commitment.Data.
+func CommitmentProofMessage(sessionID string, commitment frost.FrostSigningCommitments) frost.Message {
+	return proofMessage("commitment", sessionID, commitment.Identifier, commitment.Data)
+}
+
+// proofMessage is the NUL separated concatenation of a domain separator
+// naming the request, the session ID, the JSON identifier of the signer
+// and the content of the request. Only the content may contain NUL bytes,
+// it comes last.
+func proofMessage(request string, sessionID string, identifier frost.ParticipantIdentifier, content []byte) frost.Message {
+	var data []byte
+	data = append(data, "frost-uniffi-sdk coordinatorhttp "+request...)
+	data = append(data, 0)
+	data = append(data, sessionID...)
+	data = append(data, 0)
+	data = append(data, identifier.Data...)
+	data = append(data, 0)
+	data = append(data, content...)
+
+	return frost.Message{Data: data}
+}
+
+// SigningPackageResponse is the body returned by
+// GET /sessions/{id}/signing-package. The signing package is made of the
+// message of the session and Commitments, which signers check before
+// signing it.
+type SigningPackageResponse struct {
+	SigningPackage []byte              `json:"signing_package"`
+	Randomizer     json.RawMessage     `json:"randomizer"`
+	Commitments    []CommitmentRequest `json:"commitments"`
+}
+
+// SignatureShareRequest is the body of POST /sessions/{id}/signature-shares.
+type SignatureShareRequest struct {
+	Identifier     json.RawMessage `json:"identifier"`
+	SignatureShare json.RawMessage `json:"signature_share"`
+}
+
+// SignatureResponse is the body returned by GET /sessions/{id}/signature.
+type SignatureResponse struct {
+	Signature  []byte          `json:"signature"`
+	Randomizer json.RawMessage `json:"randomizer"`
+}
+
+// ErrorCode tells apart the failures reported by the server.
+type ErrorCode string
+
+const (
+	ErrorCodeMalformedRequest      ErrorCode = "malformed_request"
+	ErrorCodeUnauthorized          ErrorCode = "unauthorized"
+	ErrorCodeSessionNotFound       ErrorCode = "session_not_found"
+	ErrorCodeUnknownSigner         ErrorCode = "unknown_signer"
+	ErrorCodeSessionFull           ErrorCode = "session_full"
+	ErrorCodeInvalidPhase          ErrorCode = "invalid_phase"
+	ErrorCodeRepeated              ErrorCode = "repeated"
+	ErrorCodeNotReady              ErrorCode = "not_ready"
+	ErrorCodeInvalidSignatureShare ErrorCode = "invalid_signature_share"
+	ErrorCodeSessionFailed         ErrorCode = "session_failed"
+	ErrorCodeInternal              ErrorCode = "internal"
+)
+
+// ErrorResponse is the body of every response with an error status.
+type ErrorResponse struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	// Culprits are the signers whose signature shares are invalid.
+	Culprits []json.RawMessage `json:"culprits,omitempty"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("coordinator: %s: %s", e.Code, e.Message)
+}
+
+// IdentifierToJson returns the wire representation of identifier.
+func IdentifierToJson(identifier frost.ParticipantIdentifier) json.RawMessage {
+	return json.RawMessage(identifier.Data)
+}
+
+// ErrMalformedIdentifier is returned for identifiers that can't be parsed.
+var ErrMalformedIdentifier = errors.New("malformed participant identifier")
+
+// IdentifierFromJson parses the wire representation of an identifier.
+func IdentifierFromJson(raw json.RawMessage) (frost.ParticipantIdentifier, error) {
+	if len(raw) == 0 {
+		return frost.ParticipantIdentifier{}, ErrMalformedIdentifier
+	}
+
+	identifier := frost.IdentifierFromJsonString(string(raw))
+	if identifier == nil {
+		return frost.ParticipantIdentifier{}, fmt.Errorf("%w: %s", ErrMalformedIdentifier, raw)
+	}
+
+	return *identifier, nil
+}
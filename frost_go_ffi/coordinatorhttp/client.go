@@ -0,0 +1,447 @@
+package coordinatorhttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// ApprovalFunc is shown a session, and the message it signs, before the
+// client takes part in it. Returning false declines the session.
+type ApprovalFunc func(ctx context.Context, session Session) (bool, error)
+
+// ErrClientNoncesLost is returned for a session that expects a signature
+// share for a commitment whose nonces the client doesn't have, e.g. after
+// a restart. Signing with other nonces is not possible, so the session
+// can't be completed by this signer.
+var ErrClientNoncesLost = errors.New("signing nonces of the session are not available")
+
+// ErrClientUnexpectedSigningPackage is returned for a session whose
+// signing package isn't made of the approved message and the commitment
+// of the client, which then refuses to sign it.
+var ErrClientUnexpectedSigningPackage = errors.New("signing package doesn't match the approved session")
+
+// Client takes part in the signing sessions of a Server on behalf of the
+// signer of a key package.
+//
+// Requests that fail because of the network or the server are retried
+// with the same content: the commitment and the signature share of a
+// session are generated once and resubmitted as they are, so that signing
+// nonces are never reused.
+//
+// A Client is safe for concurrent use by multiple goroutines, each session
+// is handled by one of them at a time.
+type Client struct {
+	// LongPoll is how long the server may hold a request for pending
+	// sessions.
+	LongPoll time.Duration
+	// RetryInterval is the delay between attempts of a failed request.
+	RetryInterval time.Duration
+	// OnError, if set, is called by Run with the errors of the sessions
+	// it handles.
+	OnError func(sessionID string, err error)
+
+	baseURL    string
+	httpClient *http.Client
+	keyPackage frost.FrostKeyPackage
+	approve    ApprovalFunc
+
+	// mu guards sessions, it isn't held while a session is handled.
+	mu       sync.Mutex
+	sessions map[string]*clientSession
+}
+
+// clientSession is the progress of the client in a session.
+type clientSession struct {
+	// expires is when the server evicts the session.
+	expires time.Time
+	// declined is written with both mu and Client.mu held, so that
+	// either is enough to read it.
+	declined bool
+
+	// mu is held while the session is handled, it guards the fields
+	// below.
+	mu sync.Mutex
+	// asked tells whether approve was asked about the session.
+	asked bool
+	// message approved to be signed
+	message        []byte
+	signer         *frost.SigningParticipant
+	commitment     *frost.FrostSigningCommitments
+	signatureShare *frost.FrostSignatureShare
+}
+
+// NewClient creates a client for the server at baseURL that signs with
+// keyPackage the sessions accepted by approve.
+func NewClient(baseURL string, keyPackage frost.FrostKeyPackage, approve ApprovalFunc) *Client {
+	return &Client{
+		LongPoll:      30 * time.Second,
+		RetryInterval: time.Second,
+		baseURL:       strings.TrimSuffix(baseURL, "/"),
+		httpClient:    http.DefaultClient,
+		keyPackage:    keyPackage,
+		approve:       approve,
+		sessions:      make(map[string]*clientSession),
+	}
+}
+
+// Run handles the sessions waiting for this signer until ctx is done.
+// Errors of a session are reported to OnError and don't stop the client.
+func (c *Client) Run(ctx context.Context) error {
+	for {
+		sessions, err := c.PendingSessions(ctx, c.LongPoll)
+		if err != nil {
+			return err
+		}
+
+		c.forgetSessions(sessions)
+
+		idle := true
+		for _, session := range sessions {
+			if c.isDeclined(session.ID) {
+				continue
+			}
+			idle = false
+
+			if err := c.HandleSession(ctx, session); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if c.OnError != nil {
+					c.OnError(session.ID, err)
+				}
+			}
+		}
+
+		// declined sessions keep being listed, don't spin on them
+		if idle && len(sessions) > 0 {
+			if err := sleep(ctx, c.RetryInterval); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// PendingSessions lists the sessions waiting for input from this signer,
+// waiting up to wait for one to show up.
+func (c *Client) PendingSessions(ctx context.Context, wait time.Duration) ([]Session, error) {
+	var signer string
+	if err := json.Unmarshal(IdentifierToJson(c.keyPackage.Identifier), &signer); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedIdentifier, err)
+	}
+
+	query := url.Values{}
+	query.Set("signer", signer)
+	query.Set("wait", wait.String())
+
+	var sessions []Session
+	if err := c.do(ctx, http.MethodGet, "/sessions?"+query.Encode(), nil, &sessions); err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// HandleSession takes the next steps of this signer in session: asking
+// for approval, registering and sending the commitment in the commitments
+// phase, then sending the signature share once the signing package is
+// available.
+//
+// Other sessions can be handled meanwhile, only the calls for the same
+// session wait for each other.
+func (c *Client) HandleSession(ctx context.Context, session Session) error {
+	if session.Phase != SessionPhaseCommitments && session.Phase != SessionPhaseSignatureShares {
+		c.forget(session.ID)
+		return nil
+	}
+
+	state := c.session(session)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if session.Phase == SessionPhaseCommitments {
+		if !state.asked {
+			approved, err := c.approve(ctx, session)
+			if err != nil {
+				return err
+			}
+			state.asked = true
+			state.message = session.Message
+
+			c.mu.Lock()
+			state.declined = !approved
+			c.mu.Unlock()
+		}
+		if state.declined {
+			return nil
+		}
+		return c.commit(ctx, session.ID, state)
+	}
+
+	if state.commitment == nil {
+		if containsIdentifier(session.Committed, c.keyPackage.Identifier) {
+			return ErrClientNoncesLost
+		}
+		return nil
+	}
+	if err := c.sign(ctx, session.ID, state); err != nil {
+		return err
+	}
+	c.forget(session.ID)
+	return nil
+}
+
+// session returns the progress of the client in session, starting to
+// track it if it is new.
+func (c *Client) session(session Session) *clientSession {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.sessions[session.ID]
+	if !ok {
+		state = &clientSession{expires: session.Expires}
+		c.sessions[session.ID] = state
+	}
+	return state
+}
+
+func (c *Client) forget(sessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.sessions, sessionID)
+}
+
+func (c *Client) commit(ctx context.Context, sessionID string, state *clientSession) error {
+	sessionPath := "/sessions/" + url.PathEscape(sessionID)
+
+	registerRequest, err := NewRegisterRequest(sessionID, c.keyPackage)
+	if err != nil {
+		return err
+	}
+
+	if err := c.do(ctx, http.MethodPost, sessionPath+"/signers", registerRequest, nil); err != nil {
+		return err
+	}
+
+	if state.commitment == nil {
+		signer := frost.NewSigningParticipant(c.keyPackage)
+		commitment, err := signer.Commit()
+		if err != nil {
+			return err
+		}
+		state.signer = signer
+		state.commitment = &commitment
+	}
+
+	commitmentRequest, err := NewCommitmentRequest(sessionID, c.keyPackage, *state.commitment)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodPost, sessionPath+"/commitments", commitmentRequest, nil)
+}
+
+func (c *Client) sign(ctx context.Context, sessionID string, state *clientSession) error {
+	sessionPath := "/sessions/" + url.PathEscape(sessionID)
+
+	if state.signatureShare == nil {
+		var signingPackage SigningPackageResponse
+		if err := c.do(ctx, http.MethodGet, sessionPath+"/signing-package", nil, &signingPackage); err != nil {
+			return err
+		}
+
+		approved, err := c.approvedSigningPackage(signingPackage, state)
+		if err != nil {
+			return err
+		}
+
+		randomizer, err := frost.JsonToRandomizer(string(signingPackage.Randomizer))
+		if err != nil {
+			return err
+		}
+
+		signatureShare, err := state.signer.Sign(frost.Round2Configuration{
+			SigningPackage: approved,
+			Randomizer:     randomizer,
+		})
+		if err != nil {
+			return err
+		}
+		state.signatureShare = &signatureShare
+	}
+
+	signatureShareJson, err := frost.SignatureSharePackageToJson(*state.signatureShare)
+	if err != nil {
+		return err
+	}
+
+	return c.do(ctx, http.MethodPost, sessionPath+"/signature-shares", SignatureShareRequest{
+		Identifier:     IdentifierToJson(c.keyPackage.Identifier),
+		SignatureShare: json.RawMessage(signatureShareJson),
+	}, nil)
+}
+
+// approvedSigningPackage rebuilds the signing package of response from
+// the approved message and the commitments it lists, which must include
+// the commitment of the client, and checks that it is the one the server
+// sent.
+func (c *Client) approvedSigningPackage(response SigningPackageResponse, state *clientSession) (frost.FrostSigningPackage, error) {
+	var commitments []frost.FrostSigningCommitments
+	committed := false
+	for _, commitment := range response.Commitments {
+		identifier, err := IdentifierFromJson(commitment.Identifier)
+		if err != nil {
+			return frost.FrostSigningPackage{}, err
+		}
+
+		parsed, err := frost.JsonToCommitment(string(commitment.Commitment), identifier)
+		if err != nil {
+			return frost.FrostSigningPackage{}, err
+		}
+
+		if identifier == c.keyPackage.Identifier {
+			if !bytes.Equal(parsed.Data, state.commitment.Data) {
+				return frost.FrostSigningPackage{}, fmt.Errorf("%w: another commitment for this signer", ErrClientUnexpectedSigningPackage)
+			}
+			committed = true
+		}
+
+		commitments = append(commitments, parsed)
+	}
+
+	if !committed {
+		return frost.FrostSigningPackage{}, fmt.Errorf("%w: no commitment for this signer", ErrClientUnexpectedSigningPackage)
+	}
+
+	signingPackage, err := frost.NewSigningPackage(frost.Message{Data: state.message}, commitments)
+	if err != nil {
+		return frost.FrostSigningPackage{}, err
+	}
+
+	if !bytes.Equal(signingPackage.Data, response.SigningPackage) {
+		return frost.FrostSigningPackage{}, fmt.Errorf("%w: not the approved message", ErrClientUnexpectedSigningPackage)
+	}
+
+	return signingPackage, nil
+}
+
+func (c *Client) isDeclined(sessionID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.sessions[sessionID]
+	return ok && state.declined
+}
+
+// forgetSessions drops the sessions that are no longer pending and won't
+// be listed again: declined sessions, and the others once they expired.
+// A session isn't listed while the other signers send their commitments,
+// the nonces of the client are kept until then.
+func (c *Client) forgetSessions(pending []Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	listed := make(map[string]bool, len(pending))
+	for _, session := range pending {
+		listed[session.ID] = true
+	}
+
+	now := time.Now()
+	for id, state := range c.sessions {
+		if !listed[id] && (state.declined || now.After(state.expires)) {
+			delete(c.sessions, id)
+		}
+	}
+}
+
+// do sends request to path and decodes the response into response.
+// Network errors and server errors are retried until ctx is done, error
+// statuses are returned as an *ErrorResponse.
+func (c *Client) do(ctx context.Context, method string, path string, request any, response any) error {
+	var body []byte
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return err
+		}
+	}
+
+	for {
+		err := c.doOnce(ctx, method, path, body, response)
+
+		var errorResponse *ErrorResponse
+		if err == nil || ctx.Err() != nil || (errors.As(err, &errorResponse) && errorResponse.Code != ErrorCodeInternal) {
+			return err
+		}
+
+		if err := sleep(ctx, c.RetryInterval); err != nil {
+			return err
+		}
+	}
+}
+
+func (c *Client) doOnce(ctx context.Context, method string, path string, body []byte, response any) error {
+	httpRequest, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode >= http.StatusBadRequest {
+		errorResponse := &ErrorResponse{}
+		if err := json.NewDecoder(httpResponse.Body).Decode(errorResponse); err != nil {
+			errorResponse.Message = httpResponse.Status
+		}
+		// proxies in front of the server answer with their own bodies
+		if httpResponse.StatusCode >= http.StatusInternalServerError {
+			errorResponse.Code = ErrorCodeInternal
+		} else if errorResponse.Code == "" {
+			errorResponse.Code = ErrorCodeMalformedRequest
+		}
+		return errorResponse
+	}
+
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(httpResponse.Body).Decode(response)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func containsIdentifier(identifiers []json.RawMessage, identifier frost.ParticipantIdentifier) bool {
+	for _, raw := range identifiers {
+		if parsed, err := IdentifierFromJson(raw); err == nil && parsed == identifier {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,350 @@
+package coordinatorhttp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+func trustedDealerKeyPackages(t *testing.T, config frost.Configuration) (frost.FrostPublicKeyPackage, []frost.FrostKeyPackage) {
+	t.Helper()
+
+	keygen, err := frost.TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	var keyPackages []frost.FrostKeyPackage
+	for _, secretShare := range keygen.SecretShares {
+		keyPackage, err := frost.VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+		keyPackages = append(keyPackages, keyPackage)
+	}
+
+	return keygen.PublicKeyPackage, keyPackages
+}
+
+// flakyHandler processes every other request of a signer but answers it
+// with a server error, as if the response got lost on its way back.
+type flakyHandler struct {
+	handler http.Handler
+
+	mu    sync.Mutex
+	posts int
+}
+
+func (h *flakyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path == "/sessions" {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	h.mu.Lock()
+	h.posts++
+	lost := h.posts%2 == 1
+	h.mu.Unlock()
+
+	if !lost {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	h.handler.ServeHTTP(httptest.NewRecorder(), r)
+	http.Error(w, "bad gateway", http.StatusBadGateway)
+}
+
+// approveAll is an ApprovalFunc that approves every session.
+func approveAll(ctx context.Context, session Session) (bool, error) {
+	return true, nil
+}
+
+// signWithClients creates a session on the server at url and runs a
+// client for each key package, with the approval returned by approve for
+// it, until the session completes and its signature is verified.
+func signWithClients(t *testing.T, url string, publicKey frost.FrostPublicKeyPackage, keyPackages []frost.FrostKeyPackage, approve func(frost.FrostKeyPackage) ApprovalFunc) Session {
+	t.Helper()
+
+	message := []byte("i am a message")
+
+	session := createSession(t, url, CreateSessionRequest{Message: message})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	for _, keyPackage := range keyPackages {
+		client := NewClient(url, keyPackage, approve(keyPackage))
+		client.LongPoll = time.Second
+		client.RetryInterval = 10 * time.Millisecond
+		client.OnError = func(sessionID string, err error) {
+			t.Errorf("Failed to handle session %s: %v", sessionID, err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.Run(ctx)
+		}()
+	}
+
+	for session.Phase != SessionPhaseCompleted {
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Session did not complete: %+v", session)
+		case <-time.After(10 * time.Millisecond):
+		}
+		call(t, http.MethodGet, url+"/sessions/"+session.ID, nil, http.StatusOK, &session)
+	}
+
+	var signature SignatureResponse
+	call(t, http.MethodGet, url+"/sessions/"+session.ID+"/signature", nil, http.StatusOK, &signature)
+
+	randomizer, err := frost.JsonToRandomizer(string(signature.Randomizer))
+	if err != nil {
+		t.Fatalf("Failed to parse randomizer: %v", err)
+	}
+
+	err = frost.VerifyRandomizedSignature(randomizer, frost.Message{Data: message}, frost.FrostSignature{Data: signature.Signature}, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+
+	return session
+}
+
+func TestClientsSignApprovedSession(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	declining := keyPackages[0].Identifier
+
+	session := signWithClients(t, server.URL, publicKey, keyPackages, func(keyPackage frost.FrostKeyPackage) ApprovalFunc {
+		return func(ctx context.Context, session Session) (bool, error) {
+			if string(session.Message) != "i am a message" {
+				t.Errorf("Unexpected message %q", session.Message)
+			}
+			return keyPackage.Identifier != declining, nil
+		}
+	})
+
+	if containsIdentifier(session.Signed, declining) {
+		t.Fatalf("Expected the session to be signed without the declining signer")
+	}
+}
+
+func TestClientResubmitsAfterLostResponses(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(&flakyHandler{handler: coordinator})
+	defer server.Close()
+
+	signWithClients(t, server.URL, publicKey, keyPackages[:config.MinSigners], func(frost.FrostKeyPackage) ApprovalFunc {
+		return approveAll
+	})
+}
+
+// swappingHandler hands out signing packages of another message than the
+// one of the session, along with the commitments of the signers.
+type swappingHandler struct {
+	t       *testing.T
+	handler http.Handler
+}
+
+func (h *swappingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasSuffix(r.URL.Path, "/signing-package") {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	recorder := httptest.NewRecorder()
+	h.handler.ServeHTTP(recorder, r)
+
+	var response SigningPackageResponse
+	if err := json.NewDecoder(recorder.Body).Decode(&response); err != nil {
+		h.t.Errorf("Failed to decode signing package: %v", err)
+		return
+	}
+
+	var commitments []frost.FrostSigningCommitments
+	for _, commitment := range response.Commitments {
+		identifier, err := IdentifierFromJson(commitment.Identifier)
+		if err != nil {
+			h.t.Errorf("Failed to parse identifier: %v", err)
+			return
+		}
+
+		parsed, err := frost.JsonToCommitment(string(commitment.Commitment), identifier)
+		if err != nil {
+			h.t.Errorf("Failed to parse commitment: %v", err)
+			return
+		}
+		commitments = append(commitments, parsed)
+	}
+
+	swapped, err := frost.NewSigningPackage(frost.Message{Data: []byte("i am another message")}, commitments)
+	if err != nil {
+		h.t.Errorf("Failed to create signing package: %v", err)
+		return
+	}
+	response.SigningPackage = swapped.Data
+
+	writeJson(w, http.StatusOK, response)
+}
+
+func TestClientRefusesSigningPackageOfAnotherMessage(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(&swappingHandler{t: t, handler: coordinator})
+	defer server.Close()
+
+	session := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message")})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	defer cancel()
+
+	refused := make(chan error, config.MinSigners)
+	for _, keyPackage := range keyPackages[:config.MinSigners] {
+		client := NewClient(server.URL, keyPackage, approveAll)
+		client.LongPoll = time.Second
+		client.RetryInterval = 10 * time.Millisecond
+
+		var once sync.Once
+		client.OnError = func(sessionID string, err error) {
+			once.Do(func() { refused <- err })
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client.Run(ctx)
+		}()
+	}
+
+	for i := 0; i < int(config.MinSigners); i++ {
+		select {
+		case err := <-refused:
+			if !errors.Is(err, ErrClientUnexpectedSigningPackage) {
+				t.Fatalf("Expected ErrClientUnexpectedSigningPackage, got %v", err)
+			}
+		case <-ctx.Done():
+			t.Fatalf("Clients did not refuse the signing package")
+		}
+	}
+
+	call(t, http.MethodGet, server.URL+"/sessions/"+session.ID, nil, http.StatusOK, &session)
+	if len(session.Signed) != 0 {
+		t.Fatalf("Expected no signature share, got %d", len(session.Signed))
+	}
+}
+
+func TestClientHandlesSessionsWhileAnotherAwaitsApproval(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	waiting := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message waiting for approval")})
+	approved := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message")})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	asked := make(chan struct{})
+	release := make(chan struct{})
+	client := NewClient(server.URL, keyPackages[0], func(ctx context.Context, session Session) (bool, error) {
+		if session.ID == waiting.ID {
+			close(asked)
+			<-release
+		}
+		return true, nil
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		done <- client.HandleSession(ctx, waiting)
+	}()
+	<-asked
+
+	if err := client.HandleSession(ctx, approved); err != nil {
+		t.Fatalf("Failed to handle session: %v", err)
+	}
+
+	call(t, http.MethodGet, server.URL+"/sessions/"+approved.ID, nil, http.StatusOK, &approved)
+	if !containsIdentifier(approved.Committed, keyPackages[0].Identifier) {
+		t.Fatalf("Expected the commitment of the client, got %s", approved.Committed)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatalf("Failed to handle session: %v", err)
+	}
+}
+
+func TestClientForgetsSessionsNoLongerPending(t *testing.T) {
+	client := NewClient("http://localhost", frost.FrostKeyPackage{}, approveAll)
+
+	now := time.Now()
+	client.sessions = map[string]*clientSession{
+		"declined":        {expires: now.Add(time.Hour), declined: true},
+		"listed declined": {expires: now.Add(time.Hour), declined: true},
+		"committed":       {expires: now.Add(time.Hour)},
+		"expired":         {expires: now.Add(-time.Second)},
+		"listed expired":  {expires: now.Add(-time.Second)},
+	}
+
+	client.forgetSessions([]Session{{ID: "listed declined"}, {ID: "listed expired"}})
+
+	for _, id := range []string{"declined", "expired"} {
+		if _, ok := client.sessions[id]; ok {
+			t.Fatalf("Expected session %q to be forgotten", id)
+		}
+	}
+	for _, id := range []string{"listed declined", "committed", "listed expired"} {
+		if _, ok := client.sessions[id]; !ok {
+			t.Fatalf("Expected session %q to be kept", id)
+		}
+	}
+}
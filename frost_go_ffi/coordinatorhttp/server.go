@@ -0,0 +1,702 @@
+package coordinatorhttp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// maxRequestBytes bounds the size of request bodies.
+const maxRequestBytes = 1 << 20
+
+// maxWait bounds how long a GET /sessions long-poll is held.
+const maxWait = time.Minute
+
+// DefaultSessionTTL is the SessionTTL of servers created by NewServer.
+const DefaultSessionTTL = 10 * time.Minute
+
+// ErrServerNoCreatorToken is returned by NewServer when no token is given
+// to authenticate the creation of sessions.
+var ErrServerNoCreatorToken = errors.New("a token is required to create sessions")
+
+// Server plays the coordinator role of a FROST group over HTTP. Each
+// signing session is driven by a frost.Coordinator: commitments and
+// signature shares are checked as they arrive and the signature is
+// aggregated and verified as soon as the last share is in.
+//
+// Only the holders of the creator token may create sessions, sending it
+// as a bearer token. Signers authenticate their registration and their
+// commitment with a signature by their signing share, which the server
+// checks against their verifying share in the public key package, so
+// that nobody else can take their place in a session.
+//
+// Sessions are evicted SessionTTL after their creation, whether they
+// completed, failed or are still waiting for signers.
+type Server struct {
+	// SessionTTL is how long a session is kept after its creation.
+	SessionTTL time.Duration
+
+	configuration    frost.Configuration
+	publicKeyPackage frost.FrostPublicKeyPackage
+	creatorToken     []byte
+
+	mu       sync.Mutex
+	sessions map[string]*session
+	// changed is closed and replaced whenever a session changes, waking
+	// up long-polling signers.
+	changed chan struct{}
+}
+
+// NewServer creates a coordinator server for the group of
+// publicKeyPackage whose sessions are created by the holders of
+// creatorToken.
+func NewServer(configuration frost.Configuration, publicKeyPackage frost.FrostPublicKeyPackage, creatorToken string) (*Server, error) {
+	if err := frost.ValidateConfig(configuration); err != nil {
+		return nil, err
+	}
+
+	if creatorToken == "" {
+		return nil, ErrServerNoCreatorToken
+	}
+
+	return &Server{
+		SessionTTL:       DefaultSessionTTL,
+		configuration:    configuration,
+		publicKeyPackage: publicKeyPackage,
+		creatorToken:     []byte(creatorToken),
+		sessions:         make(map[string]*session),
+		changed:          make(chan struct{}),
+	}, nil
+}
+
+type session struct {
+	mu          sync.Mutex
+	id          string
+	expires     time.Time
+	message     frost.Message
+	signers     uint16
+	coordinator *frost.Coordinator
+	registered  []frost.ParticipantIdentifier
+	committed   []frost.ParticipantIdentifier
+	signed      []frost.ParticipantIdentifier
+	// commitments and signatureShares received, to accept the same one
+	// being resubmitted by a signer that missed the response.
+	commitments     map[frost.ParticipantIdentifier]frost.FrostSigningCommitments
+	signatureShares map[frost.ParticipantIdentifier]frost.FrostSignatureShare
+	round2          *frost.Round2Configuration
+	signature       *frost.FrostSignature
+	// failure is set when the signing package can't be created or the
+	// signature shares can't be aggregated, which ends the session.
+	failure error
+}
+
+func (s *session) phase() SessionPhase {
+	switch {
+	case s.failure != nil:
+		return SessionPhaseFailed
+	case s.signature != nil:
+		return SessionPhaseCompleted
+	case s.round2 != nil:
+		return SessionPhaseSignatureShares
+	default:
+		return SessionPhaseCommitments
+	}
+}
+
+func (s *session) status() Session {
+	var failure string
+	if s.failure != nil {
+		failure = s.failure.Error()
+	}
+
+	return Session{
+		ID:         s.id,
+		Message:    s.message.Data,
+		Signers:    s.signers,
+		Phase:      s.phase(),
+		Registered: identifiersToJson(s.registered),
+		Committed:  identifiersToJson(s.committed),
+		Signed:     identifiersToJson(s.signed),
+		Expires:    s.expires,
+		Failure:    failure,
+	}
+}
+
+// requireNotFailed writes an error response and returns false if the
+// session failed.
+func (s *session) requireNotFailed(w http.ResponseWriter) bool {
+	if s.failure != nil {
+		writeError(w, http.StatusConflict, &ErrorResponse{Code: ErrorCodeSessionFailed, Message: s.failure.Error()})
+		return false
+	}
+	return true
+}
+
+func (s *session) isRegistered(identifier frost.ParticipantIdentifier) bool {
+	for _, registered := range s.registered {
+		if registered == identifier {
+			return true
+		}
+	}
+	return false
+}
+
+// awaits tells whether the session is waiting for input from signer.
+func (s *session) awaits(signer frost.ParticipantIdentifier) bool {
+	switch s.phase() {
+	case SessionPhaseCommitments:
+		_, committed := s.commitments[signer]
+		return !committed && (s.isRegistered(signer) || len(s.registered) < int(s.signers))
+	case SessionPhaseSignatureShares:
+		_, committed := s.commitments[signer]
+		_, signed := s.signatureShares[signer]
+		return committed && !signed
+	default:
+		return false
+	}
+}
+
+// ServeHTTP routes the requests documented in the package comment.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if parts[0] != "sessions" || len(parts) > 3 {
+		http.NotFound(w, r)
+		return
+	}
+
+	if len(parts) == 1 {
+		switch r.Method {
+		case http.MethodPost:
+			s.createSession(w, r)
+		case http.MethodGet:
+			s.pendingSessions(w, r)
+		default:
+			w.Header().Set("Allow", http.MethodGet+", "+http.MethodPost)
+			writeError(w, http.StatusMethodNotAllowed, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: "method not allowed"})
+		}
+		return
+	}
+
+	sess, ok := s.session(parts[1])
+	if !ok {
+		writeError(w, http.StatusNotFound, &ErrorResponse{Code: ErrorCodeSessionNotFound, Message: "no session " + parts[1]})
+		return
+	}
+
+	resource := ""
+	if len(parts) == 3 {
+		resource = parts[2]
+	}
+
+	switch resource {
+	case "":
+		if allowMethod(w, r, http.MethodGet) {
+			s.status(w, sess)
+		}
+	case "signers":
+		if allowMethod(w, r, http.MethodPost) {
+			s.register(w, r, sess)
+		}
+	case "commitments":
+		if allowMethod(w, r, http.MethodPost) {
+			s.receiveCommitment(w, r, sess)
+		}
+	case "signing-package":
+		if allowMethod(w, r, http.MethodGet) {
+			s.signingPackage(w, sess)
+		}
+	case "signature-shares":
+		if allowMethod(w, r, http.MethodPost) {
+			s.receiveSignatureShare(w, r, sess)
+		}
+	case "signature":
+		if allowMethod(w, r, http.MethodGet) {
+			s.signature(w, sess)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// notify wakes up the signers waiting for a change in the sessions.
+func (s *Server) notify() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+// pendingSessions lists the sessions waiting for input from the signer
+// of the signer query parameter. With a wait query parameter the request
+// is held until there is at least one such session or wait elapses.
+func (s *Server) pendingSessions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	signer, ok := parseIdentifier(w, json.RawMessage(fmt.Sprintf("%q", query.Get("signer"))))
+	if !ok {
+		return
+	}
+
+	var wait time.Duration
+	if query.Has("wait") {
+		var err error
+		if wait, err = time.ParseDuration(query.Get("wait")); err != nil {
+			writeError(w, http.StatusBadRequest, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: err.Error()})
+			return
+		}
+		if wait > maxWait {
+			wait = maxWait
+		}
+	}
+
+	timeout := time.NewTimer(wait)
+	defer timeout.Stop()
+
+	for {
+		s.mu.Lock()
+		s.evictExpired()
+		changed := s.changed
+		sessions := make([]*session, 0, len(s.sessions))
+		for _, sess := range s.sessions {
+			sessions = append(sessions, sess)
+		}
+		s.mu.Unlock()
+
+		pending := []Session{}
+		for _, sess := range sessions {
+			sess.mu.Lock()
+			if sess.awaits(signer) {
+				pending = append(pending, sess.status())
+			}
+			sess.mu.Unlock()
+		}
+
+		if len(pending) > 0 || wait == 0 {
+			writeJson(w, http.StatusOK, pending)
+			return
+		}
+
+		select {
+		case <-changed:
+		case <-timeout.C:
+			writeJson(w, http.StatusOK, pending)
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+func (s *Server) session(id string) (*session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.evictExpired()
+
+	sess, ok := s.sessions[id]
+	return sess, ok
+}
+
+// evictExpired drops the sessions past their expiry. s.mu must be held.
+func (s *Server) evictExpired() {
+	now := time.Now()
+	for id, sess := range s.sessions {
+		if now.After(sess.expires) {
+			delete(s.sessions, id)
+		}
+	}
+}
+
+// authorizeCreator tells whether r carries the creator token, writing an
+// error response if it doesn't.
+func (s *Server) authorizeCreator(w http.ResponseWriter, r *http.Request) bool {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || subtle.ConstantTimeCompare([]byte(token), s.creatorToken) != 1 {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, http.StatusUnauthorized, &ErrorResponse{Code: ErrorCodeUnauthorized, Message: "a valid creator token is required"})
+		return false
+	}
+	return true
+}
+
+// authorizeSigner tells whether proof is a signature of message by the
+// signer of identifier, writing an error response if it isn't.
+func (s *Server) authorizeSigner(w http.ResponseWriter, identifier frost.ParticipantIdentifier, message frost.Message, proof []byte) bool {
+	if _, ok := s.publicKeyPackage.VerifyingShares[identifier]; !ok {
+		writeError(w, http.StatusForbidden, &ErrorResponse{Code: ErrorCodeUnknownSigner, Message: "signer is not part of the group"})
+		return false
+	}
+
+	if err := frost.VerifySignatureWithVerifyingShare(message, frost.FrostSignature{Data: proof}, identifier, s.publicKeyPackage); err != nil {
+		writeError(w, http.StatusUnauthorized, &ErrorResponse{Code: ErrorCodeUnauthorized, Message: "proof of the signer is invalid"})
+		return false
+	}
+	return true
+}
+
+func (s *Server) createSession(w http.ResponseWriter, r *http.Request) {
+	if !s.authorizeCreator(w, r) {
+		return
+	}
+
+	var request CreateSessionRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	signers := request.Signers
+	if signers == 0 {
+		signers = s.configuration.MinSigners
+	}
+
+	if signers < s.configuration.MinSigners || signers > s.configuration.MaxSigners {
+		writeError(w, http.StatusBadRequest, &ErrorResponse{
+			Code:    ErrorCodeMalformedRequest,
+			Message: fmt.Sprintf("signers must be between %d and %d", s.configuration.MinSigners, s.configuration.MaxSigners),
+		})
+		return
+	}
+
+	message := frost.Message{Data: request.Message}
+
+	coordinator, err := frost.NewCoordinator(s.configuration, s.publicKeyPackage, message)
+	if err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	sess := &session{
+		id:              hex.EncodeToString(id),
+		expires:         time.Now().Add(s.SessionTTL),
+		message:         message,
+		signers:         signers,
+		coordinator:     coordinator,
+		commitments:     make(map[frost.ParticipantIdentifier]frost.FrostSigningCommitments),
+		signatureShares: make(map[frost.ParticipantIdentifier]frost.FrostSignatureShare),
+	}
+
+	s.mu.Lock()
+	s.evictExpired()
+	s.sessions[sess.id] = sess
+	s.mu.Unlock()
+	s.notify()
+
+	writeJson(w, http.StatusCreated, sess.status())
+}
+
+func (s *Server) status(w http.ResponseWriter, sess *session) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	writeJson(w, http.StatusOK, sess.status())
+}
+
+func (s *Server) register(w http.ResponseWriter, r *http.Request, sess *session) {
+	var request RegisterRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	identifier, ok := parseIdentifier(w, request.Identifier)
+	if !ok {
+		return
+	}
+
+	if !s.authorizeSigner(w, identifier, RegistrationProofMessage(sess.id, identifier), request.Proof) {
+		return
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	// registering again is harmless, e.g. after a lost response
+	if !sess.isRegistered(identifier) {
+		if sess.phase() != SessionPhaseCommitments {
+			writeError(w, http.StatusConflict, &ErrorResponse{Code: ErrorCodeInvalidPhase, Message: "session is past registration"})
+			return
+		}
+
+		if len(sess.registered) >= int(sess.signers) {
+			writeError(w, http.StatusConflict, &ErrorResponse{Code: ErrorCodeSessionFull, Message: "session already has all its signers"})
+			return
+		}
+
+		sess.registered = append(sess.registered, identifier)
+		defer s.notify()
+	}
+
+	writeJson(w, http.StatusOK, sess.status())
+}
+
+func (s *Server) receiveCommitment(w http.ResponseWriter, r *http.Request, sess *session) {
+	var request CommitmentRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	identifier, ok := parseIdentifier(w, request.Identifier)
+	if !ok {
+		return
+	}
+
+	commitment, err := frost.JsonToCommitment(string(request.Commitment), identifier)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: err.Error()})
+		return
+	}
+
+	if !s.authorizeSigner(w, identifier, CommitmentProofMessage(sess.id, commitment), request.Proof) {
+		return
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if !sess.requireNotFailed(w) {
+		return
+	}
+
+	if !sess.isRegistered(identifier) {
+		writeError(w, http.StatusForbidden, &ErrorResponse{Code: ErrorCodeUnknownSigner, Message: "signer is not registered in the session"})
+		return
+	}
+
+	if received, ok := sess.commitments[identifier]; ok && bytes.Equal(received.Data, commitment.Data) {
+		writeJson(w, http.StatusOK, sess.status())
+		return
+	}
+
+	if err := sess.coordinator.ReceiveCommitment(commitment); err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	sess.commitments[identifier] = commitment
+	sess.committed = append(sess.committed, identifier)
+	defer s.notify()
+
+	if len(sess.committed) == int(sess.signers) {
+		round2, err := sess.coordinator.CreateSigningPackage()
+		if err != nil {
+			sess.failure = err
+			writeFrostError(w, err)
+			return
+		}
+		sess.round2 = &round2
+	}
+
+	writeJson(w, http.StatusOK, sess.status())
+}
+
+func (s *Server) signingPackage(w http.ResponseWriter, sess *session) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if !sess.requireNotFailed(w) {
+		return
+	}
+
+	if sess.round2 == nil {
+		writeError(w, http.StatusConflict, &ErrorResponse{Code: ErrorCodeNotReady, Message: "waiting for commitments"})
+		return
+	}
+
+	randomizerJson, err := frost.RandomizerToJson(sess.round2.Randomizer)
+	if err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	commitments := make([]CommitmentRequest, 0, len(sess.committed))
+	for _, identifier := range sess.committed {
+		commitmentJson, err := frost.CommitmentToJson(sess.commitments[identifier])
+		if err != nil {
+			writeFrostError(w, err)
+			return
+		}
+
+		commitments = append(commitments, CommitmentRequest{
+			Identifier: IdentifierToJson(identifier),
+			Commitment: json.RawMessage(commitmentJson),
+		})
+	}
+
+	writeJson(w, http.StatusOK, SigningPackageResponse{
+		SigningPackage: sess.round2.SigningPackage.Data,
+		Randomizer:     json.RawMessage(randomizerJson),
+		Commitments:    commitments,
+	})
+}
+
+func (s *Server) receiveSignatureShare(w http.ResponseWriter, r *http.Request, sess *session) {
+	var request SignatureShareRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	identifier, ok := parseIdentifier(w, request.Identifier)
+	if !ok {
+		return
+	}
+
+	signatureShare, err := frost.JsonToSignatureShare(string(request.SignatureShare), identifier)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: err.Error()})
+		return
+	}
+
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if !sess.requireNotFailed(w) {
+		return
+	}
+
+	if received, ok := sess.signatureShares[identifier]; ok && bytes.Equal(received.Data, signatureShare.Data) {
+		writeJson(w, http.StatusOK, sess.status())
+		return
+	}
+
+	if err := sess.coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	sess.signatureShares[identifier] = signatureShare
+	sess.signed = append(sess.signed, identifier)
+	defer s.notify()
+
+	if len(sess.signed) == len(sess.committed) {
+		signature, err := sess.coordinator.Aggregate()
+		if err != nil {
+			sess.failure = err
+			writeFrostError(w, err)
+			return
+		}
+
+		if err := frost.VerifyRandomizedSignature(sess.round2.Randomizer, sess.message, signature, s.publicKeyPackage); err != nil {
+			sess.failure = err
+			writeFrostError(w, err)
+			return
+		}
+
+		sess.signature = &signature
+	}
+
+	writeJson(w, http.StatusOK, sess.status())
+}
+
+func (s *Server) signature(w http.ResponseWriter, sess *session) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+
+	if !sess.requireNotFailed(w) {
+		return
+	}
+
+	if sess.signature == nil {
+		writeError(w, http.StatusConflict, &ErrorResponse{Code: ErrorCodeNotReady, Message: "waiting for signature shares"})
+		return
+	}
+
+	randomizerJson, err := frost.RandomizerToJson(sess.round2.Randomizer)
+	if err != nil {
+		writeFrostError(w, err)
+		return
+	}
+
+	writeJson(w, http.StatusOK, SignatureResponse{
+		Signature:  sess.signature.Data,
+		Randomizer: json.RawMessage(randomizerJson),
+	})
+}
+
+func allowMethod(w http.ResponseWriter, r *http.Request, method string) bool {
+	if r.Method != method {
+		w.Header().Set("Allow", method)
+		writeError(w, http.StatusMethodNotAllowed, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: "method not allowed"})
+		return false
+	}
+	return true
+}
+
+func readJson(w http.ResponseWriter, r *http.Request, v any) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: err.Error()})
+		return false
+	}
+	return true
+}
+
+func parseIdentifier(w http.ResponseWriter, raw json.RawMessage) (frost.ParticipantIdentifier, bool) {
+	identifier, err := IdentifierFromJson(raw)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, &ErrorResponse{Code: ErrorCodeMalformedRequest, Message: err.Error()})
+		return frost.ParticipantIdentifier{}, false
+	}
+	return identifier, true
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, response *ErrorResponse) {
+	writeJson(w, status, response)
+}
+
+// writeFrostError maps the errors of frost.Coordinator to HTTP statuses.
+func writeFrostError(w http.ResponseWriter, err error) {
+	response := &ErrorResponse{Code: ErrorCodeInternal, Message: err.Error()}
+	status := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, frost.ErrCoordinatorUnknownIdentifier),
+		errors.Is(err, frost.ErrCoordinatorUnexpectedSignatureShare):
+		response.Code, status = ErrorCodeUnknownSigner, http.StatusForbidden
+	case errors.Is(err, frost.ErrCoordinatorRepeatedCommitment),
+		errors.Is(err, frost.ErrCoordinatorRepeatedSignatureShare):
+		response.Code, status = ErrorCodeRepeated, http.StatusConflict
+	case errors.Is(err, frost.ErrCoordinatorInvalidPhase):
+		response.Code, status = ErrorCodeInvalidPhase, http.StatusConflict
+	case errors.Is(err, frost.ErrCoordinatorIncorrectNumberOfCommitments):
+		response.Code, status = ErrorCodeSessionFull, http.StatusConflict
+	}
+
+	if culprits := frost.SignatureShareCulprits(err); culprits != nil {
+		response.Code, status = ErrorCodeInvalidSignatureShare, http.StatusUnprocessableEntity
+		response.Culprits = identifiersToJson(culprits)
+	}
+
+	writeError(w, status, response)
+}
+
+func identifiersToJson(identifiers []frost.ParticipantIdentifier) []json.RawMessage {
+	raw := make([]json.RawMessage, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		raw = append(raw, IdentifierToJson(identifier))
+	}
+	return raw
+}
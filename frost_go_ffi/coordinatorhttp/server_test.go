@@ -0,0 +1,351 @@
+package coordinatorhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+const creatorToken = "i am the creator token"
+
+// call sends request to url and decodes the response into response,
+// failing the test if the status is not the expected one.
+func call(t *testing.T, method string, url string, request any, status int, response any) {
+	t.Helper()
+
+	callWithToken(t, method, url, "", request, status, response)
+}
+
+// createSession creates a session on the server at url with the creator
+// token.
+func createSession(t *testing.T, url string, request CreateSessionRequest) Session {
+	t.Helper()
+
+	var session Session
+	callWithToken(t, http.MethodPost, url+"/sessions", creatorToken, request, http.StatusCreated, &session)
+	return session
+}
+
+// callWithToken is call with token as bearer token, if not empty.
+func callWithToken(t *testing.T, method string, url string, token string, request any, status int, response any) {
+	t.Helper()
+
+	var body bytes.Buffer
+	if request != nil {
+		if err := json.NewEncoder(&body).Encode(request); err != nil {
+			t.Fatalf("Failed to encode request: %v", err)
+		}
+	}
+
+	httpRequest, err := http.NewRequest(method, url, &body)
+	if err != nil {
+		t.Fatalf("Failed to create request: %v", err)
+	}
+	if token != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	httpResponse, err := http.DefaultClient.Do(httpRequest)
+	if err != nil {
+		t.Fatalf("Failed to %s %s: %v", method, url, err)
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != status {
+		var errorResponse ErrorResponse
+		json.NewDecoder(httpResponse.Body).Decode(&errorResponse)
+		t.Fatalf("Expected status %d from %s %s, got %d: %v", status, method, url, httpResponse.StatusCode, &errorResponse)
+	}
+
+	if response != nil {
+		if err := json.NewDecoder(httpResponse.Body).Decode(response); err != nil {
+			t.Fatalf("Failed to decode response: %v", err)
+		}
+	}
+}
+
+// registerRequest returns the request registering the signer of
+// keyPackage in the session sessionID.
+func registerRequest(t *testing.T, sessionID string, keyPackage frost.FrostKeyPackage) RegisterRequest {
+	t.Helper()
+
+	request, err := NewRegisterRequest(sessionID, keyPackage)
+	if err != nil {
+		t.Fatalf("Failed to create register request: %v", err)
+	}
+	return request
+}
+
+func TestServerCoordinatesSigningSession(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := []byte("i am a message")
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	session := createSession(t, server.URL, CreateSessionRequest{Message: message})
+
+	sessionURL := server.URL + "/sessions/" + session.ID
+
+	var signers []*frost.SigningParticipant
+	for _, keyPackage := range keyPackages[:config.MinSigners] {
+		call(t, http.MethodPost, sessionURL+"/signers", registerRequest(t, session.ID, keyPackage), http.StatusOK, nil)
+		signers = append(signers, frost.NewSigningParticipant(keyPackage))
+	}
+
+	late := registerRequest(t, session.ID, keyPackages[config.MinSigners])
+	var errorResponse ErrorResponse
+	call(t, http.MethodPost, sessionURL+"/signers", late, http.StatusConflict, &errorResponse)
+	if errorResponse.Code != ErrorCodeSessionFull {
+		t.Fatalf("Expected error code %s, got %s", ErrorCodeSessionFull, errorResponse.Code)
+	}
+
+	call(t, http.MethodGet, sessionURL+"/signing-package", nil, http.StatusConflict, nil)
+
+	for i, signer := range signers {
+		commitment, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		request, err := NewCommitmentRequest(session.ID, keyPackages[i], commitment)
+		if err != nil {
+			t.Fatalf("Failed to create commitment request: %v", err)
+		}
+
+		call(t, http.MethodPost, sessionURL+"/commitments", request, http.StatusOK, nil)
+	}
+
+	var signingPackage SigningPackageResponse
+	call(t, http.MethodGet, sessionURL+"/signing-package", nil, http.StatusOK, &signingPackage)
+
+	randomizer, err := frost.JsonToRandomizer(string(signingPackage.Randomizer))
+	if err != nil {
+		t.Fatalf("Failed to parse randomizer: %v", err)
+	}
+
+	round2Config := frost.Round2Configuration{
+		SigningPackage: frost.FrostSigningPackage{Data: signingPackage.SigningPackage},
+		Randomizer:     randomizer,
+	}
+
+	for _, signer := range signers {
+		signatureShare, err := signer.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		signatureShareJson, err := frost.SignatureSharePackageToJson(signatureShare)
+		if err != nil {
+			t.Fatalf("Failed to serialize signature share: %v", err)
+		}
+
+		call(t, http.MethodPost, sessionURL+"/signature-shares", SignatureShareRequest{
+			Identifier:     IdentifierToJson(signer.Identifier()),
+			SignatureShare: json.RawMessage(signatureShareJson),
+		}, http.StatusOK, &session)
+	}
+
+	if session.Phase != SessionPhaseCompleted {
+		t.Fatalf("Expected phase %s, got %s", SessionPhaseCompleted, session.Phase)
+	}
+
+	var signature SignatureResponse
+	call(t, http.MethodGet, sessionURL+"/signature", nil, http.StatusOK, &signature)
+
+	err = frost.VerifyRandomizedSignature(randomizer, frost.Message{Data: message}, frost.FrostSignature{Data: signature.Signature}, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+func TestServerRequiresCreatorToken(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	if _, err := NewServer(config, publicKey, ""); !errors.Is(err, ErrServerNoCreatorToken) {
+		t.Fatalf("Expected ErrServerNoCreatorToken, got %v", err)
+	}
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	request := CreateSessionRequest{Message: []byte("i am a message")}
+
+	var errorResponse ErrorResponse
+	call(t, http.MethodPost, server.URL+"/sessions", request, http.StatusUnauthorized, &errorResponse)
+	if errorResponse.Code != ErrorCodeUnauthorized {
+		t.Fatalf("Expected error code %s, got %s", ErrorCodeUnauthorized, errorResponse.Code)
+	}
+
+	callWithToken(t, http.MethodPost, server.URL+"/sessions", "i am not the creator token", request, http.StatusUnauthorized, nil)
+
+	createSession(t, server.URL, request)
+}
+
+func TestServerEvictsExpiredSessions(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+	coordinator.SessionTTL = 50 * time.Millisecond
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	session := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message")})
+	call(t, http.MethodGet, server.URL+"/sessions/"+session.ID, nil, http.StatusOK, nil)
+
+	time.Sleep(2 * coordinator.SessionTTL)
+
+	var errorResponse ErrorResponse
+	call(t, http.MethodGet, server.URL+"/sessions/"+session.ID, nil, http.StatusNotFound, &errorResponse)
+	if errorResponse.Code != ErrorCodeSessionNotFound {
+		t.Fatalf("Expected error code %s, got %s", ErrorCodeSessionNotFound, errorResponse.Code)
+	}
+}
+
+func TestServerReportsFailedSessions(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	session := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message")})
+	sessionURL := server.URL + "/sessions/" + session.ID
+
+	call(t, http.MethodPost, sessionURL+"/signers", registerRequest(t, session.ID, keyPackages[0]), http.StatusOK, nil)
+	signer := IdentifierToJson(keyPackages[0].Identifier)
+
+	// as if CreateSigningPackage failed
+	sess, _ := coordinator.session(session.ID)
+	sess.mu.Lock()
+	sess.failure = errors.New("i am a failure")
+	sess.mu.Unlock()
+
+	call(t, http.MethodGet, sessionURL, nil, http.StatusOK, &session)
+	if session.Phase != SessionPhaseFailed || session.Failure != "i am a failure" {
+		t.Fatalf("Expected phase %s with the failure, got %+v", SessionPhaseFailed, session)
+	}
+
+	var pending []Session
+	call(t, http.MethodGet, server.URL+"/sessions?signer="+strings.Trim(string(signer), `"`), nil, http.StatusOK, &pending)
+	if len(pending) != 0 {
+		t.Fatalf("Expected no pending session, got %+v", pending)
+	}
+
+	for _, path := range []string{"/signing-package", "/signature"} {
+		var errorResponse ErrorResponse
+		call(t, http.MethodGet, sessionURL+path, nil, http.StatusConflict, &errorResponse)
+		if errorResponse.Code != ErrorCodeSessionFailed {
+			t.Fatalf("Expected error code %s from %s, got %s", ErrorCodeSessionFailed, path, errorResponse.Code)
+		}
+	}
+}
+
+func TestServerAuthenticatesSigners(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewServer(config, publicKey, creatorToken)
+	if err != nil {
+		t.Fatalf("Failed to create server: %v", err)
+	}
+
+	server := httptest.NewServer(coordinator)
+	defer server.Close()
+
+	session := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am a message")})
+	other := createSession(t, server.URL, CreateSessionRequest{Message: []byte("i am another message")})
+	sessionURL := server.URL + "/sessions/" + session.ID
+
+	signer := keyPackages[0]
+	identifier := IdentifierToJson(signer.Identifier)
+
+	// a signer registering in the place of another one
+	impostor := registerRequest(t, session.ID, keyPackages[1])
+	impostor.Identifier = identifier
+
+	forged := []RegisterRequest{
+		{Identifier: identifier},
+		impostor,
+		registerRequest(t, other.ID, signer),
+	}
+	for _, request := range forged {
+		var errorResponse ErrorResponse
+		call(t, http.MethodPost, sessionURL+"/signers", request, http.StatusUnauthorized, &errorResponse)
+		if errorResponse.Code != ErrorCodeUnauthorized {
+			t.Fatalf("Expected error code %s, got %s", ErrorCodeUnauthorized, errorResponse.Code)
+		}
+	}
+
+	call(t, http.MethodGet, sessionURL, nil, http.StatusOK, &session)
+	if len(session.Registered) != 0 {
+		t.Fatalf("Expected no registered signer, got %s", session.Registered)
+	}
+
+	call(t, http.MethodPost, sessionURL+"/signers", registerRequest(t, session.ID, signer), http.StatusOK, nil)
+
+	commitment, err := frost.NewSigningParticipant(signer).Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+
+	// a commitment with the proof of another one
+	request, err := NewCommitmentRequest(session.ID, signer, commitment)
+	if err != nil {
+		t.Fatalf("Failed to create commitment request: %v", err)
+	}
+	swapped, err := frost.NewSigningParticipant(signer).Commit()
+	if err != nil {
+		t.Fatalf("Failed to commit: %v", err)
+	}
+	swappedJson, err := frost.CommitmentToJson(swapped)
+	if err != nil {
+		t.Fatalf("Failed to serialize commitment: %v", err)
+	}
+	request.Commitment = json.RawMessage(swappedJson)
+
+	var errorResponse ErrorResponse
+	call(t, http.MethodPost, sessionURL+"/commitments", request, http.StatusUnauthorized, &errorResponse)
+	if errorResponse.Code != ErrorCodeUnauthorized {
+		t.Fatalf("Expected error code %s, got %s", ErrorCodeUnauthorized, errorResponse.Code)
+	}
+
+	call(t, http.MethodGet, sessionURL, nil, http.StatusOK, &session)
+	if len(session.Committed) != 0 {
+		t.Fatalf("Expected no commitment, got %s", session.Committed)
+	}
+}
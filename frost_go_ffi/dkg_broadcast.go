@@ -0,0 +1,253 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Err* are used for checking DkgBroadcast errors with `errors.Is`
+var ErrDkgEquivocation = errors.New("participants got different round 1 packages from the same sender")
+var ErrDkgBroadcastIncomplete = errors.New("round 1 packages of some peers are missing")
+
+// DkgEquivocationError is returned when the echo of a peer shows that it
+// got a round 1 package different from ours for the Culprits. Either each
+// culprit sent different packages to different participants, or Reporter
+// lied in its echo: the DKG must be aborted either way.
+type DkgEquivocationError struct {
+	Culprits []ParticipantIdentifier
+	Reporter ParticipantIdentifier
+}
+
+func (e *DkgEquivocationError) Error() string {
+	culprits := make([]string, len(e.Culprits))
+	for i, culprit := range e.Culprits {
+		culprits[i] = culprit.Data
+	}
+	return fmt.Sprintf("%s: %s, reported by %s", ErrDkgEquivocation.Error(), strings.Join(culprits, ", "), e.Reporter.Data)
+}
+
+func (e *DkgEquivocationError) Unwrap() error {
+	return ErrDkgEquivocation
+}
+
+// DkgEquivocators returns the participants blamed by err for sending
+// different round 1 packages to different peers, or nil if err doesn't
+// identify any.
+func DkgEquivocators(err error) []ParticipantIdentifier {
+	var equivocationError *DkgEquivocationError
+	if errors.As(err, &equivocationError) {
+		return equivocationError.Culprits
+	}
+	return nil
+}
+
+// DkgRound1Digest is the hash of the round 1 package of Identifier.
+type DkgRound1Digest struct {
+	Identifier ParticipantIdentifier `json:"identifier"`
+	Digest     []byte                `json:"digest"`
+}
+
+// DkgRound1Echo is the view of the round 1 of a DKG by Sender: the digest
+// of every round 1 package it received, its own included.
+type DkgRound1Echo struct {
+	Sender  ParticipantIdentifier `json:"sender"`
+	Digests []DkgRound1Digest     `json:"digests"`
+}
+
+func dkgRound1Digest(round1Package DkgRound1Package) []byte {
+	h := sha256.New()
+	h.Write([]byte("frost-uniffi-sdk/dkg-round1-echo/v1"))
+	h.Write([]byte(round1Package.Identifier.Data))
+	h.Write([]byte{0})
+	h.Write(round1Package.Data)
+	return h.Sum(nil)
+}
+
+// DkgBroadcast makes the round 1 broadcast of a DkgSession consistent:
+// round 1 packages are held until every peer echoed the digests of the
+// packages it received and all echoes agree with ours, and only then
+// handed to the session, which runs Part2.
+//
+//  1. broadcast session.Round1Package() to every peer and feed the peers'
+//     round 1 packages to ReceiveRound1Package.
+//  2. once all of them arrived, broadcast Echo() to every peer and feed
+//     the peers' echoes to ReceiveEcho.
+//  3. once all echoes arrived and agree, the session is in DkgPhaseRound2.
+//
+// Echoes must travel over authenticated channels, so that a participant
+// can't send an echo on behalf of another one.
+//
+// A DkgBroadcast is safe for concurrent use by multiple goroutines.
+type DkgBroadcast struct {
+	mu       sync.Mutex
+	session  *DkgSession
+	packages map[ParticipantIdentifier]DkgRound1Package
+	echoes   map[ParticipantIdentifier]DkgRound1Echo
+	echo     *DkgRound1Echo
+	err      error
+}
+
+// NewDkgBroadcast creates the broadcast of the round 1 packages of
+// session, which must not have received any yet.
+func NewDkgBroadcast(session *DkgSession) *DkgBroadcast {
+	own := session.Round1Package()
+
+	return &DkgBroadcast{
+		session:  session,
+		packages: map[ParticipantIdentifier]DkgRound1Package{own.Identifier: own},
+		echoes:   make(map[ParticipantIdentifier]DkgRound1Echo),
+	}
+}
+
+// ReceiveRound1Package receives the round 1 package broadcast by a peer.
+// When the last missing package arrives, Echo becomes available.
+func (b *DkgBroadcast) ReceiveRound1Package(round1Package DkgRound1Package) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	sender := round1Package.Identifier
+	if err := b.validatePeer(sender); err != nil {
+		return err
+	}
+
+	if received, ok := b.packages[sender]; ok {
+		if !bytes.Equal(received.Data, round1Package.Data) {
+			b.err = &DkgEquivocationError{Culprits: []ParticipantIdentifier{sender}, Reporter: b.session.Identifier()}
+			return b.err
+		}
+		return &DkgPeerError{Identifier: sender, Err: ErrDkgRepeatedPackage}
+	}
+
+	b.packages[sender] = round1Package
+
+	if len(b.packages) == len(b.session.peers)+1 {
+		echo := DkgRound1Echo{Sender: b.session.Identifier()}
+		for identifier, received := range b.packages {
+			echo.Digests = append(echo.Digests, DkgRound1Digest{Identifier: identifier, Digest: dkgRound1Digest(received)})
+		}
+		sort.Slice(echo.Digests, func(i, j int) bool {
+			return echo.Digests[i].Identifier.Data < echo.Digests[j].Identifier.Data
+		})
+		b.echo = &echo
+	}
+
+	return b.maybeDeliver()
+}
+
+// Echo returns the echo of this participant, to be broadcast to every peer
+// once all round 1 packages were received.
+func (b *DkgBroadcast) Echo() (DkgRound1Echo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.echo == nil {
+		return DkgRound1Echo{}, ErrDkgBroadcastIncomplete
+	}
+
+	return *b.echo, nil
+}
+
+// ReceiveEcho receives the echo of a peer. Echoes may arrive before this
+// participant got all round 1 packages. Once every echo and package is in
+// they are compared: any disagreement aborts the DKG with a
+// *DkgEquivocationError, returned by every later call, otherwise the
+// packages are handed to the session. Errors of the session, such as a
+// *DkgPeerError for an invalid package, are returned once.
+func (b *DkgBroadcast) ReceiveEcho(echo DkgRound1Echo) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.err != nil {
+		return b.err
+	}
+
+	if err := b.validatePeer(echo.Sender); err != nil {
+		return err
+	}
+
+	if _, ok := b.echoes[echo.Sender]; ok {
+		return &DkgPeerError{Identifier: echo.Sender, Err: ErrDkgRepeatedPackage}
+	}
+
+	b.echoes[echo.Sender] = echo
+
+	return b.maybeDeliver()
+}
+
+func (b *DkgBroadcast) validatePeer(identifier ParticipantIdentifier) error {
+	for _, peer := range b.session.peers {
+		if peer == identifier {
+			return nil
+		}
+	}
+	return &DkgPeerError{Identifier: identifier, Err: ErrDkgUnknownPeer}
+}
+
+// maybeDeliver checks the echoes and hands the packages to the session
+// once everything was received.
+func (b *DkgBroadcast) maybeDeliver() error {
+	if b.echo == nil || len(b.echoes) < len(b.session.peers) {
+		return nil
+	}
+
+	own := b.session.Identifier()
+	ours := make(map[ParticipantIdentifier][]byte, len(b.echo.Digests))
+	for _, digest := range b.echo.Digests {
+		ours[digest.Identifier] = digest.Digest
+	}
+
+	for _, peer := range b.session.peers {
+		echo := b.echoes[peer]
+
+		theirs := make(map[ParticipantIdentifier][]byte, len(echo.Digests))
+		for _, digest := range echo.Digests {
+			theirs[digest.Identifier] = digest.Digest
+		}
+
+		blamed := make(map[ParticipantIdentifier]bool)
+		for identifier, digest := range ours {
+			if bytes.Equal(digest, theirs[identifier]) {
+				continue
+			}
+			// we know what we sent: the reporter lies about our package
+			if identifier == own {
+				identifier = peer
+			}
+			blamed[identifier] = true
+		}
+		if len(theirs) != len(ours) {
+			blamed[peer] = true
+		}
+
+		if len(blamed) > 0 {
+			var culprits []ParticipantIdentifier
+			for culprit := range blamed {
+				culprits = append(culprits, culprit)
+			}
+			sort.Slice(culprits, func(i, j int) bool {
+				return culprits[i].Data < culprits[j].Data
+			})
+			b.err = &DkgEquivocationError{Culprits: culprits, Reporter: peer}
+			return b.err
+		}
+	}
+
+	// packages rejected by the session are the same for every participant,
+	// only an equivocation aborts the broadcast itself
+	for _, peer := range b.session.peers {
+		if err := b.session.ReceiveRound1Package(b.packages[peer]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
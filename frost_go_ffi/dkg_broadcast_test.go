@@ -0,0 +1,144 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+// exchangeRound1 sends the round 1 package of every session to the
+// broadcast of every other one, then the echoes. round1Package lets a
+// sender equivocate by sending another package to some receivers.
+func exchangeRound1(t *testing.T, sessions []*DkgSession, broadcasts []*DkgBroadcast, round1Package func(sender, receiver int) DkgRound1Package) []error {
+	for i := range sessions {
+		for j, broadcast := range broadcasts {
+			if i == j {
+				continue
+			}
+			if err := broadcast.ReceiveRound1Package(round1Package(i, j)); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	errs := make([]error, len(broadcasts))
+	for i, sender := range broadcasts {
+		echo, err := sender.Echo()
+		if err != nil {
+			t.Fatalf("Failed to get echo: %v", err)
+		}
+		for j, receiver := range broadcasts {
+			if i == j || errs[j] != nil {
+				continue
+			}
+			errs[j] = receiver.ReceiveEcho(echo)
+		}
+	}
+
+	return errs
+}
+
+func TestDkgBroadcastDeliversConsistentRound1(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+
+	var broadcasts []*DkgBroadcast
+	for _, session := range sessions {
+		broadcasts = append(broadcasts, NewDkgBroadcast(session))
+	}
+
+	errs := exchangeRound1(t, sessions, broadcasts, func(sender, receiver int) DkgRound1Package {
+		return sessions[sender].Round1Package()
+	})
+
+	for i, session := range sessions {
+		if errs[i] != nil {
+			t.Fatalf("Failed to check echoes: %v", errs[i])
+		}
+		if session.Phase() != DkgPhaseRound2 {
+			t.Fatalf("Expected phase %s, got %s", DkgPhaseRound2, session.Phase())
+		}
+	}
+}
+
+func TestDkgBroadcastNamesEquivocatingSender(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+
+	var broadcasts []*DkgBroadcast
+	for _, session := range sessions {
+		broadcasts = append(broadcasts, NewDkgBroadcast(session))
+	}
+
+	// the first participant runs Part1 twice and sends one package to the
+	// second participant and the other one to the third
+	var participants []ParticipantIdentifier
+	for _, session := range sessions {
+		participants = append(participants, session.Identifier())
+	}
+	equivocator := sessions[0].Identifier()
+	other, err := NewDkgSession(equivocator, participants, 2)
+	if err != nil {
+		t.Fatalf("Failed to create DKG session: %v", err)
+	}
+
+	errs := exchangeRound1(t, sessions, broadcasts, func(sender, receiver int) DkgRound1Package {
+		if sender == 0 && receiver == 2 {
+			return other.Round1Package()
+		}
+		return sessions[sender].Round1Package()
+	})
+
+	for i := 1; i < len(sessions); i++ {
+		if !errors.Is(errs[i], ErrDkgEquivocation) {
+			t.Fatalf("Expected ErrDkgEquivocation, got %v", errs[i])
+		}
+
+		culprits := DkgEquivocators(errs[i])
+		if len(culprits) != 1 || culprits[0] != equivocator {
+			t.Fatalf("Expected %v to be blamed, got %v", equivocator, culprits)
+		}
+
+		if sessions[i].Phase() != DkgPhaseRound1 {
+			t.Fatalf("Expected Part2 not to run, session is in %s", sessions[i].Phase())
+		}
+	}
+
+	round1Package := sessions[0].Round1Package()
+	if err := broadcasts[1].ReceiveRound1Package(round1Package); !errors.Is(err, ErrDkgEquivocation) {
+		t.Fatalf("Expected the broadcast to stay aborted, got %v", err)
+	}
+}
+
+func TestDkgBroadcastDoesNotLatchInvalidPackages(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+
+	var broadcasts []*DkgBroadcast
+	for _, session := range sessions {
+		broadcasts = append(broadcasts, NewDkgBroadcast(session))
+	}
+
+	// the first participant sends the same package with an invalid proof
+	// of knowledge to everyone
+	invalid := sessions[0].Round1Package()
+	invalid.Data = append([]byte{}, invalid.Data...)
+	invalid.Data[len(invalid.Data)-20] ^= 1
+
+	errs := exchangeRound1(t, sessions, broadcasts, func(sender, receiver int) DkgRound1Package {
+		if sender == 0 {
+			return invalid
+		}
+		return sessions[sender].Round1Package()
+	})
+
+	for i := 1; i < len(sessions); i++ {
+		var peerError *DkgPeerError
+		if !errors.As(errs[i], &peerError) || peerError.Identifier != invalid.Identifier {
+			t.Fatalf("Expected a *DkgPeerError blaming %v, got %v", invalid.Identifier, errs[i])
+		}
+		if errors.Is(errs[i], ErrDkgEquivocation) {
+			t.Fatalf("Expected no equivocation, got %v", errs[i])
+		}
+	}
+
+	if err := broadcasts[1].ReceiveRound1Package(invalid); !errors.Is(err, ErrDkgRepeatedPackage) {
+		t.Fatalf("Expected ErrDkgRepeatedPackage, got %v", err)
+	}
+}
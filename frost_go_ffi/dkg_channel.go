@@ -0,0 +1,170 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"fmt"
+
+	"frost_go_ffi/frost_go_ffi/commkey"
+
+	"golang.org/x/crypto/curve25519"
+)
+
+// Err* are used for checking DkgChannel errors with `errors.Is`
+var ErrDkgChannelUnknownPeer = errors.New("no communication key for DKG peer")
+var ErrDkgChannelNotRecipient = errors.New("sealed round 2 package is addressed to another participant")
+var ErrDkgChannelOpenFailed = errors.New("sealed round 2 package failed to open")
+var ErrDkgChannelMalformedKey = errors.New("malformed communication key")
+
+const dkgChannelVersion = 2
+
+// CommunicationKeyPair is the X25519 key pair a participant uses to
+// exchange confidential messages with its peers, the same key pair a
+// frostd user logs in with. Peers must learn each other's public key over
+// an authenticated channel beforehand, e.g. along with the list of
+// participants of the DKG.
+type CommunicationKeyPair = commkey.KeyPair
+
+// GenerateCommunicationKeyPair generates a random communication key pair.
+func GenerateCommunicationKeyPair() (*CommunicationKeyPair, error) {
+	return commkey.GenerateKeyPair()
+}
+
+// NewCommunicationKeyPair returns the key pair of an X25519 private key.
+func NewCommunicationKeyPair(private []byte) (*CommunicationKeyPair, error) {
+	if len(private) != curve25519.ScalarSize {
+		return nil, ErrDkgChannelMalformedKey
+	}
+
+	return commkey.NewKeyPair(private)
+}
+
+// SealedDkgRound2Package is a DkgRound2Package encrypted for its
+// recipient and authenticated as coming from its sender.
+type SealedDkgRound2Package struct {
+	Version    int                   `json:"version"`
+	Sender     ParticipantIdentifier `json:"sender"`
+	Recipient  ParticipantIdentifier `json:"recipient"`
+	Ciphertext []byte                `json:"ciphertext"`
+}
+
+func (s *SealedDkgRound2Package) prologue() []byte {
+	return []byte(fmt.Sprintf("frost-uniffi-sdk/dkg-round2/v%d/%s/%s", s.Version, s.Sender.Data, s.Recipient.Data))
+}
+
+// DkgChannel seals the round 2 packages of a participant for its peers and
+// opens the ones they sealed for it.
+//
+// Each package is the handshake message of its own Noise_K session from
+// the sender to the recipient, the one-way pattern frostd messages are
+// encrypted with: it is encrypted with a fresh ephemeral key and
+// authenticates the communication keys of both. The version and the
+// identifiers are the prologue of the handshake: a package can't be
+// replayed as coming from, or going to, another participant.
+type DkgChannel struct {
+	identifier ParticipantIdentifier
+	keyPair    *CommunicationKeyPair
+	peers      map[ParticipantIdentifier]commkey.PublicKey
+}
+
+// NewDkgChannel creates the channel of identifier, using keyPair, with
+// peers given by their communication public keys.
+func NewDkgChannel(identifier ParticipantIdentifier, keyPair *CommunicationKeyPair, peers map[ParticipantIdentifier][]byte) (*DkgChannel, error) {
+	channel := &DkgChannel{
+		identifier: identifier,
+		keyPair:    keyPair,
+		peers:      make(map[ParticipantIdentifier]commkey.PublicKey, len(peers)),
+	}
+
+	for peer, public := range peers {
+		if len(public) != curve25519.PointSize {
+			return nil, &DkgPeerError{Identifier: peer, Err: ErrDkgChannelMalformedKey}
+		}
+		channel.peers[peer] = append(commkey.PublicKey{}, public...)
+	}
+
+	return channel, nil
+}
+
+// SealRound2Package encrypts round2Package for the peer it is addressed
+// to.
+func (c *DkgChannel) SealRound2Package(round2Package DkgRound2Package) (SealedDkgRound2Package, error) {
+	recipient := round2Package.Identifier
+
+	recipientPublic, ok := c.peers[recipient]
+	if !ok {
+		return SealedDkgRound2Package{}, &DkgPeerError{Identifier: recipient, Err: ErrDkgChannelUnknownPeer}
+	}
+
+	sealed := SealedDkgRound2Package{
+		Version:   dkgChannelVersion,
+		Sender:    c.identifier,
+		Recipient: recipient,
+	}
+
+	cipher := commkey.NewCipherWithPrologue(c.keyPair, []commkey.PublicKey{recipientPublic}, sealed.prologue())
+
+	ciphertext, err := cipher.Encrypt(recipientPublic, round2Package.Data)
+	if err != nil {
+		return SealedDkgRound2Package{}, err
+	}
+	sealed.Ciphertext = ciphertext
+
+	return sealed, nil
+}
+
+// OpenRound2Package decrypts a package sealed for this participant and
+// returns it along with its authenticated sender.
+func (c *DkgChannel) OpenRound2Package(sealed SealedDkgRound2Package) (ParticipantIdentifier, DkgRound2Package, error) {
+	if sealed.Recipient != c.identifier {
+		return ParticipantIdentifier{}, DkgRound2Package{}, &DkgPeerError{Identifier: sealed.Sender, Err: ErrDkgChannelNotRecipient}
+	}
+
+	senderPublic, ok := c.peers[sealed.Sender]
+	if !ok {
+		return ParticipantIdentifier{}, DkgRound2Package{}, &DkgPeerError{Identifier: sealed.Sender, Err: ErrDkgChannelUnknownPeer}
+	}
+
+	if sealed.Version != dkgChannelVersion {
+		return ParticipantIdentifier{}, DkgRound2Package{}, &DkgPeerError{Identifier: sealed.Sender, Err: ErrDkgChannelOpenFailed}
+	}
+
+	cipher := commkey.NewCipherWithPrologue(c.keyPair, []commkey.PublicKey{senderPublic}, sealed.prologue())
+
+	data, err := cipher.Decrypt(senderPublic, sealed.Ciphertext)
+	if err != nil {
+		return ParticipantIdentifier{}, DkgRound2Package{}, &DkgPeerError{Identifier: sealed.Sender, Err: ErrDkgChannelOpenFailed}
+	}
+
+	return sealed.Sender, DkgRound2Package{Identifier: c.identifier, Data: data}, nil
+}
+
+// SealRound2Packages seals every round 2 package of session, which must
+// be the session of the participant of this channel.
+func (c *DkgChannel) SealRound2Packages(session *DkgSession) ([]SealedDkgRound2Package, error) {
+	round2Packages, err := session.Round2Packages()
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := make([]SealedDkgRound2Package, 0, len(round2Packages))
+	for _, round2Package := range round2Packages {
+		sealedPackage, err := c.SealRound2Package(round2Package)
+		if err != nil {
+			return nil, err
+		}
+		sealed = append(sealed, sealedPackage)
+	}
+
+	return sealed, nil
+}
+
+// ReceiveSealedRound2Package opens a sealed package and feeds it to
+// session, as received from its authenticated sender.
+func (c *DkgChannel) ReceiveSealedRound2Package(session *DkgSession, sealed SealedDkgRound2Package) error {
+	sender, round2Package, err := c.OpenRound2Package(sealed)
+	if err != nil {
+		return err
+	}
+
+	return session.ReceiveRound2Package(sender, round2Package)
+}
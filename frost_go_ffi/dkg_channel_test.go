@@ -0,0 +1,123 @@
+package frost_uniffi_sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// newDkgChannels returns a channel for each session, with fresh
+// communication keys known to every peer.
+func newDkgChannels(t *testing.T, sessions []*DkgSession) map[ParticipantIdentifier]*DkgChannel {
+	keyPairs := make(map[ParticipantIdentifier]*CommunicationKeyPair)
+	publicKeys := make(map[ParticipantIdentifier][]byte)
+	for _, session := range sessions {
+		keyPair, err := GenerateCommunicationKeyPair()
+		if err != nil {
+			t.Fatalf("Failed to generate communication key pair: %v", err)
+		}
+		keyPairs[session.Identifier()] = keyPair
+		publicKeys[session.Identifier()] = keyPair.Public
+	}
+
+	channels := make(map[ParticipantIdentifier]*DkgChannel)
+	for identifier, keyPair := range keyPairs {
+		channel, err := NewDkgChannel(identifier, keyPair, publicKeys)
+		if err != nil {
+			t.Fatalf("Failed to create DKG channel: %v", err)
+		}
+		channels[identifier] = channel
+	}
+
+	return channels
+}
+
+func TestDkgChannelDeliversSealedRound2Packages(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+	channels := newDkgChannels(t, sessions)
+
+	for _, sender := range sessions {
+		for _, receiver := range sessions {
+			if receiver == sender {
+				continue
+			}
+			if err := receiver.ReceiveRound1Package(sender.Round1Package()); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	byIdentifier := make(map[ParticipantIdentifier]*DkgSession)
+	for _, session := range sessions {
+		byIdentifier[session.Identifier()] = session
+	}
+
+	for _, sender := range sessions {
+		sealedPackages, err := channels[sender.Identifier()].SealRound2Packages(sender)
+		if err != nil {
+			t.Fatalf("Failed to seal round 2 packages: %v", err)
+		}
+
+		for _, sealed := range sealedPackages {
+			// packages travel serialized
+			data, err := json.Marshal(sealed)
+			if err != nil {
+				t.Fatalf("Failed to serialize sealed package: %v", err)
+			}
+
+			var received SealedDkgRound2Package
+			if err := json.Unmarshal(data, &received); err != nil {
+				t.Fatalf("Failed to deserialize sealed package: %v", err)
+			}
+
+			receiver := byIdentifier[received.Recipient]
+			if err := channels[received.Recipient].ReceiveSealedRound2Package(receiver, received); err != nil {
+				t.Fatalf("Failed to receive sealed round 2 package: %v", err)
+			}
+		}
+	}
+
+	for _, session := range sessions {
+		if session.Phase() != DkgPhaseCompleted {
+			t.Fatalf("Expected phase %s, got %s", DkgPhaseCompleted, session.Phase())
+		}
+	}
+}
+
+func TestDkgChannelRejectsForgedPackages(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+	channels := newDkgChannels(t, sessions)
+
+	sender, recipient, other := sessions[0].Identifier(), sessions[1].Identifier(), sessions[2].Identifier()
+
+	sealed, err := channels[sender].SealRound2Package(DkgRound2Package{Identifier: recipient, Data: []byte("secret share")})
+	if err != nil {
+		t.Fatalf("Failed to seal package: %v", err)
+	}
+
+	if _, _, err := channels[other].OpenRound2Package(sealed); !errors.Is(err, ErrDkgChannelNotRecipient) {
+		t.Fatalf("Expected ErrDkgChannelNotRecipient, got %v", err)
+	}
+
+	impersonated := sealed
+	impersonated.Sender = other
+	if _, _, err := channels[recipient].OpenRound2Package(impersonated); !errors.Is(err, ErrDkgChannelOpenFailed) {
+		t.Fatalf("Expected ErrDkgChannelOpenFailed, got %v", err)
+	}
+
+	tampered := sealed
+	tampered.Ciphertext = append([]byte{}, sealed.Ciphertext...)
+	tampered.Ciphertext[0] ^= 1
+	if _, _, err := channels[recipient].OpenRound2Package(tampered); !errors.Is(err, ErrDkgChannelOpenFailed) {
+		t.Fatalf("Expected ErrDkgChannelOpenFailed, got %v", err)
+	}
+
+	from, round2Package, err := channels[recipient].OpenRound2Package(sealed)
+	if err != nil {
+		t.Fatalf("Failed to open package: %v", err)
+	}
+
+	if from != sender || string(round2Package.Data) != "secret share" {
+		t.Fatalf("Unexpected package %q from %v", round2Package.Data, from)
+	}
+}
@@ -0,0 +1,227 @@
+package frost_uniffi_sdk
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const (
+	sealedDkgRound1SecretPackageKind = "dkg-round1-secret-package"
+	sealedDkgRound2SecretPackageKind = "dkg-round2-secret-package"
+	sealedDkgSessionKind             = "dkg-session"
+)
+
+// ExportDkgRound1SecretPackage serializes secretPackage and encrypts it
+// with passphrase. The result can be stored to resume the DKG after a
+// restart with ImportDkgRound1SecretPackage.
+//
+// Use DkgRound1SecretPackageToJson only if the secret is protected by
+// other means: its output is secret material in the clear.
+func ExportDkgRound1SecretPackage(secretPackage *DkgRound1SecretPackage, passphrase []byte) ([]byte, error) {
+	secretJson, err := DkgRound1SecretPackageToJson(secretPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return sealSecret(sealedDkgRound1SecretPackageKind, []byte(secretJson), passphrase)
+}
+
+// ImportDkgRound1SecretPackage decrypts a secret package exported with
+// ExportDkgRound1SecretPackage.
+func ImportDkgRound1SecretPackage(exported []byte, passphrase []byte) (*DkgRound1SecretPackage, error) {
+	secretJson, err := openSecret(sealedDkgRound1SecretPackageKind, exported, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return JsonToDkgRound1SecretPackage(string(secretJson))
+}
+
+// ExportDkgRound2SecretPackage serializes secretPackage and encrypts it
+// with passphrase. The result can be stored to resume the DKG after a
+// restart with ImportDkgRound2SecretPackage.
+//
+// Use DkgRound2SecretPackageToJson only if the secret is protected by
+// other means: its output is secret material in the clear.
+func ExportDkgRound2SecretPackage(secretPackage *DkgRound2SecretPackage, passphrase []byte) ([]byte, error) {
+	secretJson, err := DkgRound2SecretPackageToJson(secretPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return sealSecret(sealedDkgRound2SecretPackageKind, []byte(secretJson), passphrase)
+}
+
+// ImportDkgRound2SecretPackage decrypts a secret package exported with
+// ExportDkgRound2SecretPackage.
+func ImportDkgRound2SecretPackage(exported []byte, passphrase []byte) (*DkgRound2SecretPackage, error) {
+	secretJson, err := openSecret(sealedDkgRound2SecretPackageKind, exported, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return JsonToDkgRound2SecretPackage(string(secretJson))
+}
+
+// ErrDkgCheckpointInconsistent is returned when a restored checkpoint
+// lacks the secret material required by its phase.
+var ErrDkgCheckpointInconsistent = errors.New("DKG checkpoint is inconsistent with its phase")
+
+type dkgCheckpointRound2Package struct {
+	Sender  ParticipantIdentifier
+	Package DkgRound2Package
+}
+
+type dkgCheckpoint struct {
+	Identifier       ParticipantIdentifier
+	Peers            []ParticipantIdentifier
+	Phase            DkgPhase
+	Round1Secret     string `json:",omitempty"`
+	Round1Package    DkgRound1Package
+	Round2Secret     string `json:",omitempty"`
+	Round2Packages   []DkgRound2Package
+	ReceivedRound1   []DkgRound1Package
+	ReceivedRound2   []dkgCheckpointRound2Package
+	KeyPackage       string `json:",omitempty"`
+	PublicKeyPackage string `json:",omitempty"`
+	// the shares being refreshed, for sessions created with
+	// NewRefreshSession
+	RefreshKeyPackage       string `json:",omitempty"`
+	RefreshPublicKeyPackage string `json:",omitempty"`
+}
+
+// Checkpoint returns the state of the session encrypted with passphrase,
+// including the secret package of the current round. Store it every time
+// the session receives a package and resume with RestoreDkgSession after
+// a restart.
+func (s *DkgSession) Checkpoint(passphrase []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checkpoint := dkgCheckpoint{
+		Identifier:     s.identifier,
+		Peers:          s.peers,
+		Phase:          s.phase,
+		Round1Package:  s.round1Package,
+		Round2Packages: s.round2Packages,
+	}
+
+	for _, round1Package := range s.receivedRound1 {
+		checkpoint.ReceivedRound1 = append(checkpoint.ReceivedRound1, round1Package)
+	}
+
+	for sender, round2Package := range s.receivedRound2 {
+		checkpoint.ReceivedRound2 = append(checkpoint.ReceivedRound2, dkgCheckpointRound2Package{
+			Sender:  sender,
+			Package: round2Package,
+		})
+	}
+
+	var err error
+	switch s.phase {
+	case DkgPhaseRound1:
+		checkpoint.Round1Secret, err = DkgRound1SecretPackageToJson(s.round1Secret)
+	case DkgPhaseRound2:
+		checkpoint.Round2Secret, err = DkgRound2SecretPackageToJson(s.round2Secret)
+	case DkgPhaseCompleted:
+		checkpoint.KeyPackage, err = KeyPackageToJson(s.result.KeyPackage)
+		if err == nil {
+			checkpoint.PublicKeyPackage, err = PublicKeyPackageToJson(s.result.PublicKeyPackage)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if s.refresh != nil {
+		checkpoint.RefreshKeyPackage, err = KeyPackageToJson(s.refresh.keyPackage)
+		if err != nil {
+			return nil, err
+		}
+		checkpoint.RefreshPublicKeyPackage, err = PublicKeyPackageToJson(s.refresh.publicKeyPackage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plaintext, err := json.Marshal(checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return sealSecret(sealedDkgSessionKind, plaintext, passphrase)
+}
+
+// RestoreDkgSession resumes a session from a checkpoint created with
+// DkgSession.Checkpoint.
+func RestoreDkgSession(checkpoint []byte, passphrase []byte) (*DkgSession, error) {
+	plaintext, err := openSecret(sealedDkgSessionKind, checkpoint, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var state dkgCheckpoint
+	if err := json.Unmarshal(plaintext, &state); err != nil {
+		return nil, err
+	}
+
+	s := &DkgSession{
+		identifier:     state.Identifier,
+		peers:          state.Peers,
+		phase:          state.Phase,
+		round1Package:  state.Round1Package,
+		round2Packages: state.Round2Packages,
+		receivedRound1: make(map[ParticipantIdentifier]DkgRound1Package),
+		receivedRound2: make(map[ParticipantIdentifier]DkgRound2Package),
+	}
+
+	for _, round1Package := range state.ReceivedRound1 {
+		s.receivedRound1[round1Package.Identifier] = round1Package
+	}
+
+	for _, received := range state.ReceivedRound2 {
+		s.receivedRound2[received.Sender] = received.Package
+	}
+
+	switch state.Phase {
+	case DkgPhaseRound1:
+		if state.Round1Secret == "" {
+			return nil, ErrDkgCheckpointInconsistent
+		}
+		s.round1Secret, err = JsonToDkgRound1SecretPackage(state.Round1Secret)
+	case DkgPhaseRound2:
+		if state.Round2Secret == "" {
+			return nil, ErrDkgCheckpointInconsistent
+		}
+		s.round2Secret, err = JsonToDkgRound2SecretPackage(state.Round2Secret)
+	case DkgPhaseCompleted:
+		if state.KeyPackage == "" || state.PublicKeyPackage == "" {
+			return nil, ErrDkgCheckpointInconsistent
+		}
+		var result DkgPart3Result
+		result.KeyPackage, err = JsonToKeyPackage(state.KeyPackage)
+		if err == nil {
+			result.PublicKeyPackage, err = JsonToPublicKeyPackage(state.PublicKeyPackage)
+		}
+		s.result = &result
+	default:
+		return nil, ErrDkgCheckpointInconsistent
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if state.RefreshKeyPackage != "" || state.RefreshPublicKeyPackage != "" {
+		s.refresh = &dkgRefresh{}
+		s.refresh.keyPackage, err = JsonToKeyPackage(state.RefreshKeyPackage)
+		if err != nil {
+			return nil, err
+		}
+		s.refresh.publicKeyPackage, err = JsonToPublicKeyPackage(state.RefreshPublicKeyPackage)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,116 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDkgSessionResumesFromCheckpoint(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+
+	sessions := newDkgSessions(t, 3, 2)
+
+	for _, sender := range sessions {
+		for _, receiver := range sessions {
+			if receiver == sender {
+				continue
+			}
+			if err := receiver.ReceiveRound1Package(sender.Round1Package()); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	checkpoint, err := sessions[0].Checkpoint(passphrase)
+	if err != nil {
+		t.Fatalf("Failed to checkpoint DKG session: %v", err)
+	}
+
+	if _, err := RestoreDkgSession(checkpoint, []byte("wrong passphrase")); !errors.Is(err, ErrSealedSecretWrongPassphrase) {
+		t.Fatalf("Expected wrong passphrase error, got %v", err)
+	}
+
+	// the first participant restarts
+	restored, err := RestoreDkgSession(checkpoint, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to restore DKG session: %v", err)
+	}
+	sessions[0] = restored
+
+	if restored.Phase() != DkgPhaseRound2 {
+		t.Fatalf("Expected phase %s, got %s", DkgPhaseRound2, restored.Phase())
+	}
+
+	byIdentifier := make(map[ParticipantIdentifier]*DkgSession)
+	for _, session := range sessions {
+		byIdentifier[session.Identifier()] = session
+	}
+
+	for _, sender := range sessions {
+		round2Packages, err := sender.Round2Packages()
+		if err != nil {
+			t.Fatalf("Failed to get round 2 packages: %v", err)
+		}
+		for _, round2Package := range round2Packages {
+			receiver := byIdentifier[round2Package.Identifier]
+			if err := receiver.ReceiveRound2Package(sender.Identifier(), round2Package); err != nil {
+				t.Fatalf("Failed to receive round 2 package: %v", err)
+			}
+		}
+	}
+
+	var verifyingKey string
+	for _, session := range sessions {
+		result, err := session.Result()
+		if err != nil {
+			t.Fatalf("Failed to get DKG result: %v", err)
+		}
+
+		if verifyingKey != "" && verifyingKey != result.PublicKeyPackage.VerifyingKey {
+			t.Fatalf("Participants derived different verifying keys")
+		}
+		verifyingKey = result.PublicKeyPackage.VerifyingKey
+	}
+}
+
+func TestExportDkgRound1SecretPackage(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+
+	identifier, err := IdentifierFromUint16(1)
+	if err != nil {
+		t.Fatalf("Failed to create identifier: %v", err)
+	}
+
+	part1, err := Part1(identifier, 3, 2)
+	if err != nil {
+		t.Fatalf("Failed to run DKG part 1: %v", err)
+	}
+
+	exported, err := ExportDkgRound1SecretPackage(part1.Secret(), passphrase)
+	if err != nil {
+		t.Fatalf("Failed to export secret package: %v", err)
+	}
+
+	if _, err := ImportDkgRound2SecretPackage(exported, passphrase); !errors.Is(err, ErrSealedSecretKindMismatch) {
+		t.Fatalf("Expected kind mismatch error, got %v", err)
+	}
+
+	imported, err := ImportDkgRound1SecretPackage(exported, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to import secret package: %v", err)
+	}
+
+	original, err := DkgRound1SecretPackageToJson(part1.Secret())
+	if err != nil {
+		t.Fatalf("Failed to serialize secret package: %v", err)
+	}
+
+	restored, err := DkgRound1SecretPackageToJson(imported)
+	if err != nil {
+		t.Fatalf("Failed to serialize secret package: %v", err)
+	}
+
+	if original != restored {
+		t.Fatalf("Imported secret package differs from the exported one")
+	}
+}
@@ -0,0 +1,387 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// DkgPhase is the stage of the distributed key generation a DkgSession
+// is in.
+type DkgPhase int
+
+const (
+	// DkgPhaseRound1 is the initial phase, where the participant waits for
+	// the round 1 packages broadcast by every other participant.
+	DkgPhaseRound1 DkgPhase = iota
+	// DkgPhaseRound2 starts once all round 1 packages were received. The
+	// participant waits for the round 2 packages addressed to it.
+	DkgPhaseRound2
+	// DkgPhaseCompleted is the final phase, reached when the key package
+	// and the public key package of the group were derived.
+	DkgPhaseCompleted
+)
+
+func (p DkgPhase) String() string {
+	switch p {
+	case DkgPhaseRound1:
+		return "Round1"
+	case DkgPhaseRound2:
+		return "Round2"
+	case DkgPhaseCompleted:
+		return "Completed"
+	default:
+		return fmt.Sprintf("DkgPhase(%d)", int(p))
+	}
+}
+
+// Err* are used for checking DkgSession errors with `errors.Is`
+var ErrDkgParticipantNotInGroup = errors.New("participant is not part of the DKG group")
+var ErrDkgDuplicatedParticipant = errors.New("participant appears more than once in the DKG group")
+var ErrDkgUnknownPeer = errors.New("package sender is not a peer of this DKG session")
+var ErrDkgRepeatedPackage = errors.New("package already received from peer")
+var ErrDkgPackageNotAddressedToParticipant = errors.New("round 2 package is addressed to another participant")
+var ErrDkgInvalidPhase = errors.New("operation not allowed in the current DKG phase")
+
+// DkgPeerError is returned when a package received from a given peer is
+// rejected by the DkgSession.
+type DkgPeerError struct {
+	Identifier ParticipantIdentifier
+	Err        error
+}
+
+func (e *DkgPeerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Identifier.Data)
+}
+
+func (e *DkgPeerError) Unwrap() error {
+	return e.Err
+}
+
+// DkgPhaseError is returned when an operation is attempted on a
+// DkgSession that is not in the phase required to perform it.
+type DkgPhaseError struct {
+	Expected DkgPhase
+	Actual   DkgPhase
+}
+
+func (e *DkgPhaseError) Error() string {
+	return fmt.Sprintf("%s: expected %s, session is in %s", ErrDkgInvalidPhase.Error(), e.Expected, e.Actual)
+}
+
+func (e *DkgPhaseError) Unwrap() error {
+	return ErrDkgInvalidPhase
+}
+
+// DkgSession drives the distributed key generation of a single
+// participant over any transport:
+//
+//  1. broadcast Round1Package() to every peer and feed the peers' round 1
+//     packages to ReceiveRound1Package.
+//  2. once all of them arrived, send each of Round2Packages() privately to
+//     the peer it is addressed to, and feed the round 2 packages addressed
+//     to this participant to ReceiveRound2Package.
+//  3. once all of them arrived, Result() holds the key package of this
+//     participant and the public key package of the group.
+//
+// Rounds advance automatically. Round 2 packages of peers that are ahead
+// are kept until this participant reaches round 2. The secret packages of
+// each round never leave the session.
+//
+// A DkgSession is safe for concurrent use by multiple goroutines.
+type DkgSession struct {
+	mu             sync.Mutex
+	identifier     ParticipantIdentifier
+	peers          []ParticipantIdentifier
+	phase          DkgPhase
+	round1Secret   *DkgRound1SecretPackage
+	round1Package  DkgRound1Package
+	round2Secret   *DkgRound2SecretPackage
+	round2Packages []DkgRound2Package
+	receivedRound1 map[ParticipantIdentifier]DkgRound1Package
+	receivedRound2 map[ParticipantIdentifier]DkgRound2Package
+	result         *DkgPart3Result
+	// set by NewRefreshSession
+	refresh *dkgRefresh
+}
+
+// NewDkgSession starts a DKG for identifier within participants, the
+// full group including identifier, where minSigners of them will be
+// required to sign. It runs Part1 right away.
+func NewDkgSession(identifier ParticipantIdentifier, participants []ParticipantIdentifier, minSigners uint16) (*DkgSession, error) {
+	var peers []ParticipantIdentifier
+	seen := make(map[ParticipantIdentifier]bool, len(participants))
+	for _, participant := range participants {
+		if seen[participant] {
+			return nil, &DkgPeerError{Identifier: participant, Err: ErrDkgDuplicatedParticipant}
+		}
+		seen[participant] = true
+
+		if participant != identifier {
+			peers = append(peers, participant)
+		}
+	}
+
+	if !seen[identifier] {
+		return nil, &DkgPeerError{Identifier: identifier, Err: ErrDkgParticipantNotInGroup}
+	}
+
+	part1, err := Part1(identifier, uint16(len(participants)), minSigners)
+	if err != nil {
+		return nil, err
+	}
+	defer part1.Destroy()
+
+	return &DkgSession{
+		identifier:     identifier,
+		peers:          peers,
+		phase:          DkgPhaseRound1,
+		round1Secret:   part1.Secret(),
+		round1Package:  part1.Package(),
+		receivedRound1: make(map[ParticipantIdentifier]DkgRound1Package),
+		receivedRound2: make(map[ParticipantIdentifier]DkgRound2Package),
+	}, nil
+}
+
+// Identifier of the participant running this session.
+func (s *DkgSession) Identifier() ParticipantIdentifier {
+	return s.identifier
+}
+
+// Phase returns the current phase of the session.
+func (s *DkgSession) Phase() DkgPhase {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.phase
+}
+
+// Round1Package returns the package of this participant that must be
+// broadcast to every peer.
+func (s *DkgSession) Round1Package() DkgRound1Package {
+	return s.round1Package
+}
+
+// ReceiveRound1Package receives the round 1 package broadcast by a peer.
+// When the last missing package arrives the session runs Part2 and moves
+// to DkgPhaseRound2. When Part2 fails the packages it blames, or all of
+// them if it blames none, are dropped so that they can be sent again.
+func (s *DkgSession) ReceiveRound1Package(round1Package DkgRound1Package) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.requirePhase(DkgPhaseRound1); err != nil {
+		return err
+	}
+
+	sender := round1Package.Identifier
+
+	_, repeated := s.receivedRound1[sender]
+	if err := s.validateSender(sender, repeated); err != nil {
+		return err
+	}
+
+	s.receivedRound1[sender] = round1Package
+
+	if len(s.receivedRound1) < len(s.peers) {
+		return nil
+	}
+
+	part2, err := s.runPart2()
+	if err != nil {
+		return rollbackDkgRound(s.receivedRound1, err)
+	}
+	defer part2.Destroy()
+
+	s.round1Secret.Destroy()
+	s.round1Secret = nil
+	s.round2Secret = part2.Secret()
+	s.round2Packages = part2.Packages()
+	s.phase = DkgPhaseRound2
+
+	// peers that were ahead might have sent all their packages already
+	return s.maybeRunPart3()
+}
+
+// Round2Packages returns the packages of this participant for round 2.
+// Each one must be sent privately to the peer named by its Identifier.
+func (s *DkgSession) Round2Packages() ([]DkgRound2Package, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.phase == DkgPhaseRound1 {
+		return nil, &DkgPhaseError{Expected: DkgPhaseRound2, Actual: s.phase}
+	}
+
+	return s.round2Packages, nil
+}
+
+// ReceiveRound2Package receives the round 2 package that sender addressed
+// to this participant. When the last missing package arrives the session
+// runs Part3 and moves to DkgPhaseCompleted. When Part3 fails the packages
+// it blames, or all of them if it blames none, are dropped so that they
+// can be sent again.
+func (s *DkgSession) ReceiveRound2Package(sender ParticipantIdentifier, round2Package DkgRound2Package) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.phase == DkgPhaseCompleted {
+		return &DkgPhaseError{Expected: DkgPhaseRound2, Actual: s.phase}
+	}
+
+	if round2Package.Identifier != s.identifier {
+		return &DkgPeerError{Identifier: sender, Err: ErrDkgPackageNotAddressedToParticipant}
+	}
+
+	_, repeated := s.receivedRound2[sender]
+	if err := s.validateSender(sender, repeated); err != nil {
+		return err
+	}
+
+	s.receivedRound2[sender] = round2Package
+
+	if s.phase != DkgPhaseRound2 {
+		return nil
+	}
+
+	return s.maybeRunPart3()
+}
+
+// MissingPeers returns the peers whose package for the current phase has
+// not been received yet.
+func (s *DkgSession) MissingPeers() []ParticipantIdentifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var received map[ParticipantIdentifier]bool
+	switch s.phase {
+	case DkgPhaseRound1:
+		received = make(map[ParticipantIdentifier]bool, len(s.receivedRound1))
+		for identifier := range s.receivedRound1 {
+			received[identifier] = true
+		}
+	case DkgPhaseRound2:
+		received = make(map[ParticipantIdentifier]bool, len(s.receivedRound2))
+		for identifier := range s.receivedRound2 {
+			received[identifier] = true
+		}
+	default:
+		return nil
+	}
+
+	var missing []ParticipantIdentifier
+	for _, peer := range s.peers {
+		if !received[peer] {
+			missing = append(missing, peer)
+		}
+	}
+
+	return missing
+}
+
+// Result returns the key package of this participant and the public key
+// package of the group once the session is completed.
+func (s *DkgSession) Result() (DkgPart3Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.requirePhase(DkgPhaseCompleted); err != nil {
+		return DkgPart3Result{}, err
+	}
+
+	return *s.result, nil
+}
+
+func (s *DkgSession) maybeRunPart3() error {
+	if len(s.receivedRound2) < len(s.peers) {
+		return nil
+	}
+
+	result, err := s.runPart3()
+	if err != nil {
+		return rollbackDkgRound(s.receivedRound2, err)
+	}
+
+	s.round2Secret.Destroy()
+	s.round2Secret = nil
+	s.result = &result
+	s.phase = DkgPhaseCompleted
+
+	return nil
+}
+
+// rollbackDkgRound drops the received packages of a round whose Part run
+// failed with err, so that they can be received again. When err blames a
+// peer only its package is dropped and a *DkgPeerError naming it is
+// returned. Otherwise the faulty package can't be told apart and every
+// package of the round is dropped.
+func rollbackDkgRound[P any](received map[ParticipantIdentifier]P, err error) error {
+	if culprit, ok := dkgCulprit(err); ok {
+		delete(received, culprit)
+		return &DkgPeerError{Identifier: culprit, Err: err}
+	}
+
+	for sender := range received {
+		delete(received, sender)
+	}
+
+	return err
+}
+
+// dkgCulprit returns the peer blamed by err, returned by Part2 or Part3,
+// for sending an invalid package.
+func dkgCulprit(err error) (ParticipantIdentifier, bool) {
+	var proofError *FrostErrorInvalidProofOfKnowledge
+	if errors.As(err, &proofError) {
+		return proofError.Culprit, true
+	}
+
+	var shareError *FrostErrorInvalidSecretShare
+	if errors.As(err, &shareError) && shareError.Culprit != nil {
+		return *shareError.Culprit, true
+	}
+
+	return ParticipantIdentifier{}, false
+}
+
+func (s *DkgSession) runPart2() (*DkgPart2Result, error) {
+	if s.refresh != nil {
+		return RefreshPart2(s.round1Secret, s.receivedRound1)
+	}
+	return Part2(s.round1Secret, s.receivedRound1)
+}
+
+func (s *DkgSession) runPart3() (DkgPart3Result, error) {
+	if s.refresh != nil {
+		return s.refresh.part3(s.round2Secret, s.receivedRound1, s.receivedRound2)
+	}
+	return Part3(s.round2Secret, s.receivedRound1, s.receivedRound2)
+}
+
+func (s *DkgSession) validateSender(sender ParticipantIdentifier, repeated bool) error {
+	isPeer := false
+	for _, peer := range s.peers {
+		if peer == sender {
+			isPeer = true
+			break
+		}
+	}
+
+	if !isPeer {
+		return &DkgPeerError{Identifier: sender, Err: ErrDkgUnknownPeer}
+	}
+
+	if repeated {
+		return &DkgPeerError{Identifier: sender, Err: ErrDkgRepeatedPackage}
+	}
+
+	return nil
+}
+
+func (s *DkgSession) requirePhase(phase DkgPhase) error {
+	if s.phase != phase {
+		return &DkgPhaseError{Expected: phase, Actual: s.phase}
+	}
+
+	return nil
+}
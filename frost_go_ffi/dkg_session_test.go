@@ -0,0 +1,233 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func newDkgSessions(t *testing.T, maxSigners uint16, minSigners uint16) []*DkgSession {
+	var participants []ParticipantIdentifier
+	for i := uint16(1); i <= maxSigners; i++ {
+		identifier, err := IdentifierFromUint16(i)
+		if err != nil {
+			t.Fatalf("Failed to create identifier: %v", err)
+		}
+		participants = append(participants, identifier)
+	}
+
+	var sessions []*DkgSession
+	for _, identifier := range participants {
+		session, err := NewDkgSession(identifier, participants, minSigners)
+		if err != nil {
+			t.Fatalf("Failed to create DKG session: %v", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions
+}
+
+func TestDkgSessionDerivesKeysUsableForSigning(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	sessions := newDkgSessions(t, config.MaxSigners, config.MinSigners)
+
+	for _, sender := range sessions {
+		for _, receiver := range sessions {
+			if receiver == sender {
+				continue
+			}
+			if err := receiver.ReceiveRound1Package(sender.Round1Package()); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	for _, session := range sessions {
+		if session.Phase() != DkgPhaseRound2 {
+			t.Fatalf("Expected phase %s, got %s", DkgPhaseRound2, session.Phase())
+		}
+	}
+
+	byIdentifier := make(map[ParticipantIdentifier]*DkgSession)
+	for _, session := range sessions {
+		byIdentifier[session.Identifier()] = session
+	}
+
+	for _, sender := range sessions {
+		round2Packages, err := sender.Round2Packages()
+		if err != nil {
+			t.Fatalf("Failed to get round 2 packages: %v", err)
+		}
+		for _, round2Package := range round2Packages {
+			receiver := byIdentifier[round2Package.Identifier]
+			if err := receiver.ReceiveRound2Package(sender.Identifier(), round2Package); err != nil {
+				t.Fatalf("Failed to receive round 2 package: %v", err)
+			}
+		}
+	}
+
+	var publicKey FrostPublicKeyPackage
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for _, session := range sessions {
+		if len(session.MissingPeers()) != 0 {
+			t.Fatalf("Expected no missing peers, got %v", session.MissingPeers())
+		}
+
+		result, err := session.Result()
+		if err != nil {
+			t.Fatalf("Failed to get DKG result: %v", err)
+		}
+
+		if publicKey.VerifyingKey != "" && publicKey.VerifyingKey != result.PublicKeyPackage.VerifyingKey {
+			t.Fatalf("Participants derived different verifying keys")
+		}
+
+		publicKey = result.PublicKeyPackage
+		keyPackages[session.Identifier()] = result.KeyPackage
+	}
+
+	coordinator, err := NewCoordinator(config, publicKey, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		participant := NewSigningParticipant(keyPackage)
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+func TestDkgSessionRejectsUnexpectedPackages(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+	alice, bob, carol := sessions[0], sessions[1], sessions[2]
+
+	if err := alice.ReceiveRound1Package(alice.Round1Package()); !errors.Is(err, ErrDkgUnknownPeer) {
+		t.Fatalf("Expected unknown peer error, got %v", err)
+	}
+
+	if err := alice.ReceiveRound1Package(bob.Round1Package()); err != nil {
+		t.Fatalf("Failed to receive round 1 package: %v", err)
+	}
+
+	if err := alice.ReceiveRound1Package(bob.Round1Package()); !errors.Is(err, ErrDkgRepeatedPackage) {
+		t.Fatalf("Expected repeated package error, got %v", err)
+	}
+
+	missing := alice.MissingPeers()
+	if len(missing) != 1 || missing[0] != carol.Identifier() {
+		t.Fatalf("Expected %s to be missing, got %v", carol.Identifier().Data, missing)
+	}
+
+	if _, err := alice.Result(); !errors.Is(err, ErrDkgInvalidPhase) {
+		t.Fatalf("Expected invalid phase error, got %v", err)
+	}
+
+	for _, sender := range sessions {
+		for _, receiver := range []*DkgSession{bob, carol} {
+			if receiver == sender {
+				continue
+			}
+			if err := receiver.ReceiveRound1Package(sender.Round1Package()); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	round2Packages, err := bob.Round2Packages()
+	if err != nil {
+		t.Fatalf("Failed to get round 2 packages: %v", err)
+	}
+
+	for _, round2Package := range round2Packages {
+		if round2Package.Identifier == carol.Identifier() {
+			err := alice.ReceiveRound2Package(bob.Identifier(), round2Package)
+			if !errors.Is(err, ErrDkgPackageNotAddressedToParticipant) {
+				t.Fatalf("Expected package not addressed to participant error, got %v", err)
+			}
+		}
+
+		if round2Package.Identifier == alice.Identifier() {
+			// alice is still in round 1, the package is kept for later
+			if err := alice.ReceiveRound2Package(bob.Identifier(), round2Package); err != nil {
+				t.Fatalf("Failed to receive round 2 package: %v", err)
+			}
+		}
+	}
+
+	if alice.Phase() != DkgPhaseRound1 {
+		t.Fatalf("Expected phase %s, got %s", DkgPhaseRound1, alice.Phase())
+	}
+}
+
+func TestDkgSessionDropsPackageBlamedByFailedPart(t *testing.T) {
+	sessions := newDkgSessions(t, 3, 2)
+	alice, bob, carol := sessions[0], sessions[1], sessions[2]
+
+	if err := alice.ReceiveRound1Package(carol.Round1Package()); err != nil {
+		t.Fatalf("Failed to receive round 1 package: %v", err)
+	}
+
+	// the proof of knowledge of carol doesn't hold for bob
+	forged := DkgRound1Package{Identifier: bob.Identifier(), Data: carol.Round1Package().Data}
+
+	err := alice.ReceiveRound1Package(forged)
+	var peerError *DkgPeerError
+	if !errors.As(err, &peerError) || peerError.Identifier != bob.Identifier() {
+		t.Fatalf("Expected DkgPeerError for %s, got %v", bob.Identifier().Data, err)
+	}
+
+	if alice.Phase() != DkgPhaseRound1 {
+		t.Fatalf("Expected phase %s, got %s", DkgPhaseRound1, alice.Phase())
+	}
+
+	missing := alice.MissingPeers()
+	if len(missing) != 1 || missing[0] != bob.Identifier() {
+		t.Fatalf("Expected %s to be missing, got %v", bob.Identifier().Data, missing)
+	}
+
+	if err := alice.ReceiveRound1Package(bob.Round1Package()); err != nil {
+		t.Fatalf("Failed to receive round 1 package: %v", err)
+	}
+
+	if alice.Phase() != DkgPhaseRound2 {
+		t.Fatalf("Expected phase %s, got %s", DkgPhaseRound2, alice.Phase())
+	}
+}
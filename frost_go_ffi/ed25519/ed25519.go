@@ -0,0 +1,105 @@
+// Package ed25519 is the Ed25519 FROST API of frost_uniffi_sdk under the
+// names of its default API, so that a program can sign Ed25519 messages
+// with this package and Zcash Orchard spends with frost_uniffi_sdk side by
+// side.
+//
+// The types mirror the ones of frost_uniffi_sdk but hold Ed25519 values.
+// They are distinct types, so the compiler rejects them in the RedPallas
+// functions of frost_uniffi_sdk, and the other way around.
+package ed25519
+
+import frost "frost_go_ffi/frost_go_ffi"
+
+// TrustedDealerKeygenFrom deals the shares of a new Ed25519 group, or of
+// the group of Configuration.Secret when it is not empty.
+func TrustedDealerKeygenFrom(configuration Configuration) (TrustedKeyGeneration, error) {
+	keygen, err := frost.Ed25519TrustedDealerKeygenFrom(configuration)
+	if err != nil {
+		return TrustedKeyGeneration{}, err
+	}
+
+	secretShares := make(map[ParticipantIdentifier]FrostSecretKeyShare, len(keygen.SecretShares))
+	for identifier, secretShare := range keygen.SecretShares {
+		secretShares[fromParticipantIdentifier(identifier)] = fromSecretKeyShare(secretShare)
+	}
+
+	return TrustedKeyGeneration{
+		SecretShares:     secretShares,
+		PublicKeyPackage: fromPublicKeyPackage(keygen.PublicKeyPackage),
+	}, nil
+}
+
+// VerifyAndGetKeyPackageFrom verifies a secret share dealt by
+// TrustedDealerKeygenFrom and returns the key package of its participant.
+func VerifyAndGetKeyPackageFrom(secretShare FrostSecretKeyShare) (FrostKeyPackage, error) {
+	keyPackage, err := frost.Ed25519VerifyAndGetKeyPackageFrom(secretShare.frost())
+	if err != nil {
+		return FrostKeyPackage{}, err
+	}
+	return fromKeyPackage(keyPackage), nil
+}
+
+// GenerateNoncesAndCommitments runs the first round of signing for the
+// participant of keyPackage.
+func GenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
+	commitment, err := frost.Ed25519GenerateNoncesAndCommitments(keyPackage.frost())
+	if err != nil {
+		return FirstRoundCommitment{}, err
+	}
+
+	return FirstRoundCommitment{
+		Nonces:      FrostSigningNonces(commitment.Nonces),
+		Commitments: fromSigningCommitments(commitment.Commitments),
+	}, nil
+}
+
+// NewSigningPackage creates the signing package of message out of the
+// commitments of the signers.
+func NewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
+	frostCommitments := make([]frost.FrostSigningCommitments, 0, len(commitments))
+	for _, commitment := range commitments {
+		frostCommitments = append(frostCommitments, commitment.frost())
+	}
+
+	signingPackage, err := frost.Ed25519NewSigningPackage(message, frostCommitments)
+	if err != nil {
+		return FrostSigningPackage{}, err
+	}
+	return FrostSigningPackage(signingPackage), nil
+}
+
+// Sign runs the second round of signing for the participant of keyPackage.
+func Sign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage) (FrostSignatureShare, error) {
+	signatureShare, err := frost.Ed25519Sign(frost.FrostSigningPackage(signingPackage), frost.FrostSigningNonces(nonces), keyPackage.frost())
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	return fromSignatureShare(signatureShare), nil
+}
+
+// Aggregate aggregates the signature shares of the signers into the
+// signature of the group.
+func Aggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) (FrostSignature, error) {
+	frostSignatureShares := make([]frost.FrostSignatureShare, 0, len(signatureShares))
+	for _, signatureShare := range signatureShares {
+		frostSignatureShares = append(frostSignatureShares, signatureShare.frost())
+	}
+
+	signature, err := frost.Ed25519Aggregate(frost.FrostSigningPackage(signingPackage), frostSignatureShares, pubkeyPackage.frost())
+	if err != nil {
+		return FrostSignature{}, err
+	}
+	return FrostSignature(signature), nil
+}
+
+// VerifySignatureShare verifies a single signature share against the
+// verifying share of its signer.
+func VerifySignatureShare(signingPackage FrostSigningPackage, signatureShare FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) error {
+	return frost.Ed25519VerifySignatureShare(frost.FrostSigningPackage(signingPackage), signatureShare.frost(), pubkeyPackage.frost())
+}
+
+// VerifySignature verifies the signature of message by the group of
+// pubkey.
+func VerifySignature(message Message, signature FrostSignature, pubkey FrostPublicKeyPackage) error {
+	return frost.Ed25519VerifySignature(message, frost.FrostSignature(signature), pubkey.frost())
+}
@@ -0,0 +1,132 @@
+package ed25519
+
+import (
+	"errors"
+	"testing"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+func TestSignsSideBySideWithRedPallas(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	keygen, err := TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	nonces := make(map[ParticipantIdentifier]FrostSigningNonces)
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	var commitments []FrostSigningCommitments
+	for identifier, secretShare := range keygen.SecretShares {
+		keyPackage, err := VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+		keyPackages[identifier] = keyPackage
+
+		commitment, err := GenerateNoncesAndCommitments(keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to generate nonces and commitments: %v", err)
+		}
+		nonces[identifier] = commitment.Nonces
+		commitments = append(commitments, commitment.Commitments)
+	}
+
+	signingPackage, err := NewSigningPackage(message, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	var signatureShares []FrostSignatureShare
+	for identifier, keyPackage := range keyPackages {
+		signatureShare, err := Sign(signingPackage, nonces[identifier], keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := VerifySignatureShare(signingPackage, signatureShare, keygen.PublicKeyPackage); err != nil {
+			t.Fatalf("Failed to verify signature share: %v", err)
+		}
+
+		signatureShares = append(signatureShares, signatureShare)
+	}
+
+	signature, err := Aggregate(signingPackage, signatureShares, keygen.PublicKeyPackage)
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := VerifySignature(message, signature, keygen.PublicKeyPackage); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+
+	// a RedPallas group signs in the same binary, with key packages that
+	// don't mix with the Ed25519 ones
+	redPallas, err := frost.TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	coordinator, err := frost.NewCoordinator(config, redPallas.PublicKeyPackage, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*frost.SigningParticipant
+	for _, secretShare := range redPallas.SecretShares {
+		// RedPallas values must be converted explicitly to be mixed up
+		misused := FrostSecretKeyShare{Identifier: ParticipantIdentifier(secretShare.Identifier), Data: secretShare.Data}
+		if _, err := VerifyAndGetKeyPackageFrom(misused); !errors.Is(err, frost.ErrFrostErrorInvalidSecretKey) {
+			t.Fatalf("Expected ErrFrostErrorInvalidSecretKey, got %v", err)
+		}
+
+		keyPackage, err := frost.VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+
+		participant := frost.NewSigningParticipant(keyPackage)
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	randomizedSignature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := coordinator.Verify(randomizedSignature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+
+	if err := VerifySignature(message, FrostSignature(randomizedSignature), keygen.PublicKeyPackage); err == nil {
+		t.Fatalf("RedPallas signature verified against the Ed25519 group")
+	}
+}
@@ -0,0 +1,123 @@
+package ed25519
+
+import frost "frost_go_ffi/frost_go_ffi"
+
+// Configuration and Message hold no Ed25519 values, they are the ones of
+// frost_uniffi_sdk.
+type (
+	Configuration = frost.Configuration
+	Message       = frost.Message
+)
+
+// ParticipantIdentifier identifies a participant of an Ed25519 group.
+type ParticipantIdentifier frost.ParticipantIdentifier
+
+// TrustedKeyGeneration holds the shares dealt by TrustedDealerKeygenFrom.
+type TrustedKeyGeneration struct {
+	SecretShares     map[ParticipantIdentifier]FrostSecretKeyShare
+	PublicKeyPackage FrostPublicKeyPackage
+}
+
+// FrostSecretKeyShare is the share dealt to a participant.
+type FrostSecretKeyShare struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostKeyPackage is the key package of a participant.
+type FrostKeyPackage struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostPublicKeyPackage holds the verifying key of a group and the
+// verifying share of each participant, hex encoded.
+type FrostPublicKeyPackage struct {
+	VerifyingShares map[ParticipantIdentifier]string
+	VerifyingKey    string
+}
+
+// FirstRoundCommitment holds the nonces of the first round of signing,
+// which must be kept secret, and the commitments to send to the
+// coordinator.
+type FirstRoundCommitment struct {
+	Nonces      FrostSigningNonces
+	Commitments FrostSigningCommitments
+}
+
+// FrostSigningNonces are the secret nonces of a signer.
+type FrostSigningNonces frost.FrostSigningNonces
+
+// FrostSigningCommitments are the commitments of a signer.
+type FrostSigningCommitments struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostSigningPackage is the signing package created by the coordinator.
+type FrostSigningPackage frost.FrostSigningPackage
+
+// FrostSignatureShare is the signature share of a signer.
+type FrostSignatureShare struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostSignature is the signature of a group.
+type FrostSignature frost.FrostSignature
+
+func fromParticipantIdentifier(identifier frost.ParticipantIdentifier) ParticipantIdentifier {
+	return ParticipantIdentifier(identifier)
+}
+
+func (i ParticipantIdentifier) frost() frost.ParticipantIdentifier {
+	return frost.ParticipantIdentifier(i)
+}
+
+func fromSecretKeyShare(share frost.FrostSecretKeyShare) FrostSecretKeyShare {
+	return FrostSecretKeyShare{Identifier: fromParticipantIdentifier(share.Identifier), Data: share.Data}
+}
+
+func (s FrostSecretKeyShare) frost() frost.FrostSecretKeyShare {
+	return frost.FrostSecretKeyShare{Identifier: s.Identifier.frost(), Data: s.Data}
+}
+
+func fromKeyPackage(keyPackage frost.FrostKeyPackage) FrostKeyPackage {
+	return FrostKeyPackage{Identifier: fromParticipantIdentifier(keyPackage.Identifier), Data: keyPackage.Data}
+}
+
+func (k FrostKeyPackage) frost() frost.FrostKeyPackage {
+	return frost.FrostKeyPackage{Identifier: k.Identifier.frost(), Data: k.Data}
+}
+
+func fromPublicKeyPackage(publicKeyPackage frost.FrostPublicKeyPackage) FrostPublicKeyPackage {
+	verifyingShares := make(map[ParticipantIdentifier]string, len(publicKeyPackage.VerifyingShares))
+	for identifier, verifyingShare := range publicKeyPackage.VerifyingShares {
+		verifyingShares[fromParticipantIdentifier(identifier)] = verifyingShare
+	}
+	return FrostPublicKeyPackage{VerifyingShares: verifyingShares, VerifyingKey: publicKeyPackage.VerifyingKey}
+}
+
+func (p FrostPublicKeyPackage) frost() frost.FrostPublicKeyPackage {
+	verifyingShares := make(map[frost.ParticipantIdentifier]string, len(p.VerifyingShares))
+	for identifier, verifyingShare := range p.VerifyingShares {
+		verifyingShares[identifier.frost()] = verifyingShare
+	}
+	return frost.FrostPublicKeyPackage{VerifyingShares: verifyingShares, VerifyingKey: p.VerifyingKey}
+}
+
+func fromSigningCommitments(commitments frost.FrostSigningCommitments) FrostSigningCommitments {
+	return FrostSigningCommitments{Identifier: fromParticipantIdentifier(commitments.Identifier), Data: commitments.Data}
+}
+
+func (c FrostSigningCommitments) frost() frost.FrostSigningCommitments {
+	return frost.FrostSigningCommitments{Identifier: c.Identifier.frost(), Data: c.Data}
+}
+
+func fromSignatureShare(signatureShare frost.FrostSignatureShare) FrostSignatureShare {
+	return FrostSignatureShare{Identifier: fromParticipantIdentifier(signatureShare.Identifier), Data: signatureShare.Data}
+}
+
+func (s FrostSignatureShare) frost() frost.FrostSignatureShare {
+	return frost.FrostSignatureShare{Identifier: s.Identifier.frost(), Data: s.Data}
+}
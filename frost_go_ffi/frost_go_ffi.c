@@ -1,8 +0,0 @@
-#include <frost_go_ffi.h>
-
-// This file exists beacause of
-// https://github.com/golang/go/issues/11263
-
-void cgo_rust_task_callback_bridge_frost_go_ffi(RustTaskCallback cb, const void * taskData, int8_t status) {
-  cb(taskData, status);
-}
\ No newline at end of file
@@ -388,6 +388,16 @@ void* uniffi_frost_uniffi_sdk_fn_clone_dkgpart1result(void* ptr, RustCallStatus
 void uniffi_frost_uniffi_sdk_fn_free_dkgpart1result(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART1RESULT_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART1RESULT_PACKAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_method_dkgpart1result_package(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART1RESULT_SECRET
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART1RESULT_SECRET
+void* uniffi_frost_uniffi_sdk_fn_method_dkgpart1result_secret(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_DKGPART2RESULT
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_DKGPART2RESULT
 void* uniffi_frost_uniffi_sdk_fn_clone_dkgpart2result(void* ptr, RustCallStatus *out_status
@@ -398,6 +408,16 @@ void* uniffi_frost_uniffi_sdk_fn_clone_dkgpart2result(void* ptr, RustCallStatus
 void uniffi_frost_uniffi_sdk_fn_free_dkgpart2result(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART2RESULT_PACKAGES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART2RESULT_PACKAGES
+RustBuffer uniffi_frost_uniffi_sdk_fn_method_dkgpart2result_packages(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART2RESULT_SECRET
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_DKGPART2RESULT_SECRET
+void* uniffi_frost_uniffi_sdk_fn_method_dkgpart2result_secret(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_DKGROUND1SECRETPACKAGE
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_DKGROUND1SECRETPACKAGE
 void* uniffi_frost_uniffi_sdk_fn_clone_dkground1secretpackage(void* ptr, RustCallStatus *out_status
@@ -428,6 +448,21 @@ void* uniffi_frost_uniffi_sdk_fn_clone_frostrandomizedparams(void* ptr, RustCall
 void uniffi_frost_uniffi_sdk_fn_free_frostrandomizedparams(void* ptr, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY
+RustBuffer uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomized_verifying_key(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY_HEX
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY_HEX
+RustBuffer uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomized_verifying_key_hex(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZER
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZER
+RustBuffer uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomizer(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_ORCHARDADDRESS
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_ORCHARDADDRESS
 void* uniffi_frost_uniffi_sdk_fn_clone_orchardaddress(void* ptr, RustCallStatus *out_status
@@ -533,6 +568,16 @@ void uniffi_frost_uniffi_sdk_fn_free_orchardkeyparts(void* ptr, RustCallStatus *
 void* uniffi_frost_uniffi_sdk_fn_constructor_orchardkeyparts_random(RustBuffer network, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_ORCHARDKEYPARTS_NK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_ORCHARDKEYPARTS_NK
+void* uniffi_frost_uniffi_sdk_fn_method_orchardkeyparts_nk(void* ptr, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_ORCHARDKEYPARTS_RIVK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_METHOD_ORCHARDKEYPARTS_RIVK
+void* uniffi_frost_uniffi_sdk_fn_method_orchardkeyparts_rivk(void* ptr, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_ORCHARDNULLIFIERDERIVINGKEY
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_CLONE_ORCHARDNULLIFIERDERIVINGKEY
 void* uniffi_frost_uniffi_sdk_fn_clone_orchardnullifierderivingkey(void* ptr, RustCallStatus *out_status
@@ -583,6 +628,61 @@ RustBuffer uniffi_frost_uniffi_sdk_fn_func_aggregate(RustBuffer signing_package,
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_commitment_to_json(RustBuffer commitment, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_COMPUTE_REFRESHING_SHARES_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_COMPUTE_REFRESHING_SHARES_FROM
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_compute_refreshing_shares_from(RustBuffer public_key_package, uint16_t min_signers, RustBuffer participants, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_DKG_ROUND1_SECRET_PACKAGE_TO_JSON
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_DKG_ROUND1_SECRET_PACKAGE_TO_JSON
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_dkg_round1_secret_package_to_json(void* secret_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_DKG_ROUND2_SECRET_PACKAGE_TO_JSON
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_DKG_ROUND2_SECRET_PACKAGE_TO_JSON
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_dkg_round2_secret_package_to_json(void* secret_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_AGGREGATE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_AGGREGATE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_aggregate(RustBuffer signing_package, RustBuffer signature_shares, RustBuffer pubkey_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_GENERATE_NONCES_AND_COMMITMENTS
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_GENERATE_NONCES_AND_COMMITMENTS
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_generate_nonces_and_commitments(RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_NEW_SIGNING_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_NEW_SIGNING_PACKAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_new_signing_package(RustBuffer message, RustBuffer commitments, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_SIGN
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_SIGN
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_sign(RustBuffer signing_package, RustBuffer nonces, RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_TRUSTED_DEALER_KEYGEN_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_TRUSTED_DEALER_KEYGEN_FROM
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_trusted_dealer_keygen_from(RustBuffer configuration, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_AND_GET_KEY_PACKAGE_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_AND_GET_KEY_PACKAGE_FROM
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_and_get_key_package_from(RustBuffer secret_share, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_SIGNATURE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_SIGNATURE
+void uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_signature(RustBuffer message, RustBuffer signature, RustBuffer pubkey, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ED25519_VERIFY_SIGNATURE_SHARE
+void uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_signature_share(RustBuffer signing_package, RustBuffer signature_share, RustBuffer pubkey_package, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_FROM_HEX_STRING
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_FROM_HEX_STRING
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_from_hex_string(RustBuffer hex_string, RustCallStatus *out_status
@@ -613,6 +713,16 @@ RustBuffer uniffi_frost_uniffi_sdk_fn_func_identifier_from_uint16(uint16_t unsig
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_json_to_commitment(RustBuffer commitment_json, RustBuffer identifier, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_DKG_ROUND1_SECRET_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_DKG_ROUND1_SECRET_PACKAGE
+void* uniffi_frost_uniffi_sdk_fn_func_json_to_dkg_round1_secret_package(RustBuffer secret_package_json, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_DKG_ROUND2_SECRET_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_DKG_ROUND2_SECRET_PACKAGE
+void* uniffi_frost_uniffi_sdk_fn_func_json_to_dkg_round2_secret_package(RustBuffer secret_package_json, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_KEY_PACKAGE
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_JSON_TO_KEY_PACKAGE
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_json_to_key_package(RustBuffer key_package_json, RustCallStatus *out_status
@@ -643,6 +753,11 @@ RustBuffer uniffi_frost_uniffi_sdk_fn_func_key_package_to_json(RustBuffer key_pa
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_new_signing_package(RustBuffer message, RustBuffer commitments, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ORCHARD_SPEND_AUTH_FROM_PCZT
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_ORCHARD_SPEND_AUTH_FROM_PCZT
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_orchard_spend_auth_from_pczt(RustBuffer pczt, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PART_1
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PART_1
 void* uniffi_frost_uniffi_sdk_fn_func_part_1(RustBuffer participant_identifier, uint16_t max_signers, uint16_t min_signers, RustCallStatus *out_status
@@ -658,16 +773,31 @@ void* uniffi_frost_uniffi_sdk_fn_func_part_2(void* secret_package, RustBuffer ro
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_part_3(void* secret_package, RustBuffer round1_packages, RustBuffer round2_packages, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PCZT_WITH_ORCHARD_SPEND_AUTH_SIGNATURES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PCZT_WITH_ORCHARD_SPEND_AUTH_SIGNATURES
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_pczt_with_orchard_spend_auth_signatures(RustBuffer pczt, RustBuffer actions, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PUBLIC_KEY_PACKAGE_TO_JSON
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_PUBLIC_KEY_PACKAGE_TO_JSON
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_public_key_package_to_json(RustBuffer public_key_package, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_RANDOMIZER
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_RANDOMIZER
+void* uniffi_frost_uniffi_sdk_fn_func_randomized_params_from_public_key_and_randomizer(RustBuffer public_key, RustBuffer randomizer, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_SIGNING_PACKAGE
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_SIGNING_PACKAGE
 void* uniffi_frost_uniffi_sdk_fn_func_randomized_params_from_public_key_and_signing_package(RustBuffer public_key, RustBuffer signing_package, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZER_FROM_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZER_FROM_BYTES
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_randomizer_from_bytes(RustBuffer bytes, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZER_FROM_PARAMS
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RANDOMIZER_FROM_PARAMS
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_randomizer_from_params(void* randomized_params, RustCallStatus *out_status
@@ -678,16 +808,151 @@ RustBuffer uniffi_frost_uniffi_sdk_fn_func_randomizer_from_params(void* randomiz
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_randomizer_to_json(RustBuffer randomizer, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RECONSTRUCT_SIGNING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RECONSTRUCT_SIGNING_KEY
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_reconstruct_signing_key(RustBuffer key_packages, RustBuffer public_key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_KEY_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_KEY_PACKAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_refresh_key_package(RustBuffer refreshing_share, RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_1
+void* uniffi_frost_uniffi_sdk_fn_func_refresh_part_1(RustBuffer key_package, uint16_t max_signers, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_2
+void* uniffi_frost_uniffi_sdk_fn_func_refresh_part_2(void* secret_package, RustBuffer round1_packages, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REFRESH_PART_3
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_refresh_part_3(void* secret_package, RustBuffer round1_packages, RustBuffer round2_packages, RustBuffer public_key_package, RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_1
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_repair_share_step_1(RustBuffer helpers, RustBuffer key_package, RustBuffer participant, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_2
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_repair_share_step_2(RustBuffer deltas, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_REPAIR_SHARE_STEP_3
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_repair_share_step_3(RustBuffer sigmas, RustBuffer participant, RustBuffer public_key_package, uint16_t min_signers, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RESHARE_STEP_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RESHARE_STEP_1
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_reshare_step_1(RustBuffer key_package, RustBuffer old_signers, RustBuffer configuration, RustBuffer new_participants, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RESHARE_STEP_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_RESHARE_STEP_2
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_reshare_step_2(RustBuffer shares, RustBuffer public_key_package, RustBuffer configuration, RustBuffer new_participants, RustBuffer participant, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_AGGREGATE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_AGGREGATE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_aggregate(RustBuffer signing_package, RustBuffer signature_shares, RustBuffer pubkey_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_AGGREGATE_WITH_TWEAK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_AGGREGATE_WITH_TWEAK
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_aggregate_with_tweak(RustBuffer signing_package, RustBuffer signature_shares, RustBuffer pubkey_package, RustBuffer merkle_root, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_GENERATE_NONCES_AND_COMMITMENTS
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_GENERATE_NONCES_AND_COMMITMENTS
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_generate_nonces_and_commitments(RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_IDENTIFIER_FROM_UINT16
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_IDENTIFIER_FROM_UINT16
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_identifier_from_uint16(uint16_t unsigned_uint, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_NEW_SIGNING_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_NEW_SIGNING_PACKAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_new_signing_package(RustBuffer message, RustBuffer commitments, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_1
+void* uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_1(RustBuffer participant_identifier, uint16_t max_signers, uint16_t min_signers, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_2
+void* uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_2(void* secret_package, RustBuffer round1_packages, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_PART_3
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_3(void* secret_package, RustBuffer round1_packages, RustBuffer round2_packages, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_SIGN
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_SIGN
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_sign(RustBuffer signing_package, RustBuffer nonces, RustBuffer key_package, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_SIGN_WITH_TWEAK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_SIGN_WITH_TWEAK
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_sign_with_tweak(RustBuffer signing_package, RustBuffer nonces, RustBuffer key_package, RustBuffer merkle_root, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_TRUSTED_DEALER_KEYGEN_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_TRUSTED_DEALER_KEYGEN_FROM
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_trusted_dealer_keygen_from(RustBuffer configuration, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_TWEAK_PUBLIC_KEY_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_TWEAK_PUBLIC_KEY_PACKAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_tweak_public_key_package(RustBuffer pubkey_package, RustBuffer merkle_root, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_AND_GET_KEY_PACKAGE_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_AND_GET_KEY_PACKAGE_FROM
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_and_get_key_package_from(RustBuffer secret_share, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_SIGNATURE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_SIGNATURE
+void uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_signature(RustBuffer message, RustBuffer signature, RustBuffer pubkey, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SECP256K1_TR_VERIFY_SIGNATURE_SHARE
+void uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_signature_share(RustBuffer signing_package, RustBuffer signature_share, RustBuffer pubkey_package, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGN
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGN
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_sign(RustBuffer signing_package, RustBuffer nonces, RustBuffer key_package, RustBuffer randomizer, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGN_WITH_SIGNING_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGN_WITH_SIGNING_SHARE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_sign_with_signing_share(RustBuffer key_package, RustBuffer message, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGNATURE_SHARE_PACKAGE_TO_JSON
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGNATURE_SHARE_PACKAGE_TO_JSON
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_signature_share_package_to_json(RustBuffer signature_share, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGNING_PACKAGE_MESSAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_SIGNING_PACKAGE_MESSAGE
+RustBuffer uniffi_frost_uniffi_sdk_fn_func_signing_package_message(RustBuffer signing_package, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_TRUSTED_DEALER_KEYGEN_FROM
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_TRUSTED_DEALER_KEYGEN_FROM
 RustBuffer uniffi_frost_uniffi_sdk_fn_func_trusted_dealer_keygen_from(RustBuffer configuration, RustCallStatus *out_status
@@ -718,6 +983,21 @@ void uniffi_frost_uniffi_sdk_fn_func_verify_randomized_signature(RustBuffer rand
 void uniffi_frost_uniffi_sdk_fn_func_verify_signature(RustBuffer message, RustBuffer signature, RustBuffer pubkey, RustCallStatus *out_status
 );
 #endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_SHARE
+void uniffi_frost_uniffi_sdk_fn_func_verify_signature_share(RustBuffer signing_package, RustBuffer signature_share, RustBuffer pubkey_package, RustBuffer randomizer, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_WITH_RANDOMIZED_VERIFYING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_WITH_RANDOMIZED_VERIFYING_KEY
+void uniffi_frost_uniffi_sdk_fn_func_verify_signature_with_randomized_verifying_key(RustBuffer randomized_verifying_key, RustBuffer message, RustBuffer signature, RustCallStatus *out_status
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_WITH_VERIFYING_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_FN_FUNC_VERIFY_SIGNATURE_WITH_VERIFYING_SHARE
+void uniffi_frost_uniffi_sdk_fn_func_verify_signature_with_verifying_share(RustBuffer message, RustBuffer signature, RustBuffer identifier, RustBuffer pubkey_package, RustCallStatus *out_status
+);
+#endif
 #ifndef UNIFFI_FFIDEF_FFI_FROST_UNIFFI_SDK_RUSTBUFFER_ALLOC
 #define UNIFFI_FFIDEF_FFI_FROST_UNIFFI_SDK_RUSTBUFFER_ALLOC
 RustBuffer ffi_frost_uniffi_sdk_rustbuffer_alloc(uint64_t size, RustCallStatus *out_status
@@ -1008,6 +1288,72 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_aggregate(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_COMMITMENT_TO_JSON
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_commitment_to_json(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_COMPUTE_REFRESHING_SHARES_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_COMPUTE_REFRESHING_SHARES_FROM
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_compute_refreshing_shares_from(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_DKG_ROUND1_SECRET_PACKAGE_TO_JSON
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_DKG_ROUND1_SECRET_PACKAGE_TO_JSON
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_dkg_round1_secret_package_to_json(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_DKG_ROUND2_SECRET_PACKAGE_TO_JSON
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_DKG_ROUND2_SECRET_PACKAGE_TO_JSON
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_dkg_round2_secret_package_to_json(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_AGGREGATE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_AGGREGATE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_aggregate(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_GENERATE_NONCES_AND_COMMITMENTS
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_GENERATE_NONCES_AND_COMMITMENTS
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_generate_nonces_and_commitments(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_NEW_SIGNING_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_NEW_SIGNING_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_new_signing_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_SIGN
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_SIGN
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_sign(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_TRUSTED_DEALER_KEYGEN_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_TRUSTED_DEALER_KEYGEN_FROM
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_trusted_dealer_keygen_from(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_AND_GET_KEY_PACKAGE_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_AND_GET_KEY_PACKAGE_FROM
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_and_get_key_package_from(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_SIGNATURE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_SIGNATURE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ED25519_VERIFY_SIGNATURE_SHARE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature_share(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_FROM_HEX_STRING
@@ -1044,6 +1390,18 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_identifier_from_uint16(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_COMMITMENT
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_json_to_commitment(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_DKG_ROUND1_SECRET_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_DKG_ROUND1_SECRET_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round1_secret_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_DKG_ROUND2_SECRET_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_DKG_ROUND2_SECRET_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round2_secret_package(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_JSON_TO_KEY_PACKAGE
@@ -1080,6 +1438,12 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_key_package_to_json(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_NEW_SIGNING_PACKAGE
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_new_signing_package(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ORCHARD_SPEND_AUTH_FROM_PCZT
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_ORCHARD_SPEND_AUTH_FROM_PCZT
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_orchard_spend_auth_from_pczt(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PART_1
@@ -1098,18 +1462,36 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_part_2(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PART_3
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_part_3(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PCZT_WITH_ORCHARD_SPEND_AUTH_SIGNATURES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PCZT_WITH_ORCHARD_SPEND_AUTH_SIGNATURES
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_pczt_with_orchard_spend_auth_signatures(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PUBLIC_KEY_PACKAGE_TO_JSON
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_PUBLIC_KEY_PACKAGE_TO_JSON
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_public_key_package_to_json(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_RANDOMIZER
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_RANDOMIZER
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_randomizer(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_SIGNING_PACKAGE
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZED_PARAMS_FROM_PUBLIC_KEY_AND_SIGNING_PACKAGE
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_signing_package(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZER_FROM_BYTES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZER_FROM_BYTES
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_randomizer_from_bytes(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZER_FROM_PARAMS
@@ -1122,18 +1504,180 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_randomizer_from_params(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RANDOMIZER_TO_JSON
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_randomizer_to_json(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RECONSTRUCT_SIGNING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RECONSTRUCT_SIGNING_KEY
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_reconstruct_signing_key(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_KEY_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_KEY_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_refresh_key_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_1
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_refresh_part_1(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_2
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_refresh_part_2(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REFRESH_PART_3
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_refresh_part_3(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_1
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_1(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_2
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_2(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_REPAIR_SHARE_STEP_3
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_3(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RESHARE_STEP_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RESHARE_STEP_1
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_reshare_step_1(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RESHARE_STEP_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_RESHARE_STEP_2
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_reshare_step_2(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_AGGREGATE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_AGGREGATE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_AGGREGATE_WITH_TWEAK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_AGGREGATE_WITH_TWEAK
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate_with_tweak(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_GENERATE_NONCES_AND_COMMITMENTS
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_GENERATE_NONCES_AND_COMMITMENTS
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_generate_nonces_and_commitments(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_IDENTIFIER_FROM_UINT16
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_IDENTIFIER_FROM_UINT16
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_identifier_from_uint16(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_NEW_SIGNING_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_NEW_SIGNING_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_new_signing_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_1
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_1
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_1(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_2
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_2
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_2(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_3
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_PART_3
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_3(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_SIGN
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_SIGN
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_SIGN_WITH_TWEAK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_SIGN_WITH_TWEAK
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign_with_tweak(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_TRUSTED_DEALER_KEYGEN_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_TRUSTED_DEALER_KEYGEN_FROM
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_trusted_dealer_keygen_from(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_TWEAK_PUBLIC_KEY_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_TWEAK_PUBLIC_KEY_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_tweak_public_key_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_AND_GET_KEY_PACKAGE_FROM
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_AND_GET_KEY_PACKAGE_FROM
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_and_get_key_package_from(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_SIGNATURE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_SIGNATURE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SECP256K1_TR_VERIFY_SIGNATURE_SHARE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature_share(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGN
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGN
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_sign(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGN_WITH_SIGNING_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGN_WITH_SIGNING_SHARE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_sign_with_signing_share(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGNATURE_SHARE_PACKAGE_TO_JSON
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGNATURE_SHARE_PACKAGE_TO_JSON
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_signature_share_package_to_json(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGNING_PACKAGE_MESSAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_SIGNING_PACKAGE_MESSAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_signing_package_message(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_TRUSTED_DEALER_KEYGEN_FROM
@@ -1170,6 +1714,66 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_func_verify_randomized_signature(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE
 uint16_t uniffi_frost_uniffi_sdk_checksum_func_verify_signature(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_SHARE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_verify_signature_share(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_WITH_RANDOMIZED_VERIFYING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_WITH_RANDOMIZED_VERIFYING_KEY
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_randomized_verifying_key(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_WITH_VERIFYING_SHARE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_FUNC_VERIFY_SIGNATURE_WITH_VERIFYING_SHARE
+uint16_t uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_verifying_share(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART1RESULT_PACKAGE
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART1RESULT_PACKAGE
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_package(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART1RESULT_SECRET
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART1RESULT_SECRET
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_secret(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART2RESULT_PACKAGES
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART2RESULT_PACKAGES
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_packages(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART2RESULT_SECRET
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_DKGPART2RESULT_SECRET
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_secret(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY_HEX
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZED_VERIFYING_KEY_HEX
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key_hex(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZER
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_FROSTRANDOMIZEDPARAMS_RANDOMIZER
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomizer(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDADDRESS_STRING_ENCODED
@@ -1212,6 +1816,18 @@ uint16_t uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_nk(void
 #define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDFULLVIEWINGKEY_RIVK
 uint16_t uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_rivk(void
     
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDKEYPARTS_NK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDKEYPARTS_NK
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_nk(void
+    
+);
+#endif
+#ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDKEYPARTS_RIVK
+#define UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDKEYPARTS_RIVK
+uint16_t uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_rivk(void
+    
 );
 #endif
 #ifndef UNIFFI_FFIDEF_UNIFFI_FROST_UNIFFI_SDK_CHECKSUM_METHOD_ORCHARDNULLIFIERDERIVINGKEY_TO_BYTES
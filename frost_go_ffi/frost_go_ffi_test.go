@@ -19,7 +19,7 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 	}
 
 	// Start the trusted dealer key generation with the given config
-	keygen, err := TrustedDealerKeygenFrom(secretConfig)
+	keygen, err := Ed25519TrustedDealerKeygenFrom(secretConfig)
 	if err != nil {
 		t.Fatalf("Failed to generate keygen: %v", err)
 	}
@@ -33,7 +33,7 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 	for identifier, value := range shares {
 		// this verifies the share and generates a key package for each
 		// participant
-		keyPackage, err := VerifyAndGetKeyPackageFrom(value)
+		keyPackage, err := Ed25519VerifyAndGetKeyPackageFrom(value)
 		if err != nil {
 			t.Fatalf("Failed to get key package: %v", err)
 		}
@@ -52,9 +52,9 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 	var commitments []FrostSigningCommitments
 
 	for participant, secretShare := range shares {
-		keyPackage, err := VerifyAndGetKeyPackageFrom(secretShare)
+		keyPackage, err := Ed25519VerifyAndGetKeyPackageFrom(secretShare)
 		// generates a nonce and a commitment to be used (round 1)
-		firstRoundCommitment, err := GenerateNoncesAndCommitments(keyPackage)
+		firstRoundCommitment, err := Ed25519GenerateNoncesAndCommitments(keyPackage)
 
 		if err != nil {
 			t.Fatalf("Failed to generate nonces and commitments: %v", err)
@@ -65,7 +65,7 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 
 	// create a signing package using the message to be signed and the
 	// the commitments from the first round.
-	signingPackage, err := NewSigningPackage(message, commitments)
+	signingPackage, err := Ed25519NewSigningPackage(message, commitments)
 	if err != nil {
 		t.Fatalf("Failed to create signing package: %v", err)
 	}
@@ -75,7 +75,7 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 	// now, each participant has to generate a signaature from it's own nonce,
 	// key package, and the signing package.
 	for participant, keyPackage := range keyPackages {
-		signatureShare, err := Sign(signingPackage, nonces[participant], keyPackage)
+		signatureShare, err := Ed25519Sign(signingPackage, nonces[participant], keyPackage)
 		if err != nil {
 			t.Fatalf("Failed to sign: %v", err)
 		}
@@ -84,13 +84,13 @@ func TestTrustedDealerFromConfigWithSecret(t *testing.T) {
 
 	// the coordinator will receive the signatures produced by the t participants
 	// and aggregate them. This will produce a signature that will be verified
-	signature, err := Aggregate(signingPackage, signatureShares, publicKey)
+	signature, err := Ed25519Aggregate(signingPackage, signatureShares, publicKey)
 	if err != nil {
 		t.Fatalf("Failed to aggregate signature: %v", err)
 	}
 
 	// verify the signature
-	if err := VerifySignature(message, signature, publicKey); err != nil {
+	if err := Ed25519VerifySignature(message, signature, publicKey); err != nil {
 		t.Fatalf("Failed to verify signature: %v", err)
 	}
 }
@@ -364,6 +364,105 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_commitment_to_json: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_compute_refreshing_shares_from()
+		})
+		if checksum != 26834 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_compute_refreshing_shares_from: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_dkg_round1_secret_package_to_json()
+		})
+		if checksum != 10627 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_dkg_round1_secret_package_to_json: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_dkg_round2_secret_package_to_json()
+		})
+		if checksum != 18691 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_dkg_round2_secret_package_to_json: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_aggregate()
+		})
+		if checksum != 53921 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_aggregate: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_generate_nonces_and_commitments()
+		})
+		if checksum != 15415 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_generate_nonces_and_commitments: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_new_signing_package()
+		})
+		if checksum != 34344 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_new_signing_package: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_sign()
+		})
+		if checksum != 2140 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_sign: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_trusted_dealer_keygen_from()
+		})
+		if checksum != 5405 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_trusted_dealer_keygen_from: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_and_get_key_package_from()
+		})
+		if checksum != 56713 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_and_get_key_package_from: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature()
+		})
+		if checksum != 37978 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature_share()
+		})
+		if checksum != 38923 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_ed25519_verify_signature_share: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_from_hex_string()
@@ -418,6 +517,24 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_json_to_commitment: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round1_secret_package()
+		})
+		if checksum != 50735 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round1_secret_package: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round2_secret_package()
+		})
+		if checksum != 57068 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_json_to_dkg_round2_secret_package: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_json_to_key_package()
@@ -472,6 +589,15 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_new_signing_package: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_orchard_spend_auth_from_pczt()
+		})
+		if checksum != 24159 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_orchard_spend_auth_from_pczt: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_part_1()
@@ -499,6 +625,15 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_part_3: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_pczt_with_orchard_spend_auth_signatures()
+		})
+		if checksum != 46534 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_pczt_with_orchard_spend_auth_signatures: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_public_key_package_to_json()
@@ -508,6 +643,15 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_public_key_package_to_json: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_randomizer()
+		})
+		if checksum != 31501 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_randomizer: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_signing_package()
@@ -517,6 +661,15 @@ func uniffiCheckChecksums() {
 			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_randomized_params_from_public_key_and_signing_package: UniFFI API checksum mismatch")
 		}
 	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_randomizer_from_bytes()
+		})
+		if checksum != 64102 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_randomizer_from_bytes: UniFFI API checksum mismatch")
+		}
+	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
 			return C.uniffi_frost_uniffi_sdk_checksum_func_randomizer_from_params()
@@ -537,702 +690,1192 @@ func uniffiCheckChecksums() {
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_sign()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_reconstruct_signing_key()
 		})
-		if checksum != 22743 {
+		if checksum != 31583 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_sign: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_reconstruct_signing_key: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_signature_share_package_to_json()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_refresh_key_package()
 		})
-		if checksum != 17380 {
+		if checksum != 19827 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_signature_share_package_to_json: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_refresh_key_package: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_from()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_refresh_part_1()
 		})
-		if checksum != 4367 {
+		if checksum != 36075 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_from: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_refresh_part_1: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_with_identifiers()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_refresh_part_2()
 		})
-		if checksum != 25579 {
+		if checksum != 59740 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_with_identifiers: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_refresh_part_2: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_validate_config()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_refresh_part_3()
 		})
-		if checksum != 42309 {
+		if checksum != 10524 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_validate_config: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_refresh_part_3: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_and_get_key_package_from()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_1()
 		})
-		if checksum != 52603 {
+		if checksum != 30577 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_and_get_key_package_from: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_1: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_randomized_signature()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_2()
 		})
-		if checksum != 61115 {
+		if checksum != 53498 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_randomized_signature: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_2: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_signature()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_3()
 		})
-		if checksum != 31978 {
+		if checksum != 12709 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_signature: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_repair_share_step_3: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardaddress_string_encoded()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_reshare_step_1()
 		})
-		if checksum != 17163 {
+		if checksum != 49524 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardaddress_string_encoded: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_reshare_step_1: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardcommitivkrandomness_to_bytes()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_reshare_step_2()
 		})
-		if checksum != 45794 {
+		if checksum != 52635 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardcommitivkrandomness_to_bytes: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_reshare_step_2: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_ak()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate()
 		})
-		if checksum != 17920 {
+		if checksum != 37481 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_ak: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_derive_address()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate_with_tweak()
 		})
-		if checksum != 39349 {
+		if checksum != 27918 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_derive_address: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_aggregate_with_tweak: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_encode()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_generate_nonces_and_commitments()
 		})
-		if checksum != 15911 {
+		if checksum != 13510 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_encode: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_generate_nonces_and_commitments: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_nk()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_identifier_from_uint16()
 		})
-		if checksum != 26127 {
+		if checksum != 37150 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_nk: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_identifier_from_uint16: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_rivk()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_new_signing_package()
 		})
-		if checksum != 62140 {
+		if checksum != 6765 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_rivk: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_new_signing_package: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardnullifierderivingkey_to_bytes()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_1()
 		})
-		if checksum != 38576 {
+		if checksum != 54071 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardnullifierderivingkey_to_bytes: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_1: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardspendvalidatingkey_to_bytes()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_2()
 		})
-		if checksum != 32867 {
+		if checksum != 11381 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardspendvalidatingkey_to_bytes: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_2: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardaddress_new_from_string()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_3()
 		})
-		if checksum != 54798 {
+		if checksum != 62908 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardaddress_new_from_string: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_part_3: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardcommitivkrandomness_new()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign()
 		})
-		if checksum != 65326 {
+		if checksum != 42857 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardcommitivkrandomness_new: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_decode()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign_with_tweak()
 		})
-		if checksum != 15654 {
+		if checksum != 39790 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_decode: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_sign_with_tweak: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_checked_parts()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_trusted_dealer_keygen_from()
 		})
-		if checksum != 32693 {
+		if checksum != 15652 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_checked_parts: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_trusted_dealer_keygen_from: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_validating_key_and_seed()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_tweak_public_key_package()
 		})
-		if checksum != 29602 {
+		if checksum != 12191 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_validating_key_and_seed: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_tweak_public_key_package: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardkeyparts_random()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_and_get_key_package_from()
 		})
-		if checksum != 17995 {
+		if checksum != 32235 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardkeyparts_random: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_and_get_key_package_from: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardnullifierderivingkey_new()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature()
 		})
-		if checksum != 3116 {
+		if checksum != 35239 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardnullifierderivingkey_new: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature: UniFFI API checksum mismatch")
 		}
 	}
 	{
 		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
-			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardspendvalidatingkey_from_bytes()
+			return C.uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature_share()
 		})
-		if checksum != 52420 {
+		if checksum != 5002 {
 			// If this happens try cleaning and rebuilding your project
-			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardspendvalidatingkey_from_bytes: UniFFI API checksum mismatch")
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_secp256k1_tr_verify_signature_share: UniFFI API checksum mismatch")
 		}
 	}
-}
-
-type FfiConverterUint16 struct{}
-
-var FfiConverterUint16INSTANCE = FfiConverterUint16{}
-
-func (FfiConverterUint16) Lower(value uint16) C.uint16_t {
-	return C.uint16_t(value)
-}
-
-func (FfiConverterUint16) Write(writer io.Writer, value uint16) {
-	writeUint16(writer, value)
-}
-
-func (FfiConverterUint16) Lift(value C.uint16_t) uint16 {
-	return uint16(value)
-}
-
-func (FfiConverterUint16) Read(reader io.Reader) uint16 {
-	return readUint16(reader)
-}
-
-type FfiDestroyerUint16 struct{}
-
-func (FfiDestroyerUint16) Destroy(_ uint16) {}
-
-type FfiConverterString struct{}
-
-var FfiConverterStringINSTANCE = FfiConverterString{}
-
-func (FfiConverterString) Lift(rb RustBufferI) string {
-	defer rb.Free()
-	reader := rb.AsReader()
-	b, err := io.ReadAll(reader)
-	if err != nil {
-		panic(fmt.Errorf("reading reader: %w", err))
-	}
-	return string(b)
-}
-
-func (FfiConverterString) Read(reader io.Reader) string {
-	length := readInt32(reader)
-	buffer := make([]byte, length)
-	read_length, err := reader.Read(buffer)
-	if err != nil && err != io.EOF {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_sign()
+		})
+		if checksum != 22743 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_sign: UniFFI API checksum mismatch")
+		}
 	}
-	if read_length != int(length) {
-		panic(fmt.Errorf("bad read length when reading string, expected %d, read %d", length, read_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_sign_with_signing_share()
+		})
+		if checksum != 55009 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_sign_with_signing_share: UniFFI API checksum mismatch")
+		}
 	}
-	return string(buffer)
-}
-
-func (FfiConverterString) Lower(value string) C.RustBuffer {
-	return stringToRustBuffer(value)
-}
-
-func (FfiConverterString) Write(writer io.Writer, value string) {
-	if len(value) > math.MaxInt32 {
-		panic("String is too large to fit into Int32")
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_signature_share_package_to_json()
+		})
+		if checksum != 17380 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_signature_share_package_to_json: UniFFI API checksum mismatch")
+		}
 	}
-
-	writeInt32(writer, int32(len(value)))
-	write_length, err := io.WriteString(writer, value)
-	if err != nil {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_signing_package_message()
+		})
+		if checksum != 44794 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_signing_package_message: UniFFI API checksum mismatch")
+		}
 	}
-	if write_length != len(value) {
-		panic(fmt.Errorf("bad write length when writing string, expected %d, written %d", len(value), write_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_from()
+		})
+		if checksum != 4367 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_from: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-type FfiDestroyerString struct{}
-
-func (FfiDestroyerString) Destroy(_ string) {}
-
-type FfiConverterBytes struct{}
-
-var FfiConverterBytesINSTANCE = FfiConverterBytes{}
-
-func (c FfiConverterBytes) Lower(value []byte) C.RustBuffer {
-	return LowerIntoRustBuffer[[]byte](c, value)
-}
-
-func (c FfiConverterBytes) Write(writer io.Writer, value []byte) {
-	if len(value) > math.MaxInt32 {
-		panic("[]byte is too large to fit into Int32")
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_with_identifiers()
+		})
+		if checksum != 25579 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_trusted_dealer_keygen_with_identifiers: UniFFI API checksum mismatch")
+		}
 	}
-
-	writeInt32(writer, int32(len(value)))
-	write_length, err := writer.Write(value)
-	if err != nil {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_validate_config()
+		})
+		if checksum != 42309 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_validate_config: UniFFI API checksum mismatch")
+		}
 	}
-	if write_length != len(value) {
-		panic(fmt.Errorf("bad write length when writing []byte, expected %d, written %d", len(value), write_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_and_get_key_package_from()
+		})
+		if checksum != 52603 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_and_get_key_package_from: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-func (c FfiConverterBytes) Lift(rb RustBufferI) []byte {
-	return LiftFromRustBuffer[[]byte](c, rb)
-}
-
-func (c FfiConverterBytes) Read(reader io.Reader) []byte {
-	length := readInt32(reader)
-	buffer := make([]byte, length)
-	read_length, err := reader.Read(buffer)
-	if err != nil && err != io.EOF {
-		panic(err)
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_randomized_signature()
+		})
+		if checksum != 61115 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_randomized_signature: UniFFI API checksum mismatch")
+		}
 	}
-	if read_length != int(length) {
-		panic(fmt.Errorf("bad read length when reading []byte, expected %d, read %d", length, read_length))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_signature()
+		})
+		if checksum != 31978 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_signature: UniFFI API checksum mismatch")
+		}
 	}
-	return buffer
-}
-
-type FfiDestroyerBytes struct{}
-
-func (FfiDestroyerBytes) Destroy(_ []byte) {}
-
-// Below is an implementation of synchronization requirements outlined in the link.
-// https://github.com/mozilla/uniffi-rs/blob/0dc031132d9493ca812c3af6e7dd60ad2ea95bf0/uniffi_bindgen/src/bindings/kotlin/templates/ObjectRuntime.kt#L31
-
-type FfiObject struct {
-	pointer       unsafe.Pointer
-	callCounter   atomic.Int64
-	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer
-	freeFunction  func(unsafe.Pointer, *C.RustCallStatus)
-	destroyed     atomic.Bool
-}
-
-func newFfiObject(
-	pointer unsafe.Pointer,
-	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer,
-	freeFunction func(unsafe.Pointer, *C.RustCallStatus),
-) FfiObject {
-	return FfiObject{
-		pointer:       pointer,
-		cloneFunction: cloneFunction,
-		freeFunction:  freeFunction,
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_signature_share()
+		})
+		if checksum != 59086 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_signature_share: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-func (ffiObject *FfiObject) incrementPointer(debugName string) unsafe.Pointer {
-	for {
-		counter := ffiObject.callCounter.Load()
-		if counter <= -1 {
-			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_randomized_verifying_key()
+		})
+		if checksum != 12582 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_randomized_verifying_key: UniFFI API checksum mismatch")
 		}
-		if counter == math.MaxInt64 {
-			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_verifying_share()
+		})
+		if checksum != 11183 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_func_verify_signature_with_verifying_share: UniFFI API checksum mismatch")
 		}
-		if ffiObject.callCounter.CompareAndSwap(counter, counter+1) {
-			break
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_package()
+		})
+		if checksum != 51750 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_package: UniFFI API checksum mismatch")
 		}
 	}
-
-	return rustCall(func(status *C.RustCallStatus) unsafe.Pointer {
-		return ffiObject.cloneFunction(ffiObject.pointer, status)
-	})
-}
-
-func (ffiObject *FfiObject) decrementPointer() {
-	if ffiObject.callCounter.Add(-1) == -1 {
-		ffiObject.freeRustArcPtr()
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_secret()
+		})
+		if checksum != 1774 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_dkgpart1result_secret: UniFFI API checksum mismatch")
+		}
 	}
-}
-
-func (ffiObject *FfiObject) destroy() {
-	if ffiObject.destroyed.CompareAndSwap(false, true) {
-		if ffiObject.callCounter.Add(-1) == -1 {
-			ffiObject.freeRustArcPtr()
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_packages()
+		})
+		if checksum != 4035 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_packages: UniFFI API checksum mismatch")
 		}
 	}
-}
-
-func (ffiObject *FfiObject) freeRustArcPtr() {
-	rustCall(func(status *C.RustCallStatus) int32 {
-		ffiObject.freeFunction(ffiObject.pointer, status)
-		return 0
-	})
-}
-
-type DkgPart1ResultInterface interface {
-}
-type DkgPart1Result struct {
-	ffiObject FfiObject
-}
-
-func (object *DkgPart1Result) Destroy() {
-	runtime.SetFinalizer(object, nil)
-	object.ffiObject.destroy()
-}
-
-type FfiConverterDkgPart1Result struct{}
-
-var FfiConverterDkgPart1ResultINSTANCE = FfiConverterDkgPart1Result{}
-
-func (c FfiConverterDkgPart1Result) Lift(pointer unsafe.Pointer) *DkgPart1Result {
-	result := &DkgPart1Result{
-		newFfiObject(
-			pointer,
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_frost_uniffi_sdk_fn_clone_dkgpart1result(pointer, status)
-			},
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_frost_uniffi_sdk_fn_free_dkgpart1result(pointer, status)
-			},
-		),
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_secret()
+		})
+		if checksum != 14070 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_dkgpart2result_secret: UniFFI API checksum mismatch")
+		}
 	}
-	runtime.SetFinalizer(result, (*DkgPart1Result).Destroy)
-	return result
-}
-
-func (c FfiConverterDkgPart1Result) Read(reader io.Reader) *DkgPart1Result {
-	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
-}
-
-func (c FfiConverterDkgPart1Result) Lower(value *DkgPart1Result) unsafe.Pointer {
-	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
-	// because the pointer will be decremented immediately after this function returns,
-	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*DkgPart1Result")
-	defer value.ffiObject.decrementPointer()
-	return pointer
-
-}
-
-func (c FfiConverterDkgPart1Result) Write(writer io.Writer, value *DkgPart1Result) {
-	writeUint64(writer, uint64(uintptr(c.Lower(value))))
-}
-
-type FfiDestroyerDkgPart1Result struct{}
-
-func (_ FfiDestroyerDkgPart1Result) Destroy(value *DkgPart1Result) {
-	value.Destroy()
-}
-
-type DkgPart2ResultInterface interface {
-}
-type DkgPart2Result struct {
-	ffiObject FfiObject
-}
-
-func (object *DkgPart2Result) Destroy() {
-	runtime.SetFinalizer(object, nil)
-	object.ffiObject.destroy()
-}
-
-type FfiConverterDkgPart2Result struct{}
-
-var FfiConverterDkgPart2ResultINSTANCE = FfiConverterDkgPart2Result{}
-
-func (c FfiConverterDkgPart2Result) Lift(pointer unsafe.Pointer) *DkgPart2Result {
-	result := &DkgPart2Result{
-		newFfiObject(
-			pointer,
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_frost_uniffi_sdk_fn_clone_dkgpart2result(pointer, status)
-			},
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_frost_uniffi_sdk_fn_free_dkgpart2result(pointer, status)
-			},
-		),
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key()
+		})
+		if checksum != 64939 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key_hex()
+		})
+		if checksum != 19238 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomized_verifying_key_hex: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomizer()
+		})
+		if checksum != 10357 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_frostrandomizedparams_randomizer: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardaddress_string_encoded()
+		})
+		if checksum != 17163 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardaddress_string_encoded: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardcommitivkrandomness_to_bytes()
+		})
+		if checksum != 45794 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardcommitivkrandomness_to_bytes: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_ak()
+		})
+		if checksum != 17920 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_ak: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_derive_address()
+		})
+		if checksum != 39349 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_derive_address: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_encode()
+		})
+		if checksum != 15911 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_encode: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_nk()
+		})
+		if checksum != 26127 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_nk: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_rivk()
+		})
+		if checksum != 62140 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardfullviewingkey_rivk: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_nk()
+		})
+		if checksum != 40834 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_nk: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_rivk()
+		})
+		if checksum != 8893 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardkeyparts_rivk: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardnullifierderivingkey_to_bytes()
+		})
+		if checksum != 38576 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardnullifierderivingkey_to_bytes: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_method_orchardspendvalidatingkey_to_bytes()
+		})
+		if checksum != 41335 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_method_orchardspendvalidatingkey_to_bytes: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardaddress_new_from_string()
+		})
+		if checksum != 54798 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardaddress_new_from_string: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardcommitivkrandomness_new()
+		})
+		if checksum != 65326 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardcommitivkrandomness_new: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_decode()
+		})
+		if checksum != 15654 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_decode: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_checked_parts()
+		})
+		if checksum != 32693 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_checked_parts: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_validating_key_and_seed()
+		})
+		if checksum != 29602 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardfullviewingkey_new_from_validating_key_and_seed: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardkeyparts_random()
+		})
+		if checksum != 17995 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardkeyparts_random: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardnullifierderivingkey_new()
+		})
+		if checksum != 3116 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardnullifierderivingkey_new: UniFFI API checksum mismatch")
+		}
+	}
+	{
+		checksum := rustCall(func(_uniffiStatus *C.RustCallStatus) C.uint16_t {
+			return C.uniffi_frost_uniffi_sdk_checksum_constructor_orchardspendvalidatingkey_from_bytes()
+		})
+		if checksum != 20938 {
+			// If this happens try cleaning and rebuilding your project
+			panic("frost_uniffi_sdk: uniffi_frost_uniffi_sdk_checksum_constructor_orchardspendvalidatingkey_from_bytes: UniFFI API checksum mismatch")
+		}
 	}
-	runtime.SetFinalizer(result, (*DkgPart2Result).Destroy)
-	return result
 }
 
-func (c FfiConverterDkgPart2Result) Read(reader io.Reader) *DkgPart2Result {
-	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+type FfiConverterUint16 struct{}
+
+var FfiConverterUint16INSTANCE = FfiConverterUint16{}
+
+func (FfiConverterUint16) Lower(value uint16) C.uint16_t {
+	return C.uint16_t(value)
 }
 
-func (c FfiConverterDkgPart2Result) Lower(value *DkgPart2Result) unsafe.Pointer {
-	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
-	// because the pointer will be decremented immediately after this function returns,
-	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*DkgPart2Result")
-	defer value.ffiObject.decrementPointer()
-	return pointer
+func (FfiConverterUint16) Write(writer io.Writer, value uint16) {
+	writeUint16(writer, value)
+}
 
+func (FfiConverterUint16) Lift(value C.uint16_t) uint16 {
+	return uint16(value)
 }
 
-func (c FfiConverterDkgPart2Result) Write(writer io.Writer, value *DkgPart2Result) {
-	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+func (FfiConverterUint16) Read(reader io.Reader) uint16 {
+	return readUint16(reader)
 }
 
-type FfiDestroyerDkgPart2Result struct{}
+type FfiDestroyerUint16 struct{}
 
-func (_ FfiDestroyerDkgPart2Result) Destroy(value *DkgPart2Result) {
-	value.Destroy()
+func (FfiDestroyerUint16) Destroy(_ uint16) {}
+
+type FfiConverterUint32 struct{}
+
+var FfiConverterUint32INSTANCE = FfiConverterUint32{}
+
+func (FfiConverterUint32) Lower(value uint32) C.uint32_t {
+	return C.uint32_t(value)
 }
 
-type DkgRound1SecretPackageInterface interface {
+func (FfiConverterUint32) Write(writer io.Writer, value uint32) {
+	writeUint32(writer, value)
 }
-type DkgRound1SecretPackage struct {
-	ffiObject FfiObject
+
+func (FfiConverterUint32) Lift(value C.uint32_t) uint32 {
+	return uint32(value)
 }
 
-func (object *DkgRound1SecretPackage) Destroy() {
-	runtime.SetFinalizer(object, nil)
-	object.ffiObject.destroy()
+func (FfiConverterUint32) Read(reader io.Reader) uint32 {
+	return readUint32(reader)
 }
 
-type FfiConverterDkgRound1SecretPackage struct{}
+type FfiDestroyerUint32 struct{}
 
-var FfiConverterDkgRound1SecretPackageINSTANCE = FfiConverterDkgRound1SecretPackage{}
+func (FfiDestroyerUint32) Destroy(_ uint32) {}
 
-func (c FfiConverterDkgRound1SecretPackage) Lift(pointer unsafe.Pointer) *DkgRound1SecretPackage {
-	result := &DkgRound1SecretPackage{
-		newFfiObject(
-			pointer,
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_frost_uniffi_sdk_fn_clone_dkground1secretpackage(pointer, status)
-			},
-			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_frost_uniffi_sdk_fn_free_dkground1secretpackage(pointer, status)
-			},
-		),
-	}
-	runtime.SetFinalizer(result, (*DkgRound1SecretPackage).Destroy)
-	return result
-}
+type FfiConverterString struct{}
 
-func (c FfiConverterDkgRound1SecretPackage) Read(reader io.Reader) *DkgRound1SecretPackage {
-	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+var FfiConverterStringINSTANCE = FfiConverterString{}
+
+func (FfiConverterString) Lift(rb RustBufferI) string {
+	defer rb.Free()
+	reader := rb.AsReader()
+	b, err := io.ReadAll(reader)
+	if err != nil {
+		panic(fmt.Errorf("reading reader: %w", err))
+	}
+	return string(b)
 }
 
-func (c FfiConverterDkgRound1SecretPackage) Lower(value *DkgRound1SecretPackage) unsafe.Pointer {
-	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
-	// because the pointer will be decremented immediately after this function returns,
-	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*DkgRound1SecretPackage")
-	defer value.ffiObject.decrementPointer()
-	return pointer
+func (FfiConverterString) Read(reader io.Reader) string {
+	length := readInt32(reader)
+	buffer := make([]byte, length)
+	read_length, err := reader.Read(buffer)
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+	if read_length != int(length) {
+		panic(fmt.Errorf("bad read length when reading string, expected %d, read %d", length, read_length))
+	}
+	return string(buffer)
+}
 
+func (FfiConverterString) Lower(value string) C.RustBuffer {
+	return stringToRustBuffer(value)
 }
 
-func (c FfiConverterDkgRound1SecretPackage) Write(writer io.Writer, value *DkgRound1SecretPackage) {
-	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+func (FfiConverterString) Write(writer io.Writer, value string) {
+	if len(value) > math.MaxInt32 {
+		panic("String is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	write_length, err := io.WriteString(writer, value)
+	if err != nil {
+		panic(err)
+	}
+	if write_length != len(value) {
+		panic(fmt.Errorf("bad write length when writing string, expected %d, written %d", len(value), write_length))
+	}
 }
 
-type FfiDestroyerDkgRound1SecretPackage struct{}
+type FfiDestroyerString struct{}
 
-func (_ FfiDestroyerDkgRound1SecretPackage) Destroy(value *DkgRound1SecretPackage) {
-	value.Destroy()
+func (FfiDestroyerString) Destroy(_ string) {}
+
+type FfiConverterBytes struct{}
+
+var FfiConverterBytesINSTANCE = FfiConverterBytes{}
+
+func (c FfiConverterBytes) Lower(value []byte) C.RustBuffer {
+	return LowerIntoRustBuffer[[]byte](c, value)
 }
 
-type DkgRound2SecretPackageInterface interface {
+func (c FfiConverterBytes) Write(writer io.Writer, value []byte) {
+	if len(value) > math.MaxInt32 {
+		panic("[]byte is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	write_length, err := writer.Write(value)
+	if err != nil {
+		panic(err)
+	}
+	if write_length != len(value) {
+		panic(fmt.Errorf("bad write length when writing []byte, expected %d, written %d", len(value), write_length))
+	}
 }
-type DkgRound2SecretPackage struct {
+
+func (c FfiConverterBytes) Lift(rb RustBufferI) []byte {
+	return LiftFromRustBuffer[[]byte](c, rb)
+}
+
+func (c FfiConverterBytes) Read(reader io.Reader) []byte {
+	length := readInt32(reader)
+	buffer := make([]byte, length)
+	read_length, err := reader.Read(buffer)
+	if err != nil && err != io.EOF {
+		panic(err)
+	}
+	if read_length != int(length) {
+		panic(fmt.Errorf("bad read length when reading []byte, expected %d, read %d", length, read_length))
+	}
+	return buffer
+}
+
+type FfiDestroyerBytes struct{}
+
+func (FfiDestroyerBytes) Destroy(_ []byte) {}
+
+// Below is an implementation of synchronization requirements outlined in the link.
+// https://github.com/mozilla/uniffi-rs/blob/0dc031132d9493ca812c3af6e7dd60ad2ea95bf0/uniffi_bindgen/src/bindings/kotlin/templates/ObjectRuntime.kt#L31
+
+type FfiObject struct {
+	pointer       unsafe.Pointer
+	callCounter   atomic.Int64
+	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer
+	freeFunction  func(unsafe.Pointer, *C.RustCallStatus)
+	destroyed     atomic.Bool
+}
+
+func newFfiObject(
+	pointer unsafe.Pointer,
+	cloneFunction func(unsafe.Pointer, *C.RustCallStatus) unsafe.Pointer,
+	freeFunction func(unsafe.Pointer, *C.RustCallStatus),
+) FfiObject {
+	return FfiObject{
+		pointer:       pointer,
+		cloneFunction: cloneFunction,
+		freeFunction:  freeFunction,
+	}
+}
+
+func (ffiObject *FfiObject) incrementPointer(debugName string) unsafe.Pointer {
+	for {
+		counter := ffiObject.callCounter.Load()
+		if counter <= -1 {
+			panic(fmt.Errorf("%v object has already been destroyed", debugName))
+		}
+		if counter == math.MaxInt64 {
+			panic(fmt.Errorf("%v object call counter would overflow", debugName))
+		}
+		if ffiObject.callCounter.CompareAndSwap(counter, counter+1) {
+			break
+		}
+	}
+
+	return rustCall(func(status *C.RustCallStatus) unsafe.Pointer {
+		return ffiObject.cloneFunction(ffiObject.pointer, status)
+	})
+}
+
+func (ffiObject *FfiObject) decrementPointer() {
+	if ffiObject.callCounter.Add(-1) == -1 {
+		ffiObject.freeRustArcPtr()
+	}
+}
+
+func (ffiObject *FfiObject) destroy() {
+	if ffiObject.destroyed.CompareAndSwap(false, true) {
+		if ffiObject.callCounter.Add(-1) == -1 {
+			ffiObject.freeRustArcPtr()
+		}
+	}
+}
+
+func (ffiObject *FfiObject) freeRustArcPtr() {
+	rustCall(func(status *C.RustCallStatus) int32 {
+		ffiObject.freeFunction(ffiObject.pointer, status)
+		return 0
+	})
+}
+
+type DkgPart1ResultInterface interface {
+	// The round 1 package to broadcast to every other participant.
+	Package() DkgRound1Package
+	// The secret package to keep (secretly) until part 2.
+	Secret() *DkgRound1SecretPackage
+}
+type DkgPart1Result struct {
 	ffiObject FfiObject
 }
 
-func (object *DkgRound2SecretPackage) Destroy() {
+// The round 1 package to broadcast to every other participant.
+func (_self *DkgPart1Result) Package() DkgRound1Package {
+	_pointer := _self.ffiObject.incrementPointer("*DkgPart1Result")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterDkgRound1PackageINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_method_dkgpart1result_package(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+
+// The secret package to keep (secretly) until part 2.
+func (_self *DkgPart1Result) Secret() *DkgRound1SecretPackage {
+	_pointer := _self.ffiObject.incrementPointer("*DkgPart1Result")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterDkgRound1SecretPackageINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_method_dkgpart1result_secret(
+			_pointer, _uniffiStatus)
+	}))
+}
+func (object *DkgPart1Result) Destroy() {
 	runtime.SetFinalizer(object, nil)
 	object.ffiObject.destroy()
 }
 
-type FfiConverterDkgRound2SecretPackage struct{}
+type FfiConverterDkgPart1Result struct{}
 
-var FfiConverterDkgRound2SecretPackageINSTANCE = FfiConverterDkgRound2SecretPackage{}
+var FfiConverterDkgPart1ResultINSTANCE = FfiConverterDkgPart1Result{}
 
-func (c FfiConverterDkgRound2SecretPackage) Lift(pointer unsafe.Pointer) *DkgRound2SecretPackage {
-	result := &DkgRound2SecretPackage{
+func (c FfiConverterDkgPart1Result) Lift(pointer unsafe.Pointer) *DkgPart1Result {
+	result := &DkgPart1Result{
 		newFfiObject(
 			pointer,
 			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_frost_uniffi_sdk_fn_clone_dkground2secretpackage(pointer, status)
+				return C.uniffi_frost_uniffi_sdk_fn_clone_dkgpart1result(pointer, status)
 			},
 			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_frost_uniffi_sdk_fn_free_dkground2secretpackage(pointer, status)
+				C.uniffi_frost_uniffi_sdk_fn_free_dkgpart1result(pointer, status)
 			},
 		),
 	}
-	runtime.SetFinalizer(result, (*DkgRound2SecretPackage).Destroy)
+	runtime.SetFinalizer(result, (*DkgPart1Result).Destroy)
 	return result
 }
 
-func (c FfiConverterDkgRound2SecretPackage) Read(reader io.Reader) *DkgRound2SecretPackage {
+func (c FfiConverterDkgPart1Result) Read(reader io.Reader) *DkgPart1Result {
 	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
 }
 
-func (c FfiConverterDkgRound2SecretPackage) Lower(value *DkgRound2SecretPackage) unsafe.Pointer {
+func (c FfiConverterDkgPart1Result) Lower(value *DkgPart1Result) unsafe.Pointer {
 	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
 	// because the pointer will be decremented immediately after this function returns,
 	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*DkgRound2SecretPackage")
+	pointer := value.ffiObject.incrementPointer("*DkgPart1Result")
 	defer value.ffiObject.decrementPointer()
 	return pointer
 
 }
 
-func (c FfiConverterDkgRound2SecretPackage) Write(writer io.Writer, value *DkgRound2SecretPackage) {
+func (c FfiConverterDkgPart1Result) Write(writer io.Writer, value *DkgPart1Result) {
 	writeUint64(writer, uint64(uintptr(c.Lower(value))))
 }
 
-type FfiDestroyerDkgRound2SecretPackage struct{}
+type FfiDestroyerDkgPart1Result struct{}
 
-func (_ FfiDestroyerDkgRound2SecretPackage) Destroy(value *DkgRound2SecretPackage) {
+func (_ FfiDestroyerDkgPart1Result) Destroy(value *DkgPart1Result) {
 	value.Destroy()
 }
 
-type FrostRandomizedParamsInterface interface {
+type DkgPart2ResultInterface interface {
+	// The round 2 packages, each one to be sent privately to the
+	// participant it is addressed to.
+	Packages() []DkgRound2Package
+	// The secret package to keep (secretly) until part 3.
+	Secret() *DkgRound2SecretPackage
 }
-type FrostRandomizedParams struct {
+type DkgPart2Result struct {
 	ffiObject FfiObject
 }
 
-func (object *FrostRandomizedParams) Destroy() {
+// The round 2 packages, each one to be sent privately to the
+// participant it is addressed to.
+func (_self *DkgPart2Result) Packages() []DkgRound2Package {
+	_pointer := _self.ffiObject.incrementPointer("*DkgPart2Result")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterSequenceDkgRound2PackageINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_method_dkgpart2result_packages(
+				_pointer, _uniffiStatus),
+		}
+	}))
+}
+
+// The secret package to keep (secretly) until part 3.
+func (_self *DkgPart2Result) Secret() *DkgRound2SecretPackage {
+	_pointer := _self.ffiObject.incrementPointer("*DkgPart2Result")
+	defer _self.ffiObject.decrementPointer()
+	return FfiConverterDkgRound2SecretPackageINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_method_dkgpart2result_secret(
+			_pointer, _uniffiStatus)
+	}))
+}
+func (object *DkgPart2Result) Destroy() {
 	runtime.SetFinalizer(object, nil)
 	object.ffiObject.destroy()
 }
 
-type FfiConverterFrostRandomizedParams struct{}
+type FfiConverterDkgPart2Result struct{}
 
-var FfiConverterFrostRandomizedParamsINSTANCE = FfiConverterFrostRandomizedParams{}
+var FfiConverterDkgPart2ResultINSTANCE = FfiConverterDkgPart2Result{}
 
-func (c FfiConverterFrostRandomizedParams) Lift(pointer unsafe.Pointer) *FrostRandomizedParams {
-	result := &FrostRandomizedParams{
+func (c FfiConverterDkgPart2Result) Lift(pointer unsafe.Pointer) *DkgPart2Result {
+	result := &DkgPart2Result{
 		newFfiObject(
 			pointer,
 			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
-				return C.uniffi_frost_uniffi_sdk_fn_clone_frostrandomizedparams(pointer, status)
+				return C.uniffi_frost_uniffi_sdk_fn_clone_dkgpart2result(pointer, status)
 			},
 			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
-				C.uniffi_frost_uniffi_sdk_fn_free_frostrandomizedparams(pointer, status)
+				C.uniffi_frost_uniffi_sdk_fn_free_dkgpart2result(pointer, status)
 			},
 		),
 	}
-	runtime.SetFinalizer(result, (*FrostRandomizedParams).Destroy)
+	runtime.SetFinalizer(result, (*DkgPart2Result).Destroy)
 	return result
 }
 
-func (c FfiConverterFrostRandomizedParams) Read(reader io.Reader) *FrostRandomizedParams {
+func (c FfiConverterDkgPart2Result) Read(reader io.Reader) *DkgPart2Result {
 	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
 }
 
-func (c FfiConverterFrostRandomizedParams) Lower(value *FrostRandomizedParams) unsafe.Pointer {
+func (c FfiConverterDkgPart2Result) Lower(value *DkgPart2Result) unsafe.Pointer {
 	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
 	// because the pointer will be decremented immediately after this function returns,
 	// and someone will be left holding onto a non-locked pointer.
-	pointer := value.ffiObject.incrementPointer("*FrostRandomizedParams")
+	pointer := value.ffiObject.incrementPointer("*DkgPart2Result")
 	defer value.ffiObject.decrementPointer()
 	return pointer
 
 }
 
-func (c FfiConverterFrostRandomizedParams) Write(writer io.Writer, value *FrostRandomizedParams) {
+func (c FfiConverterDkgPart2Result) Write(writer io.Writer, value *DkgPart2Result) {
 	writeUint64(writer, uint64(uintptr(c.Lower(value))))
 }
 
-type FfiDestroyerFrostRandomizedParams struct{}
+type FfiDestroyerDkgPart2Result struct{}
 
-func (_ FfiDestroyerFrostRandomizedParams) Destroy(value *FrostRandomizedParams) {
+func (_ FfiDestroyerDkgPart2Result) Destroy(value *DkgPart2Result) {
 	value.Destroy()
 }
 
-// An Zcash Orchard Address and its associated network type.
-type OrchardAddressInterface interface {
-	// Returns the string-encoded form of this Orchard Address (A
-	// Unified Address containing only the orchard receiver.)
-	StringEncoded() string
+type DkgRound1SecretPackageInterface interface {
 }
-
-// An Zcash Orchard Address and its associated network type.
-type OrchardAddress struct {
+type DkgRound1SecretPackage struct {
 	ffiObject FfiObject
 }
 
-// Creates an [`OrchardAddress`] from its string-encoded form
-// If the string is invalid `Err(OrchardKeyError::DeserializationError)`
-// is returned in the Result.
+func (object *DkgRound1SecretPackage) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterDkgRound1SecretPackage struct{}
+
+var FfiConverterDkgRound1SecretPackageINSTANCE = FfiConverterDkgRound1SecretPackage{}
+
+func (c FfiConverterDkgRound1SecretPackage) Lift(pointer unsafe.Pointer) *DkgRound1SecretPackage {
+	result := &DkgRound1SecretPackage{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_frost_uniffi_sdk_fn_clone_dkground1secretpackage(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_frost_uniffi_sdk_fn_free_dkground1secretpackage(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*DkgRound1SecretPackage).Destroy)
+	return result
+}
+
+func (c FfiConverterDkgRound1SecretPackage) Read(reader io.Reader) *DkgRound1SecretPackage {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterDkgRound1SecretPackage) Lower(value *DkgRound1SecretPackage) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*DkgRound1SecretPackage")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterDkgRound1SecretPackage) Write(writer io.Writer, value *DkgRound1SecretPackage) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerDkgRound1SecretPackage struct{}
+
+func (_ FfiDestroyerDkgRound1SecretPackage) Destroy(value *DkgRound1SecretPackage) {
+	value.Destroy()
+}
+
+type DkgRound2SecretPackageInterface interface {
+}
+type DkgRound2SecretPackage struct {
+	ffiObject FfiObject
+}
+
+func (object *DkgRound2SecretPackage) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterDkgRound2SecretPackage struct{}
+
+var FfiConverterDkgRound2SecretPackageINSTANCE = FfiConverterDkgRound2SecretPackage{}
+
+func (c FfiConverterDkgRound2SecretPackage) Lift(pointer unsafe.Pointer) *DkgRound2SecretPackage {
+	result := &DkgRound2SecretPackage{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_frost_uniffi_sdk_fn_clone_dkground2secretpackage(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_frost_uniffi_sdk_fn_free_dkground2secretpackage(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*DkgRound2SecretPackage).Destroy)
+	return result
+}
+
+func (c FfiConverterDkgRound2SecretPackage) Read(reader io.Reader) *DkgRound2SecretPackage {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterDkgRound2SecretPackage) Lower(value *DkgRound2SecretPackage) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*DkgRound2SecretPackage")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterDkgRound2SecretPackage) Write(writer io.Writer, value *DkgRound2SecretPackage) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerDkgRound2SecretPackage struct{}
+
+func (_ FfiDestroyerDkgRound2SecretPackage) Destroy(value *DkgRound2SecretPackage) {
+	value.Destroy()
+}
+
+type FrostRandomizedParamsInterface interface {
+	// The randomized verifying key `rk` that the signatures created with
+	// these params are verified against.
+	RandomizedVerifyingKey() ([]byte, error)
+	// The randomized verifying key `rk`, hex-encoded like the verifying
+	// key of a [`FrostPublicKeyPackage`].
+	RandomizedVerifyingKeyHex() (string, error)
+	// The randomizer these params were created with.
+	Randomizer() (FrostRandomizer, error)
+}
+type FrostRandomizedParams struct {
+	ffiObject FfiObject
+}
+
+// The randomized verifying key `rk` that the signatures created with
+// these params are verified against.
+func (_self *FrostRandomizedParams) RandomizedVerifyingKey() ([]byte, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FrostRandomizedParams")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomized_verifying_key(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []byte
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// The randomized verifying key `rk`, hex-encoded like the verifying
+// key of a [`FrostPublicKeyPackage`].
+func (_self *FrostRandomizedParams) RandomizedVerifyingKeyHex() (string, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FrostRandomizedParams")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomized_verifying_key_hex(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// The randomizer these params were created with.
+func (_self *FrostRandomizedParams) Randomizer() (FrostRandomizer, error) {
+	_pointer := _self.ffiObject.incrementPointer("*FrostRandomizedParams")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_method_frostrandomizedparams_randomizer(
+				_pointer, _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostRandomizer
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+func (object *FrostRandomizedParams) Destroy() {
+	runtime.SetFinalizer(object, nil)
+	object.ffiObject.destroy()
+}
+
+type FfiConverterFrostRandomizedParams struct{}
+
+var FfiConverterFrostRandomizedParamsINSTANCE = FfiConverterFrostRandomizedParams{}
+
+func (c FfiConverterFrostRandomizedParams) Lift(pointer unsafe.Pointer) *FrostRandomizedParams {
+	result := &FrostRandomizedParams{
+		newFfiObject(
+			pointer,
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) unsafe.Pointer {
+				return C.uniffi_frost_uniffi_sdk_fn_clone_frostrandomizedparams(pointer, status)
+			},
+			func(pointer unsafe.Pointer, status *C.RustCallStatus) {
+				C.uniffi_frost_uniffi_sdk_fn_free_frostrandomizedparams(pointer, status)
+			},
+		),
+	}
+	runtime.SetFinalizer(result, (*FrostRandomizedParams).Destroy)
+	return result
+}
+
+func (c FfiConverterFrostRandomizedParams) Read(reader io.Reader) *FrostRandomizedParams {
+	return c.Lift(unsafe.Pointer(uintptr(readUint64(reader))))
+}
+
+func (c FfiConverterFrostRandomizedParams) Lower(value *FrostRandomizedParams) unsafe.Pointer {
+	// TODO: this is bad - all synchronization from ObjectRuntime.go is discarded here,
+	// because the pointer will be decremented immediately after this function returns,
+	// and someone will be left holding onto a non-locked pointer.
+	pointer := value.ffiObject.incrementPointer("*FrostRandomizedParams")
+	defer value.ffiObject.decrementPointer()
+	return pointer
+
+}
+
+func (c FfiConverterFrostRandomizedParams) Write(writer io.Writer, value *FrostRandomizedParams) {
+	writeUint64(writer, uint64(uintptr(c.Lower(value))))
+}
+
+type FfiDestroyerFrostRandomizedParams struct{}
+
+func (_ FfiDestroyerFrostRandomizedParams) Destroy(value *FrostRandomizedParams) {
+	value.Destroy()
+}
+
+// An Zcash Orchard Address and its associated network type.
+type OrchardAddressInterface interface {
+	// Returns the string-encoded form of this Orchard Address (A
+	// Unified Address containing only the orchard receiver.)
+	StringEncoded() string
+}
+
+// An Zcash Orchard Address and its associated network type.
+type OrchardAddress struct {
+	ffiObject FfiObject
+}
+
+// Creates an [`OrchardAddress`] from its string-encoded form
+// If the string is invalid `Err(OrchardKeyError::DeserializationError)`
+// is returned in the Result.
 func OrchardAddressNewFromString(string string) (*OrchardAddress, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_frost_uniffi_sdk_fn_constructor_orchardaddress_new_from_string(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus)
@@ -1582,6 +2225,12 @@ func (_ FfiDestroyerOrchardFullViewingKey) Destroy(value *OrchardFullViewingKey)
 //
 // - Note: See [FROST Book backup section](https://frost.zfnd.org/zcash/technical-details.html#backing-up-key-shares)
 type OrchardKeyPartsInterface interface {
+	// Returns the [`OrchardNullifierDerivingKey`] `nk` of these parts to
+	// back it up along with the key shares of the group.
+	Nk() (*OrchardNullifierDerivingKey, error)
+	// Returns the [`OrchardCommitIvkRandomness`] `rivk` of these parts to
+	// back it up along with the key shares of the group.
+	Rivk() (*OrchardCommitIvkRandomness, error)
 }
 
 // This responds to Backup and DKG requirements
@@ -1611,6 +2260,40 @@ func OrchardKeyPartsRandom(network ZcashNetwork) (*OrchardKeyParts, error) {
 	}
 }
 
+// Returns the [`OrchardNullifierDerivingKey`] `nk` of these parts to
+// back it up along with the key shares of the group.
+func (_self *OrchardKeyParts) Nk() (*OrchardNullifierDerivingKey, error) {
+	_pointer := _self.ffiObject.incrementPointer("*OrchardKeyParts")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_method_orchardkeyparts_nk(
+			_pointer, _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *OrchardNullifierDerivingKey
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterOrchardNullifierDerivingKeyINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Returns the [`OrchardCommitIvkRandomness`] `rivk` of these parts to
+// back it up along with the key shares of the group.
+func (_self *OrchardKeyParts) Rivk() (*OrchardCommitIvkRandomness, error) {
+	_pointer := _self.ffiObject.incrementPointer("*OrchardKeyParts")
+	defer _self.ffiObject.decrementPointer()
+	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_method_orchardkeyparts_rivk(
+			_pointer, _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *OrchardCommitIvkRandomness
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterOrchardCommitIvkRandomnessINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 func (object *OrchardKeyParts) Destroy() {
 	runtime.SetFinalizer(object, nil)
 	object.ffiObject.destroy()
@@ -1772,8 +2455,8 @@ type OrchardSpendValidatingKey struct {
 // Deserialized the [`OrchardSpendValidatingKey`] into bytes for
 // backup purposes.
 // - Note: See [ZF FROST Book - Technical Details](https://frost.zfnd.org/zcash/technical-details.html)
-// to serialize use the `OrchardSpendValidatingKey::to_bytes`
-// constructor
+//   to serialize use the `OrchardSpendValidatingKey::to_bytes`
+//   constructor
 func OrchardSpendValidatingKeyFromBytes(bytes []byte) (*OrchardSpendValidatingKey, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
 		return C.uniffi_frost_uniffi_sdk_fn_constructor_orchardspendvalidatingkey_from_bytes(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus)
@@ -1789,8 +2472,8 @@ func OrchardSpendValidatingKeyFromBytes(bytes []byte) (*OrchardSpendValidatingKe
 // Serialized the [`OrchardSpendValidatingKey`] into bytes for
 // backup purposes.
 // - Note: See [ZF FROST Book - Technical Details](https://frost.zfnd.org/zcash/technical-details.html)
-// to deserialize use the `OrchardSpendValidatingKey::from_bytes`
-// constructor
+//   to deserialize use the `OrchardSpendValidatingKey::from_bytes`
+//   constructor
 func (_self *OrchardSpendValidatingKey) ToBytes() []byte {
 	_pointer := _self.ffiObject.incrementPointer("*OrchardSpendValidatingKey")
 	defer _self.ffiObject.decrementPointer()
@@ -2506,37 +3189,125 @@ func (_ FfiDestroyerParticipantList) Destroy(value ParticipantList) {
 	value.Destroy()
 }
 
-type TrustedKeyGeneration struct {
-	SecretShares     map[ParticipantIdentifier]FrostSecretKeyShare
-	PublicKeyPackage FrostPublicKeyPackage
+type PcztOrchardAction struct {
+	Index        uint32
+	Rk           []byte
+	Alpha        *[]byte
+	SpendAuthSig *[]byte
 }
 
-func (r *TrustedKeyGeneration) Destroy() {
-	FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare{}.Destroy(r.SecretShares)
-	FfiDestroyerFrostPublicKeyPackage{}.Destroy(r.PublicKeyPackage)
+func (r *PcztOrchardAction) Destroy() {
+	FfiDestroyerUint32{}.Destroy(r.Index)
+	FfiDestroyerBytes{}.Destroy(r.Rk)
+	FfiDestroyerOptionalBytes{}.Destroy(r.Alpha)
+	FfiDestroyerOptionalBytes{}.Destroy(r.SpendAuthSig)
 }
 
-type FfiConverterTrustedKeyGeneration struct{}
+type FfiConverterPcztOrchardAction struct{}
 
-var FfiConverterTrustedKeyGenerationINSTANCE = FfiConverterTrustedKeyGeneration{}
+var FfiConverterPcztOrchardActionINSTANCE = FfiConverterPcztOrchardAction{}
 
-func (c FfiConverterTrustedKeyGeneration) Lift(rb RustBufferI) TrustedKeyGeneration {
-	return LiftFromRustBuffer[TrustedKeyGeneration](c, rb)
+func (c FfiConverterPcztOrchardAction) Lift(rb RustBufferI) PcztOrchardAction {
+	return LiftFromRustBuffer[PcztOrchardAction](c, rb)
 }
 
-func (c FfiConverterTrustedKeyGeneration) Read(reader io.Reader) TrustedKeyGeneration {
-	return TrustedKeyGeneration{
-		FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Read(reader),
-		FfiConverterFrostPublicKeyPackageINSTANCE.Read(reader),
+func (c FfiConverterPcztOrchardAction) Read(reader io.Reader) PcztOrchardAction {
+	return PcztOrchardAction{
+		FfiConverterUint32INSTANCE.Read(reader),
+		FfiConverterBytesINSTANCE.Read(reader),
+		FfiConverterOptionalBytesINSTANCE.Read(reader),
+		FfiConverterOptionalBytesINSTANCE.Read(reader),
 	}
 }
 
-func (c FfiConverterTrustedKeyGeneration) Lower(value TrustedKeyGeneration) C.RustBuffer {
-	return LowerIntoRustBuffer[TrustedKeyGeneration](c, value)
+func (c FfiConverterPcztOrchardAction) Lower(value PcztOrchardAction) C.RustBuffer {
+	return LowerIntoRustBuffer[PcztOrchardAction](c, value)
 }
 
-func (c FfiConverterTrustedKeyGeneration) Write(writer io.Writer, value TrustedKeyGeneration) {
-	FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Write(writer, value.SecretShares)
+func (c FfiConverterPcztOrchardAction) Write(writer io.Writer, value PcztOrchardAction) {
+	FfiConverterUint32INSTANCE.Write(writer, value.Index)
+	FfiConverterBytesINSTANCE.Write(writer, value.Rk)
+	FfiConverterOptionalBytesINSTANCE.Write(writer, value.Alpha)
+	FfiConverterOptionalBytesINSTANCE.Write(writer, value.SpendAuthSig)
+}
+
+type FfiDestroyerPcztOrchardAction struct{}
+
+func (_ FfiDestroyerPcztOrchardAction) Destroy(value PcztOrchardAction) {
+	value.Destroy()
+}
+
+type PcztOrchardSpendAuth struct {
+	Sighash []byte
+	Actions []PcztOrchardAction
+}
+
+func (r *PcztOrchardSpendAuth) Destroy() {
+	FfiDestroyerBytes{}.Destroy(r.Sighash)
+	FfiDestroyerSequencePcztOrchardAction{}.Destroy(r.Actions)
+}
+
+type FfiConverterPcztOrchardSpendAuth struct{}
+
+var FfiConverterPcztOrchardSpendAuthINSTANCE = FfiConverterPcztOrchardSpendAuth{}
+
+func (c FfiConverterPcztOrchardSpendAuth) Lift(rb RustBufferI) PcztOrchardSpendAuth {
+	return LiftFromRustBuffer[PcztOrchardSpendAuth](c, rb)
+}
+
+func (c FfiConverterPcztOrchardSpendAuth) Read(reader io.Reader) PcztOrchardSpendAuth {
+	return PcztOrchardSpendAuth{
+		FfiConverterBytesINSTANCE.Read(reader),
+		FfiConverterSequencePcztOrchardActionINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterPcztOrchardSpendAuth) Lower(value PcztOrchardSpendAuth) C.RustBuffer {
+	return LowerIntoRustBuffer[PcztOrchardSpendAuth](c, value)
+}
+
+func (c FfiConverterPcztOrchardSpendAuth) Write(writer io.Writer, value PcztOrchardSpendAuth) {
+	FfiConverterBytesINSTANCE.Write(writer, value.Sighash)
+	FfiConverterSequencePcztOrchardActionINSTANCE.Write(writer, value.Actions)
+}
+
+type FfiDestroyerPcztOrchardSpendAuth struct{}
+
+func (_ FfiDestroyerPcztOrchardSpendAuth) Destroy(value PcztOrchardSpendAuth) {
+	value.Destroy()
+}
+
+type TrustedKeyGeneration struct {
+	SecretShares     map[ParticipantIdentifier]FrostSecretKeyShare
+	PublicKeyPackage FrostPublicKeyPackage
+}
+
+func (r *TrustedKeyGeneration) Destroy() {
+	FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare{}.Destroy(r.SecretShares)
+	FfiDestroyerFrostPublicKeyPackage{}.Destroy(r.PublicKeyPackage)
+}
+
+type FfiConverterTrustedKeyGeneration struct{}
+
+var FfiConverterTrustedKeyGenerationINSTANCE = FfiConverterTrustedKeyGeneration{}
+
+func (c FfiConverterTrustedKeyGeneration) Lift(rb RustBufferI) TrustedKeyGeneration {
+	return LiftFromRustBuffer[TrustedKeyGeneration](c, rb)
+}
+
+func (c FfiConverterTrustedKeyGeneration) Read(reader io.Reader) TrustedKeyGeneration {
+	return TrustedKeyGeneration{
+		FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Read(reader),
+		FfiConverterFrostPublicKeyPackageINSTANCE.Read(reader),
+	}
+}
+
+func (c FfiConverterTrustedKeyGeneration) Lower(value TrustedKeyGeneration) C.RustBuffer {
+	return LowerIntoRustBuffer[TrustedKeyGeneration](c, value)
+}
+
+func (c FfiConverterTrustedKeyGeneration) Write(writer io.Writer, value TrustedKeyGeneration) {
+	FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Write(writer, value.SecretShares)
 	FfiConverterFrostPublicKeyPackageINSTANCE.Write(writer, value.PublicKeyPackage)
 }
 
@@ -2741,6 +3512,8 @@ var ErrCoordinationErrorSignatureShareDeserializationError = fmt.Errorf("Coordin
 var ErrCoordinationErrorPublicKeyPackageDeserializationError = fmt.Errorf("CoordinationErrorPublicKeyPackageDeserializationError")
 var ErrCoordinationErrorSignatureShareAggregationFailed = fmt.Errorf("CoordinationErrorSignatureShareAggregationFailed")
 var ErrCoordinationErrorInvalidRandomizer = fmt.Errorf("CoordinationErrorInvalidRandomizer")
+var ErrCoordinationErrorInvalidSignatureShare = fmt.Errorf("CoordinationErrorInvalidSignatureShare")
+var ErrCoordinationErrorUnknownParticipant = fmt.Errorf("CoordinationErrorUnknownParticipant")
 
 // Variant structs
 type CoordinationErrorFailedToCreateSigningPackage struct {
@@ -2897,6 +3670,64 @@ func (self CoordinationErrorInvalidRandomizer) Is(target error) bool {
 	return target == ErrCoordinationErrorInvalidRandomizer
 }
 
+// A signature share failed validation during aggregation.
+type CoordinationErrorInvalidSignatureShare struct {
+	Culprit ParticipantIdentifier
+}
+
+func NewCoordinationErrorInvalidSignatureShare(
+	culprit ParticipantIdentifier,
+) *CoordinationError {
+	return &CoordinationError{err: &CoordinationErrorInvalidSignatureShare{
+		Culprit: culprit}}
+}
+
+func (e CoordinationErrorInvalidSignatureShare) destroy() {
+	FfiDestroyerParticipantIdentifier{}.Destroy(e.Culprit)
+}
+
+func (err CoordinationErrorInvalidSignatureShare) Error() string {
+	return fmt.Sprint("InvalidSignatureShare",
+		": ",
+
+		"Culprit=",
+		err.Culprit,
+	)
+}
+
+func (self CoordinationErrorInvalidSignatureShare) Is(target error) bool {
+	return target == ErrCoordinationErrorInvalidSignatureShare
+}
+
+// The signer has no verifying share in the public key package.
+type CoordinationErrorUnknownParticipant struct {
+	Identifier ParticipantIdentifier
+}
+
+func NewCoordinationErrorUnknownParticipant(
+	identifier ParticipantIdentifier,
+) *CoordinationError {
+	return &CoordinationError{err: &CoordinationErrorUnknownParticipant{
+		Identifier: identifier}}
+}
+
+func (e CoordinationErrorUnknownParticipant) destroy() {
+	FfiDestroyerParticipantIdentifier{}.Destroy(e.Identifier)
+}
+
+func (err CoordinationErrorUnknownParticipant) Error() string {
+	return fmt.Sprint("UnknownParticipant",
+		": ",
+
+		"Identifier=",
+		err.Identifier,
+	)
+}
+
+func (self CoordinationErrorUnknownParticipant) Is(target error) bool {
+	return target == ErrCoordinationErrorUnknownParticipant
+}
+
 type FfiConverterCoordinationError struct{}
 
 var FfiConverterCoordinationErrorINSTANCE = FfiConverterCoordinationError{}
@@ -2931,6 +3762,14 @@ func (c FfiConverterCoordinationError) Read(reader io.Reader) *CoordinationError
 		}}
 	case 8:
 		return &CoordinationError{&CoordinationErrorInvalidRandomizer{}}
+	case 9:
+		return &CoordinationError{&CoordinationErrorInvalidSignatureShare{
+			Culprit: FfiConverterParticipantIdentifierINSTANCE.Read(reader),
+		}}
+	case 10:
+		return &CoordinationError{&CoordinationErrorUnknownParticipant{
+			Identifier: FfiConverterParticipantIdentifierINSTANCE.Read(reader),
+		}}
 	default:
 		panic(fmt.Sprintf("Unknown error code %d in FfiConverterCoordinationError.Read()", errorID))
 	}
@@ -2955,6 +3794,12 @@ func (c FfiConverterCoordinationError) Write(writer io.Writer, value *Coordinati
 		FfiConverterStringINSTANCE.Write(writer, variantValue.Message)
 	case *CoordinationErrorInvalidRandomizer:
 		writeInt32(writer, 8)
+	case *CoordinationErrorInvalidSignatureShare:
+		writeInt32(writer, 9)
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, variantValue.Culprit)
+	case *CoordinationErrorUnknownParticipant:
+		writeInt32(writer, 10)
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, variantValue.Identifier)
 	default:
 		_ = variantValue
 		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterCoordinationError.Write", value))
@@ -2981,6 +3826,10 @@ func (_ FfiDestroyerCoordinationError) Destroy(value *CoordinationError) {
 		variantValue.destroy()
 	case CoordinationErrorInvalidRandomizer:
 		variantValue.destroy()
+	case CoordinationErrorInvalidSignatureShare:
+		variantValue.destroy()
+	case CoordinationErrorUnknownParticipant:
+		variantValue.destroy()
 	default:
 		_ = variantValue
 		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerCoordinationError.Destroy", value))
@@ -4137,25 +4986,367 @@ func (_ FfiDestroyerFrostError) Destroy(value *FrostError) {
 		variantValue.destroy()
 	case FrostErrorInvalidKeyPackage:
 		variantValue.destroy()
-	case FrostErrorInvalidSecretKey:
+	case FrostErrorInvalidSecretKey:
+		variantValue.destroy()
+	case FrostErrorInvalidConfiguration:
+		variantValue.destroy()
+	case FrostErrorUnexpectedError:
+		variantValue.destroy()
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFrostError.Destroy", value))
+	}
+}
+
+type FrostSignatureVerificationError struct {
+	err error
+}
+
+// Convience method to turn *FrostSignatureVerificationError into error
+// Avoiding treating nil pointer as non nil error interface
+func (err *FrostSignatureVerificationError) AsError() error {
+	if err == nil {
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (err FrostSignatureVerificationError) Error() string {
+	return fmt.Sprintf("FrostSignatureVerificationError: %s", err.err.Error())
+}
+
+func (err FrostSignatureVerificationError) Unwrap() error {
+	return err.err
+}
+
+// Err* are used for checking error type with `errors.Is`
+var ErrFrostSignatureVerificationErrorInvalidPublicKeyPackage = fmt.Errorf("FrostSignatureVerificationErrorInvalidPublicKeyPackage")
+var ErrFrostSignatureVerificationErrorValidationFailed = fmt.Errorf("FrostSignatureVerificationErrorValidationFailed")
+var ErrFrostSignatureVerificationErrorInvalidVerifyingKey = fmt.Errorf("FrostSignatureVerificationErrorInvalidVerifyingKey")
+
+// Variant structs
+type FrostSignatureVerificationErrorInvalidPublicKeyPackage struct {
+}
+
+func NewFrostSignatureVerificationErrorInvalidPublicKeyPackage() *FrostSignatureVerificationError {
+	return &FrostSignatureVerificationError{err: &FrostSignatureVerificationErrorInvalidPublicKeyPackage{}}
+}
+
+func (e FrostSignatureVerificationErrorInvalidPublicKeyPackage) destroy() {
+}
+
+func (err FrostSignatureVerificationErrorInvalidPublicKeyPackage) Error() string {
+	return fmt.Sprint("InvalidPublicKeyPackage")
+}
+
+func (self FrostSignatureVerificationErrorInvalidPublicKeyPackage) Is(target error) bool {
+	return target == ErrFrostSignatureVerificationErrorInvalidPublicKeyPackage
+}
+
+type FrostSignatureVerificationErrorValidationFailed struct {
+	Reason string
+}
+
+func NewFrostSignatureVerificationErrorValidationFailed(
+	reason string,
+) *FrostSignatureVerificationError {
+	return &FrostSignatureVerificationError{err: &FrostSignatureVerificationErrorValidationFailed{
+		Reason: reason}}
+}
+
+func (e FrostSignatureVerificationErrorValidationFailed) destroy() {
+	FfiDestroyerString{}.Destroy(e.Reason)
+}
+
+func (err FrostSignatureVerificationErrorValidationFailed) Error() string {
+	return fmt.Sprint("ValidationFailed",
+		": ",
+
+		"Reason=",
+		err.Reason,
+	)
+}
+
+func (self FrostSignatureVerificationErrorValidationFailed) Is(target error) bool {
+	return target == ErrFrostSignatureVerificationErrorValidationFailed
+}
+
+type FrostSignatureVerificationErrorInvalidVerifyingKey struct {
+}
+
+func NewFrostSignatureVerificationErrorInvalidVerifyingKey() *FrostSignatureVerificationError {
+	return &FrostSignatureVerificationError{err: &FrostSignatureVerificationErrorInvalidVerifyingKey{}}
+}
+
+func (e FrostSignatureVerificationErrorInvalidVerifyingKey) destroy() {
+}
+
+func (err FrostSignatureVerificationErrorInvalidVerifyingKey) Error() string {
+	return fmt.Sprint("InvalidVerifyingKey")
+}
+
+func (self FrostSignatureVerificationErrorInvalidVerifyingKey) Is(target error) bool {
+	return target == ErrFrostSignatureVerificationErrorInvalidVerifyingKey
+}
+
+type FfiConverterFrostSignatureVerificationError struct{}
+
+var FfiConverterFrostSignatureVerificationErrorINSTANCE = FfiConverterFrostSignatureVerificationError{}
+
+func (c FfiConverterFrostSignatureVerificationError) Lift(eb RustBufferI) *FrostSignatureVerificationError {
+	return LiftFromRustBuffer[*FrostSignatureVerificationError](c, eb)
+}
+
+func (c FfiConverterFrostSignatureVerificationError) Lower(value *FrostSignatureVerificationError) C.RustBuffer {
+	return LowerIntoRustBuffer[*FrostSignatureVerificationError](c, value)
+}
+
+func (c FfiConverterFrostSignatureVerificationError) Read(reader io.Reader) *FrostSignatureVerificationError {
+	errorID := readUint32(reader)
+
+	switch errorID {
+	case 1:
+		return &FrostSignatureVerificationError{&FrostSignatureVerificationErrorInvalidPublicKeyPackage{}}
+	case 2:
+		return &FrostSignatureVerificationError{&FrostSignatureVerificationErrorValidationFailed{
+			Reason: FfiConverterStringINSTANCE.Read(reader),
+		}}
+	case 3:
+		return &FrostSignatureVerificationError{&FrostSignatureVerificationErrorInvalidVerifyingKey{}}
+	default:
+		panic(fmt.Sprintf("Unknown error code %d in FfiConverterFrostSignatureVerificationError.Read()", errorID))
+	}
+}
+
+func (c FfiConverterFrostSignatureVerificationError) Write(writer io.Writer, value *FrostSignatureVerificationError) {
+	switch variantValue := value.err.(type) {
+	case *FrostSignatureVerificationErrorInvalidPublicKeyPackage:
+		writeInt32(writer, 1)
+	case *FrostSignatureVerificationErrorValidationFailed:
+		writeInt32(writer, 2)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Reason)
+	case *FrostSignatureVerificationErrorInvalidVerifyingKey:
+		writeInt32(writer, 3)
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterFrostSignatureVerificationError.Write", value))
+	}
+}
+
+type FfiDestroyerFrostSignatureVerificationError struct{}
+
+func (_ FfiDestroyerFrostSignatureVerificationError) Destroy(value *FrostSignatureVerificationError) {
+	switch variantValue := value.err.(type) {
+	case FrostSignatureVerificationErrorInvalidPublicKeyPackage:
+		variantValue.destroy()
+	case FrostSignatureVerificationErrorValidationFailed:
+		variantValue.destroy()
+	case FrostSignatureVerificationErrorInvalidVerifyingKey:
+		variantValue.destroy()
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFrostSignatureVerificationError.Destroy", value))
+	}
+}
+
+type OrchardKeyError struct {
+	err error
+}
+
+// Convience method to turn *OrchardKeyError into error
+// Avoiding treating nil pointer as non nil error interface
+func (err *OrchardKeyError) AsError() error {
+	if err == nil {
+		return nil
+	} else {
+		return err
+	}
+}
+
+func (err OrchardKeyError) Error() string {
+	return fmt.Sprintf("OrchardKeyError: %s", err.err.Error())
+}
+
+func (err OrchardKeyError) Unwrap() error {
+	return err.err
+}
+
+// Err* are used for checking error type with `errors.Is`
+var ErrOrchardKeyErrorKeyDerivationError = fmt.Errorf("OrchardKeyErrorKeyDerivationError")
+var ErrOrchardKeyErrorSerializationError = fmt.Errorf("OrchardKeyErrorSerializationError")
+var ErrOrchardKeyErrorDeserializationError = fmt.Errorf("OrchardKeyErrorDeserializationError")
+var ErrOrchardKeyErrorOtherError = fmt.Errorf("OrchardKeyErrorOtherError")
+
+// Variant structs
+type OrchardKeyErrorKeyDerivationError struct {
+	Message string
+}
+
+func NewOrchardKeyErrorKeyDerivationError(
+	message string,
+) *OrchardKeyError {
+	return &OrchardKeyError{err: &OrchardKeyErrorKeyDerivationError{
+		Message: message}}
+}
+
+func (e OrchardKeyErrorKeyDerivationError) destroy() {
+	FfiDestroyerString{}.Destroy(e.Message)
+}
+
+func (err OrchardKeyErrorKeyDerivationError) Error() string {
+	return fmt.Sprint("KeyDerivationError",
+		": ",
+
+		"Message=",
+		err.Message,
+	)
+}
+
+func (self OrchardKeyErrorKeyDerivationError) Is(target error) bool {
+	return target == ErrOrchardKeyErrorKeyDerivationError
+}
+
+type OrchardKeyErrorSerializationError struct {
+}
+
+func NewOrchardKeyErrorSerializationError() *OrchardKeyError {
+	return &OrchardKeyError{err: &OrchardKeyErrorSerializationError{}}
+}
+
+func (e OrchardKeyErrorSerializationError) destroy() {
+}
+
+func (err OrchardKeyErrorSerializationError) Error() string {
+	return fmt.Sprint("SerializationError")
+}
+
+func (self OrchardKeyErrorSerializationError) Is(target error) bool {
+	return target == ErrOrchardKeyErrorSerializationError
+}
+
+type OrchardKeyErrorDeserializationError struct {
+}
+
+func NewOrchardKeyErrorDeserializationError() *OrchardKeyError {
+	return &OrchardKeyError{err: &OrchardKeyErrorDeserializationError{}}
+}
+
+func (e OrchardKeyErrorDeserializationError) destroy() {
+}
+
+func (err OrchardKeyErrorDeserializationError) Error() string {
+	return fmt.Sprint("DeserializationError")
+}
+
+func (self OrchardKeyErrorDeserializationError) Is(target error) bool {
+	return target == ErrOrchardKeyErrorDeserializationError
+}
+
+type OrchardKeyErrorOtherError struct {
+	ErrorMessage string
+}
+
+func NewOrchardKeyErrorOtherError(
+	errorMessage string,
+) *OrchardKeyError {
+	return &OrchardKeyError{err: &OrchardKeyErrorOtherError{
+		ErrorMessage: errorMessage}}
+}
+
+func (e OrchardKeyErrorOtherError) destroy() {
+	FfiDestroyerString{}.Destroy(e.ErrorMessage)
+}
+
+func (err OrchardKeyErrorOtherError) Error() string {
+	return fmt.Sprint("OtherError",
+		": ",
+
+		"ErrorMessage=",
+		err.ErrorMessage,
+	)
+}
+
+func (self OrchardKeyErrorOtherError) Is(target error) bool {
+	return target == ErrOrchardKeyErrorOtherError
+}
+
+type FfiConverterOrchardKeyError struct{}
+
+var FfiConverterOrchardKeyErrorINSTANCE = FfiConverterOrchardKeyError{}
+
+func (c FfiConverterOrchardKeyError) Lift(eb RustBufferI) *OrchardKeyError {
+	return LiftFromRustBuffer[*OrchardKeyError](c, eb)
+}
+
+func (c FfiConverterOrchardKeyError) Lower(value *OrchardKeyError) C.RustBuffer {
+	return LowerIntoRustBuffer[*OrchardKeyError](c, value)
+}
+
+func (c FfiConverterOrchardKeyError) Read(reader io.Reader) *OrchardKeyError {
+	errorID := readUint32(reader)
+
+	switch errorID {
+	case 1:
+		return &OrchardKeyError{&OrchardKeyErrorKeyDerivationError{
+			Message: FfiConverterStringINSTANCE.Read(reader),
+		}}
+	case 2:
+		return &OrchardKeyError{&OrchardKeyErrorSerializationError{}}
+	case 3:
+		return &OrchardKeyError{&OrchardKeyErrorDeserializationError{}}
+	case 4:
+		return &OrchardKeyError{&OrchardKeyErrorOtherError{
+			ErrorMessage: FfiConverterStringINSTANCE.Read(reader),
+		}}
+	default:
+		panic(fmt.Sprintf("Unknown error code %d in FfiConverterOrchardKeyError.Read()", errorID))
+	}
+}
+
+func (c FfiConverterOrchardKeyError) Write(writer io.Writer, value *OrchardKeyError) {
+	switch variantValue := value.err.(type) {
+	case *OrchardKeyErrorKeyDerivationError:
+		writeInt32(writer, 1)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Message)
+	case *OrchardKeyErrorSerializationError:
+		writeInt32(writer, 2)
+	case *OrchardKeyErrorDeserializationError:
+		writeInt32(writer, 3)
+	case *OrchardKeyErrorOtherError:
+		writeInt32(writer, 4)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.ErrorMessage)
+	default:
+		_ = variantValue
+		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterOrchardKeyError.Write", value))
+	}
+}
+
+type FfiDestroyerOrchardKeyError struct{}
+
+func (_ FfiDestroyerOrchardKeyError) Destroy(value *OrchardKeyError) {
+	switch variantValue := value.err.(type) {
+	case OrchardKeyErrorKeyDerivationError:
+		variantValue.destroy()
+	case OrchardKeyErrorSerializationError:
 		variantValue.destroy()
-	case FrostErrorInvalidConfiguration:
+	case OrchardKeyErrorDeserializationError:
 		variantValue.destroy()
-	case FrostErrorUnexpectedError:
+	case OrchardKeyErrorOtherError:
 		variantValue.destroy()
 	default:
 		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFrostError.Destroy", value))
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerOrchardKeyError.Destroy", value))
 	}
 }
 
-type FrostSignatureVerificationError struct {
+type Round1Error struct {
 	err error
 }
 
-// Convience method to turn *FrostSignatureVerificationError into error
+// Convience method to turn *Round1Error into error
 // Avoiding treating nil pointer as non nil error interface
-func (err *FrostSignatureVerificationError) AsError() error {
+func (err *Round1Error) AsError() error {
 	if err == nil {
 		return nil
 	} else {
@@ -4163,126 +5354,138 @@ func (err *FrostSignatureVerificationError) AsError() error {
 	}
 }
 
-func (err FrostSignatureVerificationError) Error() string {
-	return fmt.Sprintf("FrostSignatureVerificationError: %s", err.err.Error())
+func (err Round1Error) Error() string {
+	return fmt.Sprintf("Round1Error: %s", err.err.Error())
 }
 
-func (err FrostSignatureVerificationError) Unwrap() error {
+func (err Round1Error) Unwrap() error {
 	return err.err
 }
 
 // Err* are used for checking error type with `errors.Is`
-var ErrFrostSignatureVerificationErrorInvalidPublicKeyPackage = fmt.Errorf("FrostSignatureVerificationErrorInvalidPublicKeyPackage")
-var ErrFrostSignatureVerificationErrorValidationFailed = fmt.Errorf("FrostSignatureVerificationErrorValidationFailed")
+var ErrRound1ErrorInvalidKeyPackage = fmt.Errorf("Round1ErrorInvalidKeyPackage")
+var ErrRound1ErrorNonceSerializationError = fmt.Errorf("Round1ErrorNonceSerializationError")
+var ErrRound1ErrorCommitmentSerializationError = fmt.Errorf("Round1ErrorCommitmentSerializationError")
 
 // Variant structs
-type FrostSignatureVerificationErrorInvalidPublicKeyPackage struct {
+type Round1ErrorInvalidKeyPackage struct {
 }
 
-func NewFrostSignatureVerificationErrorInvalidPublicKeyPackage() *FrostSignatureVerificationError {
-	return &FrostSignatureVerificationError{err: &FrostSignatureVerificationErrorInvalidPublicKeyPackage{}}
+func NewRound1ErrorInvalidKeyPackage() *Round1Error {
+	return &Round1Error{err: &Round1ErrorInvalidKeyPackage{}}
 }
 
-func (e FrostSignatureVerificationErrorInvalidPublicKeyPackage) destroy() {
+func (e Round1ErrorInvalidKeyPackage) destroy() {
 }
 
-func (err FrostSignatureVerificationErrorInvalidPublicKeyPackage) Error() string {
-	return fmt.Sprint("InvalidPublicKeyPackage")
+func (err Round1ErrorInvalidKeyPackage) Error() string {
+	return fmt.Sprint("InvalidKeyPackage")
 }
 
-func (self FrostSignatureVerificationErrorInvalidPublicKeyPackage) Is(target error) bool {
-	return target == ErrFrostSignatureVerificationErrorInvalidPublicKeyPackage
+func (self Round1ErrorInvalidKeyPackage) Is(target error) bool {
+	return target == ErrRound1ErrorInvalidKeyPackage
 }
 
-type FrostSignatureVerificationErrorValidationFailed struct {
-	Reason string
+type Round1ErrorNonceSerializationError struct {
 }
 
-func NewFrostSignatureVerificationErrorValidationFailed(
-	reason string,
-) *FrostSignatureVerificationError {
-	return &FrostSignatureVerificationError{err: &FrostSignatureVerificationErrorValidationFailed{
-		Reason: reason}}
+func NewRound1ErrorNonceSerializationError() *Round1Error {
+	return &Round1Error{err: &Round1ErrorNonceSerializationError{}}
 }
 
-func (e FrostSignatureVerificationErrorValidationFailed) destroy() {
-	FfiDestroyerString{}.Destroy(e.Reason)
+func (e Round1ErrorNonceSerializationError) destroy() {
 }
 
-func (err FrostSignatureVerificationErrorValidationFailed) Error() string {
-	return fmt.Sprint("ValidationFailed",
-		": ",
+func (err Round1ErrorNonceSerializationError) Error() string {
+	return fmt.Sprint("NonceSerializationError")
+}
 
-		"Reason=",
-		err.Reason,
-	)
+func (self Round1ErrorNonceSerializationError) Is(target error) bool {
+	return target == ErrRound1ErrorNonceSerializationError
 }
 
-func (self FrostSignatureVerificationErrorValidationFailed) Is(target error) bool {
-	return target == ErrFrostSignatureVerificationErrorValidationFailed
+type Round1ErrorCommitmentSerializationError struct {
 }
 
-type FfiConverterFrostSignatureVerificationError struct{}
+func NewRound1ErrorCommitmentSerializationError() *Round1Error {
+	return &Round1Error{err: &Round1ErrorCommitmentSerializationError{}}
+}
 
-var FfiConverterFrostSignatureVerificationErrorINSTANCE = FfiConverterFrostSignatureVerificationError{}
+func (e Round1ErrorCommitmentSerializationError) destroy() {
+}
 
-func (c FfiConverterFrostSignatureVerificationError) Lift(eb RustBufferI) *FrostSignatureVerificationError {
-	return LiftFromRustBuffer[*FrostSignatureVerificationError](c, eb)
+func (err Round1ErrorCommitmentSerializationError) Error() string {
+	return fmt.Sprint("CommitmentSerializationError")
 }
 
-func (c FfiConverterFrostSignatureVerificationError) Lower(value *FrostSignatureVerificationError) C.RustBuffer {
-	return LowerIntoRustBuffer[*FrostSignatureVerificationError](c, value)
+func (self Round1ErrorCommitmentSerializationError) Is(target error) bool {
+	return target == ErrRound1ErrorCommitmentSerializationError
 }
 
-func (c FfiConverterFrostSignatureVerificationError) Read(reader io.Reader) *FrostSignatureVerificationError {
+type FfiConverterRound1Error struct{}
+
+var FfiConverterRound1ErrorINSTANCE = FfiConverterRound1Error{}
+
+func (c FfiConverterRound1Error) Lift(eb RustBufferI) *Round1Error {
+	return LiftFromRustBuffer[*Round1Error](c, eb)
+}
+
+func (c FfiConverterRound1Error) Lower(value *Round1Error) C.RustBuffer {
+	return LowerIntoRustBuffer[*Round1Error](c, value)
+}
+
+func (c FfiConverterRound1Error) Read(reader io.Reader) *Round1Error {
 	errorID := readUint32(reader)
 
 	switch errorID {
 	case 1:
-		return &FrostSignatureVerificationError{&FrostSignatureVerificationErrorInvalidPublicKeyPackage{}}
+		return &Round1Error{&Round1ErrorInvalidKeyPackage{}}
 	case 2:
-		return &FrostSignatureVerificationError{&FrostSignatureVerificationErrorValidationFailed{
-			Reason: FfiConverterStringINSTANCE.Read(reader),
-		}}
+		return &Round1Error{&Round1ErrorNonceSerializationError{}}
+	case 3:
+		return &Round1Error{&Round1ErrorCommitmentSerializationError{}}
 	default:
-		panic(fmt.Sprintf("Unknown error code %d in FfiConverterFrostSignatureVerificationError.Read()", errorID))
+		panic(fmt.Sprintf("Unknown error code %d in FfiConverterRound1Error.Read()", errorID))
 	}
 }
 
-func (c FfiConverterFrostSignatureVerificationError) Write(writer io.Writer, value *FrostSignatureVerificationError) {
+func (c FfiConverterRound1Error) Write(writer io.Writer, value *Round1Error) {
 	switch variantValue := value.err.(type) {
-	case *FrostSignatureVerificationErrorInvalidPublicKeyPackage:
+	case *Round1ErrorInvalidKeyPackage:
 		writeInt32(writer, 1)
-	case *FrostSignatureVerificationErrorValidationFailed:
+	case *Round1ErrorNonceSerializationError:
 		writeInt32(writer, 2)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Reason)
+	case *Round1ErrorCommitmentSerializationError:
+		writeInt32(writer, 3)
 	default:
 		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterFrostSignatureVerificationError.Write", value))
+		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterRound1Error.Write", value))
 	}
 }
 
-type FfiDestroyerFrostSignatureVerificationError struct{}
+type FfiDestroyerRound1Error struct{}
 
-func (_ FfiDestroyerFrostSignatureVerificationError) Destroy(value *FrostSignatureVerificationError) {
+func (_ FfiDestroyerRound1Error) Destroy(value *Round1Error) {
 	switch variantValue := value.err.(type) {
-	case FrostSignatureVerificationErrorInvalidPublicKeyPackage:
+	case Round1ErrorInvalidKeyPackage:
 		variantValue.destroy()
-	case FrostSignatureVerificationErrorValidationFailed:
+	case Round1ErrorNonceSerializationError:
+		variantValue.destroy()
+	case Round1ErrorCommitmentSerializationError:
 		variantValue.destroy()
 	default:
 		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerFrostSignatureVerificationError.Destroy", value))
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerRound1Error.Destroy", value))
 	}
 }
 
-type OrchardKeyError struct {
+type Round2Error struct {
 	err error
 }
 
-// Convience method to turn *OrchardKeyError into error
+// Convience method to turn *Round2Error into error
 // Avoiding treating nil pointer as non nil error interface
-func (err *OrchardKeyError) AsError() error {
+func (err *Round2Error) AsError() error {
 	if err == nil {
 		return nil
 	} else {
@@ -4290,944 +5493,1325 @@ func (err *OrchardKeyError) AsError() error {
 	}
 }
 
-func (err OrchardKeyError) Error() string {
-	return fmt.Sprintf("OrchardKeyError: %s", err.err.Error())
+func (err Round2Error) Error() string {
+	return fmt.Sprintf("Round2Error: %s", err.err.Error())
 }
 
-func (err OrchardKeyError) Unwrap() error {
+func (err Round2Error) Unwrap() error {
 	return err.err
 }
 
 // Err* are used for checking error type with `errors.Is`
-var ErrOrchardKeyErrorKeyDerivationError = fmt.Errorf("OrchardKeyErrorKeyDerivationError")
-var ErrOrchardKeyErrorSerializationError = fmt.Errorf("OrchardKeyErrorSerializationError")
-var ErrOrchardKeyErrorDeserializationError = fmt.Errorf("OrchardKeyErrorDeserializationError")
-var ErrOrchardKeyErrorOtherError = fmt.Errorf("OrchardKeyErrorOtherError")
+var ErrRound2ErrorInvalidKeyPackage = fmt.Errorf("Round2ErrorInvalidKeyPackage")
+var ErrRound2ErrorNonceSerializationError = fmt.Errorf("Round2ErrorNonceSerializationError")
+var ErrRound2ErrorCommitmentSerializationError = fmt.Errorf("Round2ErrorCommitmentSerializationError")
+var ErrRound2ErrorSigningPackageDeserializationError = fmt.Errorf("Round2ErrorSigningPackageDeserializationError")
+var ErrRound2ErrorSigningFailed = fmt.Errorf("Round2ErrorSigningFailed")
+var ErrRound2ErrorInvalidRandomizer = fmt.Errorf("Round2ErrorInvalidRandomizer")
 
 // Variant structs
-type OrchardKeyErrorKeyDerivationError struct {
-	Message string
+type Round2ErrorInvalidKeyPackage struct {
 }
 
-func NewOrchardKeyErrorKeyDerivationError(
-	message string,
-) *OrchardKeyError {
-	return &OrchardKeyError{err: &OrchardKeyErrorKeyDerivationError{
-		Message: message}}
+func NewRound2ErrorInvalidKeyPackage() *Round2Error {
+	return &Round2Error{err: &Round2ErrorInvalidKeyPackage{}}
 }
 
-func (e OrchardKeyErrorKeyDerivationError) destroy() {
-	FfiDestroyerString{}.Destroy(e.Message)
+func (e Round2ErrorInvalidKeyPackage) destroy() {
 }
 
-func (err OrchardKeyErrorKeyDerivationError) Error() string {
-	return fmt.Sprint("KeyDerivationError",
-		": ",
+func (err Round2ErrorInvalidKeyPackage) Error() string {
+	return fmt.Sprint("InvalidKeyPackage")
+}
 
-		"Message=",
-		err.Message,
-	)
+func (self Round2ErrorInvalidKeyPackage) Is(target error) bool {
+	return target == ErrRound2ErrorInvalidKeyPackage
 }
 
-func (self OrchardKeyErrorKeyDerivationError) Is(target error) bool {
-	return target == ErrOrchardKeyErrorKeyDerivationError
+type Round2ErrorNonceSerializationError struct {
+}
+
+func NewRound2ErrorNonceSerializationError() *Round2Error {
+	return &Round2Error{err: &Round2ErrorNonceSerializationError{}}
+}
+
+func (e Round2ErrorNonceSerializationError) destroy() {
+}
+
+func (err Round2ErrorNonceSerializationError) Error() string {
+	return fmt.Sprint("NonceSerializationError")
+}
+
+func (self Round2ErrorNonceSerializationError) Is(target error) bool {
+	return target == ErrRound2ErrorNonceSerializationError
+}
+
+type Round2ErrorCommitmentSerializationError struct {
+}
+
+func NewRound2ErrorCommitmentSerializationError() *Round2Error {
+	return &Round2Error{err: &Round2ErrorCommitmentSerializationError{}}
+}
+
+func (e Round2ErrorCommitmentSerializationError) destroy() {
+}
+
+func (err Round2ErrorCommitmentSerializationError) Error() string {
+	return fmt.Sprint("CommitmentSerializationError")
+}
+
+func (self Round2ErrorCommitmentSerializationError) Is(target error) bool {
+	return target == ErrRound2ErrorCommitmentSerializationError
 }
 
-type OrchardKeyErrorSerializationError struct {
+type Round2ErrorSigningPackageDeserializationError struct {
 }
 
-func NewOrchardKeyErrorSerializationError() *OrchardKeyError {
-	return &OrchardKeyError{err: &OrchardKeyErrorSerializationError{}}
+func NewRound2ErrorSigningPackageDeserializationError() *Round2Error {
+	return &Round2Error{err: &Round2ErrorSigningPackageDeserializationError{}}
 }
 
-func (e OrchardKeyErrorSerializationError) destroy() {
+func (e Round2ErrorSigningPackageDeserializationError) destroy() {
 }
 
-func (err OrchardKeyErrorSerializationError) Error() string {
-	return fmt.Sprint("SerializationError")
+func (err Round2ErrorSigningPackageDeserializationError) Error() string {
+	return fmt.Sprint("SigningPackageDeserializationError")
 }
 
-func (self OrchardKeyErrorSerializationError) Is(target error) bool {
-	return target == ErrOrchardKeyErrorSerializationError
+func (self Round2ErrorSigningPackageDeserializationError) Is(target error) bool {
+	return target == ErrRound2ErrorSigningPackageDeserializationError
 }
 
-type OrchardKeyErrorDeserializationError struct {
+type Round2ErrorSigningFailed struct {
+	Message string
 }
 
-func NewOrchardKeyErrorDeserializationError() *OrchardKeyError {
-	return &OrchardKeyError{err: &OrchardKeyErrorDeserializationError{}}
+func NewRound2ErrorSigningFailed(
+	message string,
+) *Round2Error {
+	return &Round2Error{err: &Round2ErrorSigningFailed{
+		Message: message}}
 }
 
-func (e OrchardKeyErrorDeserializationError) destroy() {
+func (e Round2ErrorSigningFailed) destroy() {
+	FfiDestroyerString{}.Destroy(e.Message)
 }
 
-func (err OrchardKeyErrorDeserializationError) Error() string {
-	return fmt.Sprint("DeserializationError")
-}
+func (err Round2ErrorSigningFailed) Error() string {
+	return fmt.Sprint("SigningFailed",
+		": ",
 
-func (self OrchardKeyErrorDeserializationError) Is(target error) bool {
-	return target == ErrOrchardKeyErrorDeserializationError
+		"Message=",
+		err.Message,
+	)
 }
 
-type OrchardKeyErrorOtherError struct {
-	ErrorMessage string
+func (self Round2ErrorSigningFailed) Is(target error) bool {
+	return target == ErrRound2ErrorSigningFailed
 }
 
-func NewOrchardKeyErrorOtherError(
-	errorMessage string,
-) *OrchardKeyError {
-	return &OrchardKeyError{err: &OrchardKeyErrorOtherError{
-		ErrorMessage: errorMessage}}
+type Round2ErrorInvalidRandomizer struct {
 }
 
-func (e OrchardKeyErrorOtherError) destroy() {
-	FfiDestroyerString{}.Destroy(e.ErrorMessage)
+func NewRound2ErrorInvalidRandomizer() *Round2Error {
+	return &Round2Error{err: &Round2ErrorInvalidRandomizer{}}
 }
 
-func (err OrchardKeyErrorOtherError) Error() string {
-	return fmt.Sprint("OtherError",
-		": ",
+func (e Round2ErrorInvalidRandomizer) destroy() {
+}
 
-		"ErrorMessage=",
-		err.ErrorMessage,
-	)
+func (err Round2ErrorInvalidRandomizer) Error() string {
+	return fmt.Sprint("InvalidRandomizer")
 }
 
-func (self OrchardKeyErrorOtherError) Is(target error) bool {
-	return target == ErrOrchardKeyErrorOtherError
+func (self Round2ErrorInvalidRandomizer) Is(target error) bool {
+	return target == ErrRound2ErrorInvalidRandomizer
 }
 
-type FfiConverterOrchardKeyError struct{}
+type FfiConverterRound2Error struct{}
 
-var FfiConverterOrchardKeyErrorINSTANCE = FfiConverterOrchardKeyError{}
+var FfiConverterRound2ErrorINSTANCE = FfiConverterRound2Error{}
 
-func (c FfiConverterOrchardKeyError) Lift(eb RustBufferI) *OrchardKeyError {
-	return LiftFromRustBuffer[*OrchardKeyError](c, eb)
+func (c FfiConverterRound2Error) Lift(eb RustBufferI) *Round2Error {
+	return LiftFromRustBuffer[*Round2Error](c, eb)
 }
 
-func (c FfiConverterOrchardKeyError) Lower(value *OrchardKeyError) C.RustBuffer {
-	return LowerIntoRustBuffer[*OrchardKeyError](c, value)
+func (c FfiConverterRound2Error) Lower(value *Round2Error) C.RustBuffer {
+	return LowerIntoRustBuffer[*Round2Error](c, value)
 }
 
-func (c FfiConverterOrchardKeyError) Read(reader io.Reader) *OrchardKeyError {
+func (c FfiConverterRound2Error) Read(reader io.Reader) *Round2Error {
 	errorID := readUint32(reader)
 
 	switch errorID {
 	case 1:
-		return &OrchardKeyError{&OrchardKeyErrorKeyDerivationError{
-			Message: FfiConverterStringINSTANCE.Read(reader),
-		}}
+		return &Round2Error{&Round2ErrorInvalidKeyPackage{}}
 	case 2:
-		return &OrchardKeyError{&OrchardKeyErrorSerializationError{}}
+		return &Round2Error{&Round2ErrorNonceSerializationError{}}
 	case 3:
-		return &OrchardKeyError{&OrchardKeyErrorDeserializationError{}}
+		return &Round2Error{&Round2ErrorCommitmentSerializationError{}}
 	case 4:
-		return &OrchardKeyError{&OrchardKeyErrorOtherError{
-			ErrorMessage: FfiConverterStringINSTANCE.Read(reader),
+		return &Round2Error{&Round2ErrorSigningPackageDeserializationError{}}
+	case 5:
+		return &Round2Error{&Round2ErrorSigningFailed{
+			Message: FfiConverterStringINSTANCE.Read(reader),
 		}}
+	case 6:
+		return &Round2Error{&Round2ErrorInvalidRandomizer{}}
 	default:
-		panic(fmt.Sprintf("Unknown error code %d in FfiConverterOrchardKeyError.Read()", errorID))
+		panic(fmt.Sprintf("Unknown error code %d in FfiConverterRound2Error.Read()", errorID))
 	}
 }
 
-func (c FfiConverterOrchardKeyError) Write(writer io.Writer, value *OrchardKeyError) {
+func (c FfiConverterRound2Error) Write(writer io.Writer, value *Round2Error) {
 	switch variantValue := value.err.(type) {
-	case *OrchardKeyErrorKeyDerivationError:
+	case *Round2ErrorInvalidKeyPackage:
 		writeInt32(writer, 1)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Message)
-	case *OrchardKeyErrorSerializationError:
+	case *Round2ErrorNonceSerializationError:
 		writeInt32(writer, 2)
-	case *OrchardKeyErrorDeserializationError:
+	case *Round2ErrorCommitmentSerializationError:
 		writeInt32(writer, 3)
-	case *OrchardKeyErrorOtherError:
+	case *Round2ErrorSigningPackageDeserializationError:
 		writeInt32(writer, 4)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.ErrorMessage)
+	case *Round2ErrorSigningFailed:
+		writeInt32(writer, 5)
+		FfiConverterStringINSTANCE.Write(writer, variantValue.Message)
+	case *Round2ErrorInvalidRandomizer:
+		writeInt32(writer, 6)
 	default:
 		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterOrchardKeyError.Write", value))
+		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterRound2Error.Write", value))
 	}
 }
 
-type FfiDestroyerOrchardKeyError struct{}
+type FfiDestroyerRound2Error struct{}
 
-func (_ FfiDestroyerOrchardKeyError) Destroy(value *OrchardKeyError) {
+func (_ FfiDestroyerRound2Error) Destroy(value *Round2Error) {
 	switch variantValue := value.err.(type) {
-	case OrchardKeyErrorKeyDerivationError:
+	case Round2ErrorInvalidKeyPackage:
 		variantValue.destroy()
-	case OrchardKeyErrorSerializationError:
+	case Round2ErrorNonceSerializationError:
 		variantValue.destroy()
-	case OrchardKeyErrorDeserializationError:
+	case Round2ErrorCommitmentSerializationError:
 		variantValue.destroy()
-	case OrchardKeyErrorOtherError:
+	case Round2ErrorSigningPackageDeserializationError:
+		variantValue.destroy()
+	case Round2ErrorSigningFailed:
+		variantValue.destroy()
+	case Round2ErrorInvalidRandomizer:
 		variantValue.destroy()
 	default:
 		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerOrchardKeyError.Destroy", value))
+		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerRound2Error.Destroy", value))
 	}
 }
 
-type Round1Error struct {
-	err error
+type ZcashNetwork uint
+
+const (
+	ZcashNetworkMainnet ZcashNetwork = 1
+	ZcashNetworkTestnet ZcashNetwork = 2
+)
+
+type FfiConverterZcashNetwork struct{}
+
+var FfiConverterZcashNetworkINSTANCE = FfiConverterZcashNetwork{}
+
+func (c FfiConverterZcashNetwork) Lift(rb RustBufferI) ZcashNetwork {
+	return LiftFromRustBuffer[ZcashNetwork](c, rb)
 }
 
-// Convience method to turn *Round1Error into error
-// Avoiding treating nil pointer as non nil error interface
-func (err *Round1Error) AsError() error {
-	if err == nil {
-		return nil
-	} else {
-		return err
-	}
+func (c FfiConverterZcashNetwork) Lower(value ZcashNetwork) C.RustBuffer {
+	return LowerIntoRustBuffer[ZcashNetwork](c, value)
+}
+func (FfiConverterZcashNetwork) Read(reader io.Reader) ZcashNetwork {
+	id := readInt32(reader)
+	return ZcashNetwork(id)
 }
 
-func (err Round1Error) Error() string {
-	return fmt.Sprintf("Round1Error: %s", err.err.Error())
+func (FfiConverterZcashNetwork) Write(writer io.Writer, value ZcashNetwork) {
+	writeInt32(writer, int32(value))
 }
 
-func (err Round1Error) Unwrap() error {
-	return err.err
+type FfiDestroyerZcashNetwork struct{}
+
+func (_ FfiDestroyerZcashNetwork) Destroy(value ZcashNetwork) {
 }
 
-// Err* are used for checking error type with `errors.Is`
-var ErrRound1ErrorInvalidKeyPackage = fmt.Errorf("Round1ErrorInvalidKeyPackage")
-var ErrRound1ErrorNonceSerializationError = fmt.Errorf("Round1ErrorNonceSerializationError")
-var ErrRound1ErrorCommitmentSerializationError = fmt.Errorf("Round1ErrorCommitmentSerializationError")
+type FfiConverterOptionalBytes struct{}
 
-// Variant structs
-type Round1ErrorInvalidKeyPackage struct {
+var FfiConverterOptionalBytesINSTANCE = FfiConverterOptionalBytes{}
+
+func (c FfiConverterOptionalBytes) Lift(rb RustBufferI) *[]byte {
+	return LiftFromRustBuffer[*[]byte](c, rb)
 }
 
-func NewRound1ErrorInvalidKeyPackage() *Round1Error {
-	return &Round1Error{err: &Round1ErrorInvalidKeyPackage{}}
+func (_ FfiConverterOptionalBytes) Read(reader io.Reader) *[]byte {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterBytesINSTANCE.Read(reader)
+	return &temp
 }
 
-func (e Round1ErrorInvalidKeyPackage) destroy() {
+func (c FfiConverterOptionalBytes) Lower(value *[]byte) C.RustBuffer {
+	return LowerIntoRustBuffer[*[]byte](c, value)
 }
 
-func (err Round1ErrorInvalidKeyPackage) Error() string {
-	return fmt.Sprint("InvalidKeyPackage")
+func (_ FfiConverterOptionalBytes) Write(writer io.Writer, value *[]byte) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterBytesINSTANCE.Write(writer, *value)
+	}
 }
 
-func (self Round1ErrorInvalidKeyPackage) Is(target error) bool {
-	return target == ErrRound1ErrorInvalidKeyPackage
+type FfiDestroyerOptionalBytes struct{}
+
+func (_ FfiDestroyerOptionalBytes) Destroy(value *[]byte) {
+	if value != nil {
+		FfiDestroyerBytes{}.Destroy(*value)
+	}
 }
 
-type Round1ErrorNonceSerializationError struct {
+type FfiConverterOptionalParticipantIdentifier struct{}
+
+var FfiConverterOptionalParticipantIdentifierINSTANCE = FfiConverterOptionalParticipantIdentifier{}
+
+func (c FfiConverterOptionalParticipantIdentifier) Lift(rb RustBufferI) *ParticipantIdentifier {
+	return LiftFromRustBuffer[*ParticipantIdentifier](c, rb)
 }
 
-func NewRound1ErrorNonceSerializationError() *Round1Error {
-	return &Round1Error{err: &Round1ErrorNonceSerializationError{}}
+func (_ FfiConverterOptionalParticipantIdentifier) Read(reader io.Reader) *ParticipantIdentifier {
+	if readInt8(reader) == 0 {
+		return nil
+	}
+	temp := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
+	return &temp
 }
 
-func (e Round1ErrorNonceSerializationError) destroy() {
+func (c FfiConverterOptionalParticipantIdentifier) Lower(value *ParticipantIdentifier) C.RustBuffer {
+	return LowerIntoRustBuffer[*ParticipantIdentifier](c, value)
 }
 
-func (err Round1ErrorNonceSerializationError) Error() string {
-	return fmt.Sprint("NonceSerializationError")
+func (_ FfiConverterOptionalParticipantIdentifier) Write(writer io.Writer, value *ParticipantIdentifier) {
+	if value == nil {
+		writeInt8(writer, 0)
+	} else {
+		writeInt8(writer, 1)
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, *value)
+	}
 }
 
-func (self Round1ErrorNonceSerializationError) Is(target error) bool {
-	return target == ErrRound1ErrorNonceSerializationError
+type FfiDestroyerOptionalParticipantIdentifier struct{}
+
+func (_ FfiDestroyerOptionalParticipantIdentifier) Destroy(value *ParticipantIdentifier) {
+	if value != nil {
+		FfiDestroyerParticipantIdentifier{}.Destroy(*value)
+	}
 }
 
-type Round1ErrorCommitmentSerializationError struct {
+type FfiConverterSequenceDkgRound2Package struct{}
+
+var FfiConverterSequenceDkgRound2PackageINSTANCE = FfiConverterSequenceDkgRound2Package{}
+
+func (c FfiConverterSequenceDkgRound2Package) Lift(rb RustBufferI) []DkgRound2Package {
+	return LiftFromRustBuffer[[]DkgRound2Package](c, rb)
 }
 
-func NewRound1ErrorCommitmentSerializationError() *Round1Error {
-	return &Round1Error{err: &Round1ErrorCommitmentSerializationError{}}
+func (c FfiConverterSequenceDkgRound2Package) Read(reader io.Reader) []DkgRound2Package {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]DkgRound2Package, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterDkgRound2PackageINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (e Round1ErrorCommitmentSerializationError) destroy() {
+func (c FfiConverterSequenceDkgRound2Package) Lower(value []DkgRound2Package) C.RustBuffer {
+	return LowerIntoRustBuffer[[]DkgRound2Package](c, value)
+}
+
+func (c FfiConverterSequenceDkgRound2Package) Write(writer io.Writer, value []DkgRound2Package) {
+	if len(value) > math.MaxInt32 {
+		panic("[]DkgRound2Package is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterDkgRound2PackageINSTANCE.Write(writer, item)
+	}
 }
 
-func (err Round1ErrorCommitmentSerializationError) Error() string {
-	return fmt.Sprint("CommitmentSerializationError")
-}
+type FfiDestroyerSequenceDkgRound2Package struct{}
 
-func (self Round1ErrorCommitmentSerializationError) Is(target error) bool {
-	return target == ErrRound1ErrorCommitmentSerializationError
+func (FfiDestroyerSequenceDkgRound2Package) Destroy(sequence []DkgRound2Package) {
+	for _, value := range sequence {
+		FfiDestroyerDkgRound2Package{}.Destroy(value)
+	}
 }
 
-type FfiConverterRound1Error struct{}
+type FfiConverterSequenceFrostKeyPackage struct{}
 
-var FfiConverterRound1ErrorINSTANCE = FfiConverterRound1Error{}
+var FfiConverterSequenceFrostKeyPackageINSTANCE = FfiConverterSequenceFrostKeyPackage{}
 
-func (c FfiConverterRound1Error) Lift(eb RustBufferI) *Round1Error {
-	return LiftFromRustBuffer[*Round1Error](c, eb)
+func (c FfiConverterSequenceFrostKeyPackage) Lift(rb RustBufferI) []FrostKeyPackage {
+	return LiftFromRustBuffer[[]FrostKeyPackage](c, rb)
 }
 
-func (c FfiConverterRound1Error) Lower(value *Round1Error) C.RustBuffer {
-	return LowerIntoRustBuffer[*Round1Error](c, value)
+func (c FfiConverterSequenceFrostKeyPackage) Read(reader io.Reader) []FrostKeyPackage {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FrostKeyPackage, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFrostKeyPackageINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (c FfiConverterRound1Error) Read(reader io.Reader) *Round1Error {
-	errorID := readUint32(reader)
+func (c FfiConverterSequenceFrostKeyPackage) Lower(value []FrostKeyPackage) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FrostKeyPackage](c, value)
+}
 
-	switch errorID {
-	case 1:
-		return &Round1Error{&Round1ErrorInvalidKeyPackage{}}
-	case 2:
-		return &Round1Error{&Round1ErrorNonceSerializationError{}}
-	case 3:
-		return &Round1Error{&Round1ErrorCommitmentSerializationError{}}
-	default:
-		panic(fmt.Sprintf("Unknown error code %d in FfiConverterRound1Error.Read()", errorID))
+func (c FfiConverterSequenceFrostKeyPackage) Write(writer io.Writer, value []FrostKeyPackage) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FrostKeyPackage is too large to fit into Int32")
 	}
-}
 
-func (c FfiConverterRound1Error) Write(writer io.Writer, value *Round1Error) {
-	switch variantValue := value.err.(type) {
-	case *Round1ErrorInvalidKeyPackage:
-		writeInt32(writer, 1)
-	case *Round1ErrorNonceSerializationError:
-		writeInt32(writer, 2)
-	case *Round1ErrorCommitmentSerializationError:
-		writeInt32(writer, 3)
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterRound1Error.Write", value))
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFrostKeyPackageINSTANCE.Write(writer, item)
 	}
 }
 
-type FfiDestroyerRound1Error struct{}
+type FfiDestroyerSequenceFrostKeyPackage struct{}
 
-func (_ FfiDestroyerRound1Error) Destroy(value *Round1Error) {
-	switch variantValue := value.err.(type) {
-	case Round1ErrorInvalidKeyPackage:
-		variantValue.destroy()
-	case Round1ErrorNonceSerializationError:
-		variantValue.destroy()
-	case Round1ErrorCommitmentSerializationError:
-		variantValue.destroy()
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerRound1Error.Destroy", value))
+func (FfiDestroyerSequenceFrostKeyPackage) Destroy(sequence []FrostKeyPackage) {
+	for _, value := range sequence {
+		FfiDestroyerFrostKeyPackage{}.Destroy(value)
 	}
 }
 
-type Round2Error struct {
-	err error
+type FfiConverterSequenceFrostSignatureShare struct{}
+
+var FfiConverterSequenceFrostSignatureShareINSTANCE = FfiConverterSequenceFrostSignatureShare{}
+
+func (c FfiConverterSequenceFrostSignatureShare) Lift(rb RustBufferI) []FrostSignatureShare {
+	return LiftFromRustBuffer[[]FrostSignatureShare](c, rb)
 }
 
-// Convience method to turn *Round2Error into error
-// Avoiding treating nil pointer as non nil error interface
-func (err *Round2Error) AsError() error {
-	if err == nil {
+func (c FfiConverterSequenceFrostSignatureShare) Read(reader io.Reader) []FrostSignatureShare {
+	length := readInt32(reader)
+	if length == 0 {
 		return nil
-	} else {
-		return err
 	}
+	result := make([]FrostSignatureShare, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFrostSignatureShareINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (err Round2Error) Error() string {
-	return fmt.Sprintf("Round2Error: %s", err.err.Error())
-}
-
-func (err Round2Error) Unwrap() error {
-	return err.err
+func (c FfiConverterSequenceFrostSignatureShare) Lower(value []FrostSignatureShare) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FrostSignatureShare](c, value)
 }
 
-// Err* are used for checking error type with `errors.Is`
-var ErrRound2ErrorInvalidKeyPackage = fmt.Errorf("Round2ErrorInvalidKeyPackage")
-var ErrRound2ErrorNonceSerializationError = fmt.Errorf("Round2ErrorNonceSerializationError")
-var ErrRound2ErrorCommitmentSerializationError = fmt.Errorf("Round2ErrorCommitmentSerializationError")
-var ErrRound2ErrorSigningPackageDeserializationError = fmt.Errorf("Round2ErrorSigningPackageDeserializationError")
-var ErrRound2ErrorSigningFailed = fmt.Errorf("Round2ErrorSigningFailed")
-var ErrRound2ErrorInvalidRandomizer = fmt.Errorf("Round2ErrorInvalidRandomizer")
-
-// Variant structs
-type Round2ErrorInvalidKeyPackage struct {
-}
+func (c FfiConverterSequenceFrostSignatureShare) Write(writer io.Writer, value []FrostSignatureShare) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FrostSignatureShare is too large to fit into Int32")
+	}
 
-func NewRound2ErrorInvalidKeyPackage() *Round2Error {
-	return &Round2Error{err: &Round2ErrorInvalidKeyPackage{}}
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFrostSignatureShareINSTANCE.Write(writer, item)
+	}
 }
 
-func (e Round2ErrorInvalidKeyPackage) destroy() {
-}
+type FfiDestroyerSequenceFrostSignatureShare struct{}
 
-func (err Round2ErrorInvalidKeyPackage) Error() string {
-	return fmt.Sprint("InvalidKeyPackage")
+func (FfiDestroyerSequenceFrostSignatureShare) Destroy(sequence []FrostSignatureShare) {
+	for _, value := range sequence {
+		FfiDestroyerFrostSignatureShare{}.Destroy(value)
+	}
 }
 
-func (self Round2ErrorInvalidKeyPackage) Is(target error) bool {
-	return target == ErrRound2ErrorInvalidKeyPackage
-}
+type FfiConverterSequenceFrostSigningCommitments struct{}
 
-type Round2ErrorNonceSerializationError struct {
-}
+var FfiConverterSequenceFrostSigningCommitmentsINSTANCE = FfiConverterSequenceFrostSigningCommitments{}
 
-func NewRound2ErrorNonceSerializationError() *Round2Error {
-	return &Round2Error{err: &Round2ErrorNonceSerializationError{}}
+func (c FfiConverterSequenceFrostSigningCommitments) Lift(rb RustBufferI) []FrostSigningCommitments {
+	return LiftFromRustBuffer[[]FrostSigningCommitments](c, rb)
 }
 
-func (e Round2ErrorNonceSerializationError) destroy() {
+func (c FfiConverterSequenceFrostSigningCommitments) Read(reader io.Reader) []FrostSigningCommitments {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]FrostSigningCommitments, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterFrostSigningCommitmentsINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (err Round2ErrorNonceSerializationError) Error() string {
-	return fmt.Sprint("NonceSerializationError")
+func (c FfiConverterSequenceFrostSigningCommitments) Lower(value []FrostSigningCommitments) C.RustBuffer {
+	return LowerIntoRustBuffer[[]FrostSigningCommitments](c, value)
 }
 
-func (self Round2ErrorNonceSerializationError) Is(target error) bool {
-	return target == ErrRound2ErrorNonceSerializationError
-}
+func (c FfiConverterSequenceFrostSigningCommitments) Write(writer io.Writer, value []FrostSigningCommitments) {
+	if len(value) > math.MaxInt32 {
+		panic("[]FrostSigningCommitments is too large to fit into Int32")
+	}
 
-type Round2ErrorCommitmentSerializationError struct {
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterFrostSigningCommitmentsINSTANCE.Write(writer, item)
+	}
 }
 
-func NewRound2ErrorCommitmentSerializationError() *Round2Error {
-	return &Round2Error{err: &Round2ErrorCommitmentSerializationError{}}
-}
+type FfiDestroyerSequenceFrostSigningCommitments struct{}
 
-func (e Round2ErrorCommitmentSerializationError) destroy() {
+func (FfiDestroyerSequenceFrostSigningCommitments) Destroy(sequence []FrostSigningCommitments) {
+	for _, value := range sequence {
+		FfiDestroyerFrostSigningCommitments{}.Destroy(value)
+	}
 }
 
-func (err Round2ErrorCommitmentSerializationError) Error() string {
-	return fmt.Sprint("CommitmentSerializationError")
-}
+type FfiConverterSequenceParticipantIdentifier struct{}
 
-func (self Round2ErrorCommitmentSerializationError) Is(target error) bool {
-	return target == ErrRound2ErrorCommitmentSerializationError
-}
+var FfiConverterSequenceParticipantIdentifierINSTANCE = FfiConverterSequenceParticipantIdentifier{}
 
-type Round2ErrorSigningPackageDeserializationError struct {
+func (c FfiConverterSequenceParticipantIdentifier) Lift(rb RustBufferI) []ParticipantIdentifier {
+	return LiftFromRustBuffer[[]ParticipantIdentifier](c, rb)
 }
 
-func NewRound2ErrorSigningPackageDeserializationError() *Round2Error {
-	return &Round2Error{err: &Round2ErrorSigningPackageDeserializationError{}}
+func (c FfiConverterSequenceParticipantIdentifier) Read(reader io.Reader) []ParticipantIdentifier {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]ParticipantIdentifier, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterParticipantIdentifierINSTANCE.Read(reader))
+	}
+	return result
 }
 
-func (e Round2ErrorSigningPackageDeserializationError) destroy() {
+func (c FfiConverterSequenceParticipantIdentifier) Lower(value []ParticipantIdentifier) C.RustBuffer {
+	return LowerIntoRustBuffer[[]ParticipantIdentifier](c, value)
 }
 
-func (err Round2ErrorSigningPackageDeserializationError) Error() string {
-	return fmt.Sprint("SigningPackageDeserializationError")
-}
+func (c FfiConverterSequenceParticipantIdentifier) Write(writer io.Writer, value []ParticipantIdentifier) {
+	if len(value) > math.MaxInt32 {
+		panic("[]ParticipantIdentifier is too large to fit into Int32")
+	}
 
-func (self Round2ErrorSigningPackageDeserializationError) Is(target error) bool {
-	return target == ErrRound2ErrorSigningPackageDeserializationError
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, item)
+	}
 }
 
-type Round2ErrorSigningFailed struct {
-	Message string
-}
+type FfiDestroyerSequenceParticipantIdentifier struct{}
 
-func NewRound2ErrorSigningFailed(
-	message string,
-) *Round2Error {
-	return &Round2Error{err: &Round2ErrorSigningFailed{
-		Message: message}}
+func (FfiDestroyerSequenceParticipantIdentifier) Destroy(sequence []ParticipantIdentifier) {
+	for _, value := range sequence {
+		FfiDestroyerParticipantIdentifier{}.Destroy(value)
+	}
 }
 
-func (e Round2ErrorSigningFailed) destroy() {
-	FfiDestroyerString{}.Destroy(e.Message)
-}
+type FfiConverterSequencePcztOrchardAction struct{}
 
-func (err Round2ErrorSigningFailed) Error() string {
-	return fmt.Sprint("SigningFailed",
-		": ",
+var FfiConverterSequencePcztOrchardActionINSTANCE = FfiConverterSequencePcztOrchardAction{}
 
-		"Message=",
-		err.Message,
-	)
+func (c FfiConverterSequencePcztOrchardAction) Lift(rb RustBufferI) []PcztOrchardAction {
+	return LiftFromRustBuffer[[]PcztOrchardAction](c, rb)
 }
 
-func (self Round2ErrorSigningFailed) Is(target error) bool {
-	return target == ErrRound2ErrorSigningFailed
+func (c FfiConverterSequencePcztOrchardAction) Read(reader io.Reader) []PcztOrchardAction {
+	length := readInt32(reader)
+	if length == 0 {
+		return nil
+	}
+	result := make([]PcztOrchardAction, 0, length)
+	for i := int32(0); i < length; i++ {
+		result = append(result, FfiConverterPcztOrchardActionINSTANCE.Read(reader))
+	}
+	return result
 }
 
-type Round2ErrorInvalidRandomizer struct {
+func (c FfiConverterSequencePcztOrchardAction) Lower(value []PcztOrchardAction) C.RustBuffer {
+	return LowerIntoRustBuffer[[]PcztOrchardAction](c, value)
 }
 
-func NewRound2ErrorInvalidRandomizer() *Round2Error {
-	return &Round2Error{err: &Round2ErrorInvalidRandomizer{}}
-}
+func (c FfiConverterSequencePcztOrchardAction) Write(writer io.Writer, value []PcztOrchardAction) {
+	if len(value) > math.MaxInt32 {
+		panic("[]PcztOrchardAction is too large to fit into Int32")
+	}
 
-func (e Round2ErrorInvalidRandomizer) destroy() {
+	writeInt32(writer, int32(len(value)))
+	for _, item := range value {
+		FfiConverterPcztOrchardActionINSTANCE.Write(writer, item)
+	}
 }
 
-func (err Round2ErrorInvalidRandomizer) Error() string {
-	return fmt.Sprint("InvalidRandomizer")
-}
+type FfiDestroyerSequencePcztOrchardAction struct{}
 
-func (self Round2ErrorInvalidRandomizer) Is(target error) bool {
-	return target == ErrRound2ErrorInvalidRandomizer
+func (FfiDestroyerSequencePcztOrchardAction) Destroy(sequence []PcztOrchardAction) {
+	for _, value := range sequence {
+		FfiDestroyerPcztOrchardAction{}.Destroy(value)
+	}
 }
 
-type FfiConverterRound2Error struct{}
+type FfiConverterMapParticipantIdentifierString struct{}
 
-var FfiConverterRound2ErrorINSTANCE = FfiConverterRound2Error{}
+var FfiConverterMapParticipantIdentifierStringINSTANCE = FfiConverterMapParticipantIdentifierString{}
 
-func (c FfiConverterRound2Error) Lift(eb RustBufferI) *Round2Error {
-	return LiftFromRustBuffer[*Round2Error](c, eb)
+func (c FfiConverterMapParticipantIdentifierString) Lift(rb RustBufferI) map[ParticipantIdentifier]string {
+	return LiftFromRustBuffer[map[ParticipantIdentifier]string](c, rb)
 }
 
-func (c FfiConverterRound2Error) Lower(value *Round2Error) C.RustBuffer {
-	return LowerIntoRustBuffer[*Round2Error](c, value)
+func (_ FfiConverterMapParticipantIdentifierString) Read(reader io.Reader) map[ParticipantIdentifier]string {
+	result := make(map[ParticipantIdentifier]string)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
+		value := FfiConverterStringINSTANCE.Read(reader)
+		result[key] = value
+	}
+	return result
 }
 
-func (c FfiConverterRound2Error) Read(reader io.Reader) *Round2Error {
-	errorID := readUint32(reader)
+func (c FfiConverterMapParticipantIdentifierString) Lower(value map[ParticipantIdentifier]string) C.RustBuffer {
+	return LowerIntoRustBuffer[map[ParticipantIdentifier]string](c, value)
+}
 
-	switch errorID {
-	case 1:
-		return &Round2Error{&Round2ErrorInvalidKeyPackage{}}
-	case 2:
-		return &Round2Error{&Round2ErrorNonceSerializationError{}}
-	case 3:
-		return &Round2Error{&Round2ErrorCommitmentSerializationError{}}
-	case 4:
-		return &Round2Error{&Round2ErrorSigningPackageDeserializationError{}}
-	case 5:
-		return &Round2Error{&Round2ErrorSigningFailed{
-			Message: FfiConverterStringINSTANCE.Read(reader),
-		}}
-	case 6:
-		return &Round2Error{&Round2ErrorInvalidRandomizer{}}
-	default:
-		panic(fmt.Sprintf("Unknown error code %d in FfiConverterRound2Error.Read()", errorID))
+func (_ FfiConverterMapParticipantIdentifierString) Write(writer io.Writer, mapValue map[ParticipantIdentifier]string) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[ParticipantIdentifier]string is too large to fit into Int32")
 	}
-}
 
-func (c FfiConverterRound2Error) Write(writer io.Writer, value *Round2Error) {
-	switch variantValue := value.err.(type) {
-	case *Round2ErrorInvalidKeyPackage:
-		writeInt32(writer, 1)
-	case *Round2ErrorNonceSerializationError:
-		writeInt32(writer, 2)
-	case *Round2ErrorCommitmentSerializationError:
-		writeInt32(writer, 3)
-	case *Round2ErrorSigningPackageDeserializationError:
-		writeInt32(writer, 4)
-	case *Round2ErrorSigningFailed:
-		writeInt32(writer, 5)
-		FfiConverterStringINSTANCE.Write(writer, variantValue.Message)
-	case *Round2ErrorInvalidRandomizer:
-		writeInt32(writer, 6)
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiConverterRound2Error.Write", value))
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
+		FfiConverterStringINSTANCE.Write(writer, value)
 	}
 }
 
-type FfiDestroyerRound2Error struct{}
+type FfiDestroyerMapParticipantIdentifierString struct{}
 
-func (_ FfiDestroyerRound2Error) Destroy(value *Round2Error) {
-	switch variantValue := value.err.(type) {
-	case Round2ErrorInvalidKeyPackage:
-		variantValue.destroy()
-	case Round2ErrorNonceSerializationError:
-		variantValue.destroy()
-	case Round2ErrorCommitmentSerializationError:
-		variantValue.destroy()
-	case Round2ErrorSigningPackageDeserializationError:
-		variantValue.destroy()
-	case Round2ErrorSigningFailed:
-		variantValue.destroy()
-	case Round2ErrorInvalidRandomizer:
-		variantValue.destroy()
-	default:
-		_ = variantValue
-		panic(fmt.Sprintf("invalid error value `%v` in FfiDestroyerRound2Error.Destroy", value))
+func (_ FfiDestroyerMapParticipantIdentifierString) Destroy(mapValue map[ParticipantIdentifier]string) {
+	for key, value := range mapValue {
+		FfiDestroyerParticipantIdentifier{}.Destroy(key)
+		FfiDestroyerString{}.Destroy(value)
 	}
 }
 
-type ZcashNetwork uint
-
-const (
-	ZcashNetworkMainnet ZcashNetwork = 1
-	ZcashNetworkTestnet ZcashNetwork = 2
-)
-
-type FfiConverterZcashNetwork struct{}
+type FfiConverterMapParticipantIdentifierDkgRound1Package struct{}
 
-var FfiConverterZcashNetworkINSTANCE = FfiConverterZcashNetwork{}
+var FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE = FfiConverterMapParticipantIdentifierDkgRound1Package{}
 
-func (c FfiConverterZcashNetwork) Lift(rb RustBufferI) ZcashNetwork {
-	return LiftFromRustBuffer[ZcashNetwork](c, rb)
+func (c FfiConverterMapParticipantIdentifierDkgRound1Package) Lift(rb RustBufferI) map[ParticipantIdentifier]DkgRound1Package {
+	return LiftFromRustBuffer[map[ParticipantIdentifier]DkgRound1Package](c, rb)
 }
 
-func (c FfiConverterZcashNetwork) Lower(value ZcashNetwork) C.RustBuffer {
-	return LowerIntoRustBuffer[ZcashNetwork](c, value)
+func (_ FfiConverterMapParticipantIdentifierDkgRound1Package) Read(reader io.Reader) map[ParticipantIdentifier]DkgRound1Package {
+	result := make(map[ParticipantIdentifier]DkgRound1Package)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
+		value := FfiConverterDkgRound1PackageINSTANCE.Read(reader)
+		result[key] = value
+	}
+	return result
 }
-func (FfiConverterZcashNetwork) Read(reader io.Reader) ZcashNetwork {
-	id := readInt32(reader)
-	return ZcashNetwork(id)
+
+func (c FfiConverterMapParticipantIdentifierDkgRound1Package) Lower(value map[ParticipantIdentifier]DkgRound1Package) C.RustBuffer {
+	return LowerIntoRustBuffer[map[ParticipantIdentifier]DkgRound1Package](c, value)
 }
 
-func (FfiConverterZcashNetwork) Write(writer io.Writer, value ZcashNetwork) {
-	writeInt32(writer, int32(value))
+func (_ FfiConverterMapParticipantIdentifierDkgRound1Package) Write(writer io.Writer, mapValue map[ParticipantIdentifier]DkgRound1Package) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[ParticipantIdentifier]DkgRound1Package is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
+		FfiConverterDkgRound1PackageINSTANCE.Write(writer, value)
+	}
 }
 
-type FfiDestroyerZcashNetwork struct{}
+type FfiDestroyerMapParticipantIdentifierDkgRound1Package struct{}
 
-func (_ FfiDestroyerZcashNetwork) Destroy(value ZcashNetwork) {
+func (_ FfiDestroyerMapParticipantIdentifierDkgRound1Package) Destroy(mapValue map[ParticipantIdentifier]DkgRound1Package) {
+	for key, value := range mapValue {
+		FfiDestroyerParticipantIdentifier{}.Destroy(key)
+		FfiDestroyerDkgRound1Package{}.Destroy(value)
+	}
 }
 
-type FfiConverterOptionalParticipantIdentifier struct{}
+type FfiConverterMapParticipantIdentifierDkgRound2Package struct{}
 
-var FfiConverterOptionalParticipantIdentifierINSTANCE = FfiConverterOptionalParticipantIdentifier{}
+var FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE = FfiConverterMapParticipantIdentifierDkgRound2Package{}
 
-func (c FfiConverterOptionalParticipantIdentifier) Lift(rb RustBufferI) *ParticipantIdentifier {
-	return LiftFromRustBuffer[*ParticipantIdentifier](c, rb)
+func (c FfiConverterMapParticipantIdentifierDkgRound2Package) Lift(rb RustBufferI) map[ParticipantIdentifier]DkgRound2Package {
+	return LiftFromRustBuffer[map[ParticipantIdentifier]DkgRound2Package](c, rb)
 }
 
-func (_ FfiConverterOptionalParticipantIdentifier) Read(reader io.Reader) *ParticipantIdentifier {
-	if readInt8(reader) == 0 {
-		return nil
+func (_ FfiConverterMapParticipantIdentifierDkgRound2Package) Read(reader io.Reader) map[ParticipantIdentifier]DkgRound2Package {
+	result := make(map[ParticipantIdentifier]DkgRound2Package)
+	length := readInt32(reader)
+	for i := int32(0); i < length; i++ {
+		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
+		value := FfiConverterDkgRound2PackageINSTANCE.Read(reader)
+		result[key] = value
 	}
-	temp := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
-	return &temp
+	return result
 }
 
-func (c FfiConverterOptionalParticipantIdentifier) Lower(value *ParticipantIdentifier) C.RustBuffer {
-	return LowerIntoRustBuffer[*ParticipantIdentifier](c, value)
+func (c FfiConverterMapParticipantIdentifierDkgRound2Package) Lower(value map[ParticipantIdentifier]DkgRound2Package) C.RustBuffer {
+	return LowerIntoRustBuffer[map[ParticipantIdentifier]DkgRound2Package](c, value)
 }
 
-func (_ FfiConverterOptionalParticipantIdentifier) Write(writer io.Writer, value *ParticipantIdentifier) {
-	if value == nil {
-		writeInt8(writer, 0)
-	} else {
-		writeInt8(writer, 1)
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, *value)
+func (_ FfiConverterMapParticipantIdentifierDkgRound2Package) Write(writer io.Writer, mapValue map[ParticipantIdentifier]DkgRound2Package) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[ParticipantIdentifier]DkgRound2Package is too large to fit into Int32")
+	}
+
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
+		FfiConverterDkgRound2PackageINSTANCE.Write(writer, value)
 	}
 }
 
-type FfiDestroyerOptionalParticipantIdentifier struct{}
+type FfiDestroyerMapParticipantIdentifierDkgRound2Package struct{}
 
-func (_ FfiDestroyerOptionalParticipantIdentifier) Destroy(value *ParticipantIdentifier) {
-	if value != nil {
-		FfiDestroyerParticipantIdentifier{}.Destroy(*value)
+func (_ FfiDestroyerMapParticipantIdentifierDkgRound2Package) Destroy(mapValue map[ParticipantIdentifier]DkgRound2Package) {
+	for key, value := range mapValue {
+		FfiDestroyerParticipantIdentifier{}.Destroy(key)
+		FfiDestroyerDkgRound2Package{}.Destroy(value)
 	}
 }
 
-type FfiConverterSequenceFrostSignatureShare struct{}
+type FfiConverterMapParticipantIdentifierFrostSecretKeyShare struct{}
 
-var FfiConverterSequenceFrostSignatureShareINSTANCE = FfiConverterSequenceFrostSignatureShare{}
+var FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE = FfiConverterMapParticipantIdentifierFrostSecretKeyShare{}
 
-func (c FfiConverterSequenceFrostSignatureShare) Lift(rb RustBufferI) []FrostSignatureShare {
-	return LiftFromRustBuffer[[]FrostSignatureShare](c, rb)
+func (c FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Lift(rb RustBufferI) map[ParticipantIdentifier]FrostSecretKeyShare {
+	return LiftFromRustBuffer[map[ParticipantIdentifier]FrostSecretKeyShare](c, rb)
 }
 
-func (c FfiConverterSequenceFrostSignatureShare) Read(reader io.Reader) []FrostSignatureShare {
+func (_ FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Read(reader io.Reader) map[ParticipantIdentifier]FrostSecretKeyShare {
+	result := make(map[ParticipantIdentifier]FrostSecretKeyShare)
 	length := readInt32(reader)
-	if length == 0 {
-		return nil
-	}
-	result := make([]FrostSignatureShare, 0, length)
 	for i := int32(0); i < length; i++ {
-		result = append(result, FfiConverterFrostSignatureShareINSTANCE.Read(reader))
+		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
+		value := FfiConverterFrostSecretKeyShareINSTANCE.Read(reader)
+		result[key] = value
 	}
 	return result
 }
 
-func (c FfiConverterSequenceFrostSignatureShare) Lower(value []FrostSignatureShare) C.RustBuffer {
-	return LowerIntoRustBuffer[[]FrostSignatureShare](c, value)
+func (c FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Lower(value map[ParticipantIdentifier]FrostSecretKeyShare) C.RustBuffer {
+	return LowerIntoRustBuffer[map[ParticipantIdentifier]FrostSecretKeyShare](c, value)
 }
 
-func (c FfiConverterSequenceFrostSignatureShare) Write(writer io.Writer, value []FrostSignatureShare) {
-	if len(value) > math.MaxInt32 {
-		panic("[]FrostSignatureShare is too large to fit into Int32")
+func (_ FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Write(writer io.Writer, mapValue map[ParticipantIdentifier]FrostSecretKeyShare) {
+	if len(mapValue) > math.MaxInt32 {
+		panic("map[ParticipantIdentifier]FrostSecretKeyShare is too large to fit into Int32")
 	}
 
-	writeInt32(writer, int32(len(value)))
-	for _, item := range value {
-		FfiConverterFrostSignatureShareINSTANCE.Write(writer, item)
+	writeInt32(writer, int32(len(mapValue)))
+	for key, value := range mapValue {
+		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
+		FfiConverterFrostSecretKeyShareINSTANCE.Write(writer, value)
+	}
+}
+
+type FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare struct{}
+
+func (_ FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare) Destroy(mapValue map[ParticipantIdentifier]FrostSecretKeyShare) {
+	for key, value := range mapValue {
+		FfiDestroyerParticipantIdentifier{}.Destroy(key)
+		FfiDestroyerFrostSecretKeyShare{}.Destroy(value)
+	}
+}
+
+func Aggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage, randomizer FrostRandomizer) (FrostSignature, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_aggregate(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterSequenceFrostSignatureShareINSTANCE.Lower(signatureShares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignature
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// returns Raw Signing commitnments using serde_json
+// WARNING: The identifier you have in the `FrostSigningCommitments`
+// is not an original field of `SigningCommitments`, we've included
+// them as a nice-to-have.
+func CommitmentToJson(commitment FrostSigningCommitments) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_commitment_to_json(FfiConverterFrostSigningCommitmentsINSTANCE.Lower(commitment), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerSequenceFrostSignatureShare struct{}
-
-func (FfiDestroyerSequenceFrostSignatureShare) Destroy(sequence []FrostSignatureShare) {
-	for _, value := range sequence {
-		FfiDestroyerFrostSignatureShare{}.Destroy(value)
+// Trusted dealer refresh.
+// Computes a refreshing share for each of the participants, which all
+// hold a share of the group of `public_key_package`, along with the
+// public key package the group will have once they applied them with
+// `refresh_key_package`. The verifying key of the group doesn't change.
+//
+// Refreshing shares are secret: each one must be sent privately to the
+// participant it belongs to.
+func ComputeRefreshingSharesFrom(publicKeyPackage FrostPublicKeyPackage, minSigners uint16, participants ParticipantList) (TrustedKeyGeneration, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_compute_refreshing_shares_from(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), FfiConverterUint16INSTANCE.Lower(minSigners), FfiConverterParticipantListINSTANCE.Lower(participants), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue TrustedKeyGeneration
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterTrustedKeyGenerationINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterSequenceFrostSigningCommitments struct{}
-
-var FfiConverterSequenceFrostSigningCommitmentsINSTANCE = FfiConverterSequenceFrostSigningCommitments{}
-
-func (c FfiConverterSequenceFrostSigningCommitments) Lift(rb RustBufferI) []FrostSigningCommitments {
-	return LiftFromRustBuffer[[]FrostSigningCommitments](c, rb)
+// Serializes the secret package of round 1 to JSON so that a
+// participant can checkpoint a DKG and resume it after a restart.
+//
+// **This is secret material.** It must be stored encrypted and
+// deleted once the DKG is completed.
+func DkgRound1SecretPackageToJson(secretPackage *DkgRound1SecretPackage) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_dkg_round1_secret_package_to_json(FfiConverterDkgRound1SecretPackageINSTANCE.Lower(secretPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (c FfiConverterSequenceFrostSigningCommitments) Read(reader io.Reader) []FrostSigningCommitments {
-	length := readInt32(reader)
-	if length == 0 {
-		return nil
-	}
-	result := make([]FrostSigningCommitments, 0, length)
-	for i := int32(0); i < length; i++ {
-		result = append(result, FfiConverterFrostSigningCommitmentsINSTANCE.Read(reader))
+// Serializes the secret package of round 2 to JSON so that a
+// participant can checkpoint a DKG and resume it after a restart.
+//
+// **This is secret material.** It must be stored encrypted and
+// deleted once the DKG is completed.
+func DkgRound2SecretPackageToJson(secretPackage *DkgRound2SecretPackage) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_dkg_round2_secret_package_to_json(FfiConverterDkgRound2SecretPackageINSTANCE.Lower(secretPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
 }
 
-func (c FfiConverterSequenceFrostSigningCommitments) Lower(value []FrostSigningCommitments) C.RustBuffer {
-	return LowerIntoRustBuffer[[]FrostSigningCommitments](c, value)
+// Ed25519 aggregation. Unlike RedPallas aggregation, it takes no
+// randomizer.
+func Ed25519Aggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) (FrostSignature, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_aggregate(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterSequenceFrostSignatureShareINSTANCE.Lower(signatureShares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignature
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (c FfiConverterSequenceFrostSigningCommitments) Write(writer io.Writer, value []FrostSigningCommitments) {
-	if len(value) > math.MaxInt32 {
-		panic("[]FrostSigningCommitments is too large to fit into Int32")
+// Ed25519 counterpart of `generate_nonces_and_commitments`.
+func Ed25519GenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round1Error](FfiConverterRound1Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_generate_nonces_and_commitments(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FirstRoundCommitment
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFirstRoundCommitmentINSTANCE.Lift(_uniffiRV), nil
 	}
+}
 
-	writeInt32(writer, int32(len(value)))
-	for _, item := range value {
-		FfiConverterFrostSigningCommitmentsINSTANCE.Write(writer, item)
+// Ed25519 counterpart of `new_signing_package`.
+func Ed25519NewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_new_signing_package(FfiConverterMessageINSTANCE.Lower(message), FfiConverterSequenceFrostSigningCommitmentsINSTANCE.Lower(commitments), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSigningPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSigningPackageINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerSequenceFrostSigningCommitments struct{}
-
-func (FfiDestroyerSequenceFrostSigningCommitments) Destroy(sequence []FrostSigningCommitments) {
-	for _, value := range sequence {
-		FfiDestroyerFrostSigningCommitments{}.Destroy(value)
+// Ed25519 signing. Unlike RedPallas signing, it takes no randomizer.
+func Ed25519Sign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage) (FrostSignatureShare, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round2Error](FfiConverterRound2Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_sign(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSigningNoncesINSTANCE.Lower(nonces), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignatureShare
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureShareINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterSequenceParticipantIdentifier struct{}
-
-var FfiConverterSequenceParticipantIdentifierINSTANCE = FfiConverterSequenceParticipantIdentifier{}
-
-func (c FfiConverterSequenceParticipantIdentifier) Lift(rb RustBufferI) []ParticipantIdentifier {
-	return LiftFromRustBuffer[[]ParticipantIdentifier](c, rb)
+// Ed25519 counterpart of `trusted_dealer_keygen_from`.
+func Ed25519TrustedDealerKeygenFrom(configuration Configuration) (TrustedKeyGeneration, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_trusted_dealer_keygen_from(FfiConverterConfigurationINSTANCE.Lower(configuration), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue TrustedKeyGeneration
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterTrustedKeyGenerationINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (c FfiConverterSequenceParticipantIdentifier) Read(reader io.Reader) []ParticipantIdentifier {
-	length := readInt32(reader)
-	if length == 0 {
-		return nil
-	}
-	result := make([]ParticipantIdentifier, 0, length)
-	for i := int32(0); i < length; i++ {
-		result = append(result, FfiConverterParticipantIdentifierINSTANCE.Read(reader))
+// Ed25519 counterpart of `verify_and_get_key_package_from`.
+func Ed25519VerifyAndGetKeyPackageFrom(secretShare FrostSecretKeyShare) (FrostKeyPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_and_get_key_package_from(FfiConverterFrostSecretKeyShareINSTANCE.Lower(secretShare), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostKeyPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostKeyPackageINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
 }
 
-func (c FfiConverterSequenceParticipantIdentifier) Lower(value []ParticipantIdentifier) C.RustBuffer {
-	return LowerIntoRustBuffer[[]ParticipantIdentifier](c, value)
+// Ed25519 counterpart of `verify_signature`.
+func Ed25519VerifySignature(message Message, signature FrostSignature, pubkey FrostPublicKeyPackage) error {
+	_, _uniffiErr := rustCallWithError[FrostSignatureVerificationError](FfiConverterFrostSignatureVerificationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_signature(FfiConverterMessageINSTANCE.Lower(message), FfiConverterFrostSignatureINSTANCE.Lower(signature), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkey), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
 }
 
-func (c FfiConverterSequenceParticipantIdentifier) Write(writer io.Writer, value []ParticipantIdentifier) {
-	if len(value) > math.MaxInt32 {
-		panic("[]ParticipantIdentifier is too large to fit into Int32")
-	}
+// Verifies a single Ed25519 signature share against the verifying share
+// of its signer, so that a coordinator can reject it before aggregation.
+func Ed25519VerifySignatureShare(signingPackage FrostSigningPackage, signatureShare FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) error {
+	_, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_ed25519_verify_signature_share(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSignatureShareINSTANCE.Lower(signatureShare), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
 
-	writeInt32(writer, int32(len(value)))
-	for _, item := range value {
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, item)
+func FromHexString(hexString string) (FrostRandomizer, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_from_hex_string(FfiConverterStringINSTANCE.Lower(hexString), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostRandomizer
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerSequenceParticipantIdentifier struct{}
-
-func (FfiDestroyerSequenceParticipantIdentifier) Destroy(sequence []ParticipantIdentifier) {
-	for _, value := range sequence {
-		FfiDestroyerParticipantIdentifier{}.Destroy(value)
+func GenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round1Error](FfiConverterRound1Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_generate_nonces_and_commitments(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FirstRoundCommitment
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFirstRoundCommitmentINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterMapParticipantIdentifierString struct{}
-
-var FfiConverterMapParticipantIdentifierStringINSTANCE = FfiConverterMapParticipantIdentifierString{}
-
-func (c FfiConverterMapParticipantIdentifierString) Lift(rb RustBufferI) map[ParticipantIdentifier]string {
-	return LiftFromRustBuffer[map[ParticipantIdentifier]string](c, rb)
+func IdentifierFromJsonString(string string) *ParticipantIdentifier {
+	return FfiConverterOptionalParticipantIdentifierINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_json_string(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus),
+		}
+	}))
 }
 
-func (_ FfiConverterMapParticipantIdentifierString) Read(reader io.Reader) map[ParticipantIdentifier]string {
-	result := make(map[ParticipantIdentifier]string)
-	length := readInt32(reader)
-	for i := int32(0); i < length; i++ {
-		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
-		value := FfiConverterStringINSTANCE.Read(reader)
-		result[key] = value
+func IdentifierFromString(string string) (ParticipantIdentifier, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_string(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue ParticipantIdentifier
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterParticipantIdentifierINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
-}
-
-func (c FfiConverterMapParticipantIdentifierString) Lower(value map[ParticipantIdentifier]string) C.RustBuffer {
-	return LowerIntoRustBuffer[map[ParticipantIdentifier]string](c, value)
 }
 
-func (_ FfiConverterMapParticipantIdentifierString) Write(writer io.Writer, mapValue map[ParticipantIdentifier]string) {
-	if len(mapValue) > math.MaxInt32 {
-		panic("map[ParticipantIdentifier]string is too large to fit into Int32")
+func IdentifierFromUint16(unsignedUint uint16) (ParticipantIdentifier, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_uint16(FfiConverterUint16INSTANCE.Lower(unsignedUint), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue ParticipantIdentifier
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterParticipantIdentifierINSTANCE.Lift(_uniffiRV), nil
 	}
+}
 
-	writeInt32(writer, int32(len(mapValue)))
-	for key, value := range mapValue {
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
-		FfiConverterStringINSTANCE.Write(writer, value)
+func JsonToCommitment(commitmentJson string, identifier ParticipantIdentifier) (FrostSigningCommitments, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_commitment(FfiConverterStringINSTANCE.Lower(commitmentJson), FfiConverterParticipantIdentifierINSTANCE.Lower(identifier), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSigningCommitments
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSigningCommitmentsINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerMapParticipantIdentifierString struct{}
-
-func (_ FfiDestroyerMapParticipantIdentifierString) Destroy(mapValue map[ParticipantIdentifier]string) {
-	for key, value := range mapValue {
-		FfiDestroyerParticipantIdentifier{}.Destroy(key)
-		FfiDestroyerString{}.Destroy(value)
+// Restores a secret package of round 1 serialized with
+// `dkg_round1_secret_package_to_json`.
+func JsonToDkgRound1SecretPackage(secretPackageJson string) (*DkgRound1SecretPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_json_to_dkg_round1_secret_package(FfiConverterStringINSTANCE.Lower(secretPackageJson), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgRound1SecretPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgRound1SecretPackageINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterMapParticipantIdentifierDkgRound1Package struct{}
-
-var FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE = FfiConverterMapParticipantIdentifierDkgRound1Package{}
-
-func (c FfiConverterMapParticipantIdentifierDkgRound1Package) Lift(rb RustBufferI) map[ParticipantIdentifier]DkgRound1Package {
-	return LiftFromRustBuffer[map[ParticipantIdentifier]DkgRound1Package](c, rb)
-}
-
-func (_ FfiConverterMapParticipantIdentifierDkgRound1Package) Read(reader io.Reader) map[ParticipantIdentifier]DkgRound1Package {
-	result := make(map[ParticipantIdentifier]DkgRound1Package)
-	length := readInt32(reader)
-	for i := int32(0); i < length; i++ {
-		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
-		value := FfiConverterDkgRound1PackageINSTANCE.Read(reader)
-		result[key] = value
+// Restores a secret package of round 2 serialized with
+// `dkg_round2_secret_package_to_json`.
+func JsonToDkgRound2SecretPackage(secretPackageJson string) (*DkgRound2SecretPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_json_to_dkg_round2_secret_package(FfiConverterStringINSTANCE.Lower(secretPackageJson), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgRound2SecretPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgRound2SecretPackageINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
-}
-
-func (c FfiConverterMapParticipantIdentifierDkgRound1Package) Lower(value map[ParticipantIdentifier]DkgRound1Package) C.RustBuffer {
-	return LowerIntoRustBuffer[map[ParticipantIdentifier]DkgRound1Package](c, value)
 }
 
-func (_ FfiConverterMapParticipantIdentifierDkgRound1Package) Write(writer io.Writer, mapValue map[ParticipantIdentifier]DkgRound1Package) {
-	if len(mapValue) > math.MaxInt32 {
-		panic("map[ParticipantIdentifier]DkgRound1Package is too large to fit into Int32")
+func JsonToKeyPackage(keyPackageJson string) (FrostKeyPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_key_package(FfiConverterStringINSTANCE.Lower(keyPackageJson), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostKeyPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostKeyPackageINSTANCE.Lift(_uniffiRV), nil
 	}
+}
 
-	writeInt32(writer, int32(len(mapValue)))
-	for key, value := range mapValue {
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
-		FfiConverterDkgRound1PackageINSTANCE.Write(writer, value)
+func JsonToPublicKeyPackage(publicKeyPackageJson string) (FrostPublicKeyPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_public_key_package(FfiConverterStringINSTANCE.Lower(publicKeyPackageJson), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostPublicKeyPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostPublicKeyPackageINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerMapParticipantIdentifierDkgRound1Package struct{}
-
-func (_ FfiDestroyerMapParticipantIdentifierDkgRound1Package) Destroy(mapValue map[ParticipantIdentifier]DkgRound1Package) {
-	for key, value := range mapValue {
-		FfiDestroyerParticipantIdentifier{}.Destroy(key)
-		FfiDestroyerDkgRound1Package{}.Destroy(value)
+func JsonToRandomizer(randomizerJson string) (FrostRandomizer, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_randomizer(FfiConverterStringINSTANCE.Lower(randomizerJson), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostRandomizer
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterMapParticipantIdentifierDkgRound2Package struct{}
-
-var FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE = FfiConverterMapParticipantIdentifierDkgRound2Package{}
-
-func (c FfiConverterMapParticipantIdentifierDkgRound2Package) Lift(rb RustBufferI) map[ParticipantIdentifier]DkgRound2Package {
-	return LiftFromRustBuffer[map[ParticipantIdentifier]DkgRound2Package](c, rb)
+func JsonToSignatureShare(signatureShareJson string, identifier ParticipantIdentifier) (FrostSignatureShare, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_signature_share(FfiConverterStringINSTANCE.Lower(signatureShareJson), FfiConverterParticipantIdentifierINSTANCE.Lower(identifier), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignatureShare
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureShareINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (_ FfiConverterMapParticipantIdentifierDkgRound2Package) Read(reader io.Reader) map[ParticipantIdentifier]DkgRound2Package {
-	result := make(map[ParticipantIdentifier]DkgRound2Package)
-	length := readInt32(reader)
-	for i := int32(0); i < length; i++ {
-		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
-		value := FfiConverterDkgRound2PackageINSTANCE.Read(reader)
-		result[key] = value
+func KeyPackageToJson(keyPackage FrostKeyPackage) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_key_package_to_json(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
 }
 
-func (c FfiConverterMapParticipantIdentifierDkgRound2Package) Lower(value map[ParticipantIdentifier]DkgRound2Package) C.RustBuffer {
-	return LowerIntoRustBuffer[map[ParticipantIdentifier]DkgRound2Package](c, value)
+func NewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_new_signing_package(FfiConverterMessageINSTANCE.Lower(message), FfiConverterSequenceFrostSigningCommitmentsINSTANCE.Lower(commitments), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSigningPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSigningPackageINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (_ FfiConverterMapParticipantIdentifierDkgRound2Package) Write(writer io.Writer, mapValue map[ParticipantIdentifier]DkgRound2Package) {
-	if len(mapValue) > math.MaxInt32 {
-		panic("map[ParticipantIdentifier]DkgRound2Package is too large to fit into Int32")
+// Parses a PCZT (partially created Zcash transaction) handed to the signer
+// role and returns what its Orchard spend authorization signatures are
+// computed over.
+//
+// Returns [`OrchardKeyError::DeserializationError`] when `pczt` can't be
+// parsed and [`OrchardKeyError::OtherError`] when it isn't ready to be
+// signed, e.g. because it lacks the data the sighash commits to.
+func OrchardSpendAuthFromPczt(pczt []byte) (PcztOrchardSpendAuth, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_orchard_spend_auth_from_pczt(FfiConverterBytesINSTANCE.Lower(pczt), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue PcztOrchardSpendAuth
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterPcztOrchardSpendAuthINSTANCE.Lift(_uniffiRV), nil
 	}
+}
 
-	writeInt32(writer, int32(len(mapValue)))
-	for key, value := range mapValue {
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
-		FfiConverterDkgRound2PackageINSTANCE.Write(writer, value)
+func Part1(participantIdentifier ParticipantIdentifier, maxSigners uint16, minSigners uint16) (*DkgPart1Result, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_part_1(FfiConverterParticipantIdentifierINSTANCE.Lower(participantIdentifier), FfiConverterUint16INSTANCE.Lower(maxSigners), FfiConverterUint16INSTANCE.Lower(minSigners), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgPart1Result
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgPart1ResultINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerMapParticipantIdentifierDkgRound2Package struct{}
-
-func (_ FfiDestroyerMapParticipantIdentifierDkgRound2Package) Destroy(mapValue map[ParticipantIdentifier]DkgRound2Package) {
-	for key, value := range mapValue {
-		FfiDestroyerParticipantIdentifier{}.Destroy(key)
-		FfiDestroyerDkgRound2Package{}.Destroy(value)
+// DKG Part 2
+// receives a SecretPackage from round one generated by the same
+// participant and kept in-memory (and secretly) until now.
+// It also receives the round 1 packages corresponding to all the
+// other participants **except** itself.
+//
+// Example: if P1, P2 and P3 are doing DKG, then when P1 runs part_2
+// this will receive a secret generated by P1 in part_1 and the
+// round 1 packages from P2 and P3. Everyone else has to do the same.
+//
+// For part_3 the P1 will send the round 2 packages generated here to
+// the other participants P2 and P3 and should receive packages from
+// P2 and P3.
+func Part2(secretPackage *DkgRound1SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package) (*DkgPart2Result, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_part_2(FfiConverterDkgRound1SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgPart2Result
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgPart2ResultINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiConverterMapParticipantIdentifierFrostSecretKeyShare struct{}
-
-var FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE = FfiConverterMapParticipantIdentifierFrostSecretKeyShare{}
-
-func (c FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Lift(rb RustBufferI) map[ParticipantIdentifier]FrostSecretKeyShare {
-	return LiftFromRustBuffer[map[ParticipantIdentifier]FrostSecretKeyShare](c, rb)
+func Part3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package) (DkgPart3Result, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_part_3(FfiConverterDkgRound2SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE.Lower(round2Packages), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue DkgPart3Result
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgPart3ResultINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (_ FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Read(reader io.Reader) map[ParticipantIdentifier]FrostSecretKeyShare {
-	result := make(map[ParticipantIdentifier]FrostSecretKeyShare)
-	length := readInt32(reader)
-	for i := int32(0); i < length; i++ {
-		key := FfiConverterParticipantIdentifierINSTANCE.Read(reader)
-		value := FfiConverterFrostSecretKeyShareINSTANCE.Read(reader)
-		result[key] = value
+// Writes the `spend_auth_sig` of each of `actions` that has one in the
+// Orchard action of `pczt` at its `index`, and returns the updated PCZT.
+//
+// The `rk` of each action must be the one of the action of `pczt` at that
+// index and its signature must verify against it, otherwise
+// [`OrchardKeyError::OtherError`] is returned and no signature is written.
+func PcztWithOrchardSpendAuthSignatures(pczt []byte, actions []PcztOrchardAction) ([]byte, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[OrchardKeyError](FfiConverterOrchardKeyError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_pczt_with_orchard_spend_auth_signatures(FfiConverterBytesINSTANCE.Lower(pczt), FfiConverterSequencePcztOrchardActionINSTANCE.Lower(actions), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue []byte
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
 	}
-	return result
 }
 
-func (c FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Lower(value map[ParticipantIdentifier]FrostSecretKeyShare) C.RustBuffer {
-	return LowerIntoRustBuffer[map[ParticipantIdentifier]FrostSecretKeyShare](c, value)
+func PublicKeyPackageToJson(publicKeyPackage FrostPublicKeyPackage) (string, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_public_key_package_to_json(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue string
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func (_ FfiConverterMapParticipantIdentifierFrostSecretKeyShare) Write(writer io.Writer, mapValue map[ParticipantIdentifier]FrostSecretKeyShare) {
-	if len(mapValue) > math.MaxInt32 {
-		panic("map[ParticipantIdentifier]FrostSecretKeyShare is too large to fit into Int32")
+// Creates the [`FrostRandomizedParams`] of `public_key` for a randomizer
+// chosen by the caller instead of a random one, such as the one created
+// by [`randomizer_from_bytes`] out of the `alpha` of a Zcash action.
+func RandomizedParamsFromPublicKeyAndRandomizer(publicKey FrostPublicKeyPackage, randomizer FrostRandomizer) (*FrostRandomizedParams, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_randomized_params_from_public_key_and_randomizer(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKey), FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FrostRandomizedParams
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizedParamsINSTANCE.Lift(_uniffiRV), nil
 	}
+}
 
-	writeInt32(writer, int32(len(mapValue)))
-	for key, value := range mapValue {
-		FfiConverterParticipantIdentifierINSTANCE.Write(writer, key)
-		FfiConverterFrostSecretKeyShareINSTANCE.Write(writer, value)
+func RandomizedParamsFromPublicKeyAndSigningPackage(publicKey FrostPublicKeyPackage, signingPackage FrostSigningPackage) (*FrostRandomizedParams, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_randomized_params_from_public_key_and_signing_package(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKey), FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *FrostRandomizedParams
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizedParamsINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-type FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare struct{}
-
-func (_ FfiDestroyerMapParticipantIdentifierFrostSecretKeyShare) Destroy(mapValue map[ParticipantIdentifier]FrostSecretKeyShare) {
-	for key, value := range mapValue {
-		FfiDestroyerParticipantIdentifier{}.Destroy(key)
-		FfiDestroyerFrostSecretKeyShare{}.Destroy(value)
+// Creates a [`FrostRandomizer`] out of a randomizer chosen by the caller,
+// a 32-byte little-endian encoded scalar. Fails if it isn't a canonical
+// scalar.
+//
+// The randomizer of an Orchard action is its spend authorization
+// randomizer `alpha`, chosen by the transaction builder: the randomized
+// verifying key of a FROST group signing with it is the `rk` of the
+// action and its signature is the `spendAuthSig` of the action. Each
+// action of a bundle has its own `alpha` and needs its own signing round.
+func RandomizerFromBytes(bytes []byte) (FrostRandomizer, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_randomizer_from_bytes(FfiConverterBytesINSTANCE.Lower(bytes), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostRandomizer
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func Aggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage, randomizer FrostRandomizer) (FrostSignature, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+func RandomizerFromParams(randomizedParams *FrostRandomizedParams) (FrostRandomizer, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_aggregate(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterSequenceFrostSignatureShareINSTANCE.Lower(signatureShares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_randomizer_from_params(FfiConverterFrostRandomizedParamsINSTANCE.Lower(randomizedParams), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostSignature
+		var _uniffiDefaultValue FrostRandomizer
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-// returns Raw Signing commitnments using serde_json
-// WARNING: The identifier you have in the `FrostSigningCommitments`
-// is not an original field of `SigningCommitments`, we've included
-// them as a nice-to-have.
-func CommitmentToJson(commitment FrostSigningCommitments) (string, error) {
+func RandomizerToJson(randomizer FrostRandomizer) (string, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_commitment_to_json(FfiConverterFrostSigningCommitmentsINSTANCE.Lower(commitment), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_randomizer_to_json(FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
@@ -5238,88 +6822,142 @@ func CommitmentToJson(commitment FrostSigningCommitments) (string, error) {
 	}
 }
 
-func FromHexString(hexString string) (FrostRandomizer, error) {
+// Reconstructs the signing key of the group of `public_key_package` from
+// the key packages of at least as many participants as required to sign.
+// Each key package is checked against `public_key_package` and so is the
+// reconstructed key. Returns the serialized signing key, which can be used
+// as the `secret` of a `Configuration` to deal new shares of the group.
+//
+// WARNING: the signing key is no longer protected by the threshold once
+// reconstructed. This is only meant for disaster recovery, in an
+// air-gapped environment.
+func ReconstructSigningKey(keyPackages []FrostKeyPackage, publicKeyPackage FrostPublicKeyPackage) ([]byte, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_from_hex_string(FfiConverterStringINSTANCE.Lower(hexString), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_reconstruct_signing_key(FfiConverterSequenceFrostKeyPackageINSTANCE.Lower(keyPackages), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostRandomizer
+		var _uniffiDefaultValue []byte
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterBytesINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func GenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[Round1Error](FfiConverterRound1Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+// Applies a refreshing share computed by `compute_refreshing_shares_from`
+// to the current key package of its participant, which must be
+// discarded in favour of the returned one.
+func RefreshKeyPackage(refreshingShare FrostSecretKeyShare, keyPackage FrostKeyPackage) (FrostKeyPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_generate_nonces_and_commitments(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_refresh_key_package(FfiConverterFrostSecretKeyShareINSTANCE.Lower(refreshingShare), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FirstRoundCommitment
+		var _uniffiDefaultValue FrostKeyPackage
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFirstRoundCommitmentINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostKeyPackageINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func IdentifierFromJsonString(string string) *ParticipantIdentifier {
-	return FfiConverterOptionalParticipantIdentifierINSTANCE.Lift(rustCall(func(_uniffiStatus *C.RustCallStatus) RustBufferI {
-		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_json_string(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus),
-		}
-	}))
+// Distributed refresh Part 1.
+// Starts refreshing the share of `key_package` together with the other
+// participants of the group, `max_signers` in total. It works like
+// `part_1` of the DKG: the round 1 package must be broadcast to every
+// other participant.
+func RefreshPart1(keyPackage FrostKeyPackage, maxSigners uint16) (*DkgPart1Result, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_refresh_part_1(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), FfiConverterUint16INSTANCE.Lower(maxSigners), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgPart1Result
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgPart1ResultINSTANCE.Lift(_uniffiRV), nil
+	}
 }
 
-func IdentifierFromString(string string) (ParticipantIdentifier, error) {
+// Distributed refresh Part 2.
+// Works like `part_2` of the DKG, with the round 1 packages of all the
+// other participants **except** itself.
+func RefreshPart2(secretPackage *DkgRound1SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package) (*DkgPart2Result, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
+		return C.uniffi_frost_uniffi_sdk_fn_func_refresh_part_2(FfiConverterDkgRound1SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), _uniffiStatus)
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue *DkgPart2Result
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterDkgPart2ResultINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// Distributed refresh Part 3.
+// Works like `part_3` of the DKG, and also takes the current key package
+// of the participant and public key package of the group. Returns the
+// refreshed ones, which must replace them: the verifying key of the
+// group doesn't change.
+func RefreshPart3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package, publicKeyPackage FrostPublicKeyPackage, keyPackage FrostKeyPackage) (DkgPart3Result, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_string(FfiConverterStringINSTANCE.Lower(string), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_refresh_part_3(FfiConverterDkgRound2SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE.Lower(round2Packages), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue ParticipantIdentifier
+		var _uniffiDefaultValue DkgPart3Result
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterParticipantIdentifierINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterDkgPart3ResultINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func IdentifierFromUint16(unsignedUint uint16) (ParticipantIdentifier, error) {
+// Repair Step 1.
+// Run by each of the `helpers`, at least `min_signers` participants
+// holding `key_package`, to help `participant` recover its lost share.
+// Returns a delta for each helper, this one included, which must be
+// sent privately to the helper it is addressed to.
+func RepairShareStep1(helpers []ParticipantIdentifier, keyPackage FrostKeyPackage, participant ParticipantIdentifier) (map[ParticipantIdentifier]string, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_identifier_from_uint16(FfiConverterUint16INSTANCE.Lower(unsignedUint), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_repair_share_step_1(FfiConverterSequenceParticipantIdentifierINSTANCE.Lower(helpers), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), FfiConverterParticipantIdentifierINSTANCE.Lower(participant), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue ParticipantIdentifier
+		var _uniffiDefaultValue map[ParticipantIdentifier]string
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterParticipantIdentifierINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterMapParticipantIdentifierStringINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func JsonToCommitment(commitmentJson string, identifier ParticipantIdentifier) (FrostSigningCommitments, error) {
+// Repair Step 2.
+// Run by each helper with the deltas addressed to it by every helper,
+// itself included, by identifier of their sender. Returns the sigma to
+// send privately to the participant being repaired.
+func RepairShareStep2(deltas map[ParticipantIdentifier]string) (string, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_commitment(FfiConverterStringINSTANCE.Lower(commitmentJson), FfiConverterParticipantIdentifierINSTANCE.Lower(identifier), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_repair_share_step_2(FfiConverterMapParticipantIdentifierStringINSTANCE.Lower(deltas), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostSigningCommitments
+		var _uniffiDefaultValue string
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostSigningCommitmentsINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func JsonToKeyPackage(keyPackageJson string) (FrostKeyPackage, error) {
+// Repair Step 3.
+// Run by the participant being repaired with the sigmas of every helper,
+// by identifier of their sender. Returns its key package for the group of
+// `public_key_package`, checked against its verifying share.
+func RepairShareStep3(sigmas map[ParticipantIdentifier]string, participant ParticipantIdentifier, publicKeyPackage FrostPublicKeyPackage, minSigners uint16) (FrostKeyPackage, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_key_package(FfiConverterStringINSTANCE.Lower(keyPackageJson), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_repair_share_step_3(FfiConverterMapParticipantIdentifierStringINSTANCE.Lower(sigmas), FfiConverterParticipantIdentifierINSTANCE.Lower(participant), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), FfiConverterUint16INSTANCE.Lower(minSigners), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
@@ -5330,66 +6968,118 @@ func JsonToKeyPackage(keyPackageJson string) (FrostKeyPackage, error) {
 	}
 }
 
-func JsonToPublicKeyPackage(publicKeyPackageJson string) (FrostPublicKeyPackage, error) {
+// Reshare Step 1.
+// Run by each of the `old_signers`, at least as many as required to sign
+// in the current group, to hand out its share to the `new_participants`
+// of a group with the same verifying key and the given `configuration`.
+// Returns a share for each new participant, which must be sent privately
+// to the participant it belongs to.
+func ReshareStep1(keyPackage FrostKeyPackage, oldSigners []ParticipantIdentifier, configuration Configuration, newParticipants []ParticipantIdentifier) (map[ParticipantIdentifier]FrostSecretKeyShare, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_public_key_package(FfiConverterStringINSTANCE.Lower(publicKeyPackageJson), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_reshare_step_1(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), FfiConverterSequenceParticipantIdentifierINSTANCE.Lower(oldSigners), FfiConverterConfigurationINSTANCE.Lower(configuration), FfiConverterSequenceParticipantIdentifierINSTANCE.Lower(newParticipants), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostPublicKeyPackage
+		var _uniffiDefaultValue map[ParticipantIdentifier]FrostSecretKeyShare
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostPublicKeyPackageINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func JsonToRandomizer(randomizerJson string) (FrostRandomizer, error) {
+// Reshare Step 2.
+// Run by each of the `new_participants` with the shares it received
+// from every old signer, by identifier of their sender. Each share is
+// checked against the verifying share of its sender in the current
+// `public_key_package`. Returns the key package of `participant` and the
+// public key package of the new group, which has the same verifying key.
+//
+// New participants must make sure that they all derived the same public
+// key package before using their key packages.
+func ReshareStep2(shares map[ParticipantIdentifier]FrostSecretKeyShare, publicKeyPackage FrostPublicKeyPackage, configuration Configuration, newParticipants []ParticipantIdentifier, participant ParticipantIdentifier) (DkgPart3Result, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_randomizer(FfiConverterStringINSTANCE.Lower(randomizerJson), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_reshare_step_2(FfiConverterMapParticipantIdentifierFrostSecretKeyShareINSTANCE.Lower(shares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), FfiConverterConfigurationINSTANCE.Lower(configuration), FfiConverterSequenceParticipantIdentifierINSTANCE.Lower(newParticipants), FfiConverterParticipantIdentifierINSTANCE.Lower(participant), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostRandomizer
+		var _uniffiDefaultValue DkgPart3Result
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterDkgPart3ResultINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func JsonToSignatureShare(signatureShareJson string, identifier ParticipantIdentifier) (FrostSignatureShare, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+// secp256k1-TR aggregation. The signature is a 64-byte BIP-340 Schnorr
+// signature.
+func Secp256k1TrAggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) (FrostSignature, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_json_to_signature_share(FfiConverterStringINSTANCE.Lower(signatureShareJson), FfiConverterParticipantIdentifierINSTANCE.Lower(identifier), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_aggregate(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterSequenceFrostSignatureShareINSTANCE.Lower(signatureShares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostSignatureShare
+		var _uniffiDefaultValue FrostSignature
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostSignatureShareINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func KeyPackageToJson(keyPackage FrostKeyPackage) (string, error) {
+// secp256k1-TR counterpart of `aggregate_with_tweak`: aggregates the
+// signature shares of `secp256k1_tr_sign_with_tweak` into a BIP-340
+// signature by the output key tweaked with `merkle_root`.
+func Secp256k1TrAggregateWithTweak(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage, merkleRoot *[]byte) (FrostSignature, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_aggregate_with_tweak(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterSequenceFrostSignatureShareINSTANCE.Lower(signatureShares), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), FfiConverterOptionalBytesINSTANCE.Lower(merkleRoot), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignature
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// secp256k1-TR counterpart of `generate_nonces_and_commitments`.
+func Secp256k1TrGenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round1Error](FfiConverterRound1Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_generate_nonces_and_commitments(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FirstRoundCommitment
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFirstRoundCommitmentINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// secp256k1-TR counterpart of `identifier_from_uint16`.
+func Secp256k1TrIdentifierFromUint16(unsignedUint uint16) (ParticipantIdentifier, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_key_package_to_json(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_identifier_from_uint16(FfiConverterUint16INSTANCE.Lower(unsignedUint), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue string
+		var _uniffiDefaultValue ParticipantIdentifier
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterParticipantIdentifierINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func NewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
+// secp256k1-TR counterpart of `new_signing_package`. The message is
+// usually a BIP-341 signature hash.
+func Secp256k1TrNewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_new_signing_package(FfiConverterMessageINSTANCE.Lower(message), FfiConverterSequenceFrostSigningCommitmentsINSTANCE.Lower(commitments), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_new_signing_package(FfiConverterMessageINSTANCE.Lower(message), FfiConverterSequenceFrostSigningCommitmentsINSTANCE.Lower(commitments), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
@@ -5400,9 +7090,11 @@ func NewSigningPackage(message Message, commitments []FrostSigningCommitments) (
 	}
 }
 
-func Part1(participantIdentifier ParticipantIdentifier, maxSigners uint16, minSigners uint16) (*DkgPart1Result, error) {
+// secp256k1-TR counterpart of DKG `part_1`. The identifier must come from
+// `secp256k1_tr_identifier_from_uint16`.
+func Secp256k1TrPart1(participantIdentifier ParticipantIdentifier, maxSigners uint16, minSigners uint16) (*DkgPart1Result, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_frost_uniffi_sdk_fn_func_part_1(FfiConverterParticipantIdentifierINSTANCE.Lower(participantIdentifier), FfiConverterUint16INSTANCE.Lower(maxSigners), FfiConverterUint16INSTANCE.Lower(minSigners), _uniffiStatus)
+		return C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_1(FfiConverterParticipantIdentifierINSTANCE.Lower(participantIdentifier), FfiConverterUint16INSTANCE.Lower(maxSigners), FfiConverterUint16INSTANCE.Lower(minSigners), _uniffiStatus)
 	})
 	if _uniffiErr != nil {
 		var _uniffiDefaultValue *DkgPart1Result
@@ -5412,22 +7104,10 @@ func Part1(participantIdentifier ParticipantIdentifier, maxSigners uint16, minSi
 	}
 }
 
-// DKG Part 2
-// receives a SecretPackage from round one generated by the same
-// participant and kept in-memory (and secretly) until now.
-// It also receives the round 1 packages corresponding to all the
-// other participants **except** itself.
-//
-// Example: if P1, P2 and P3 are doing DKG, then when P1 runs part_2
-// this will receive a secret generated by P1 in part_1 and the
-// round 1 packages from P2 and P3. Everyone else has to do the same.
-//
-// For part_3 the P1 will send the round 2 packages generated here to
-// the other participants P2 and P3 and should receive packages from
-// P2 and P3.
-func Part2(secretPackage *DkgRound1SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package) (*DkgPart2Result, error) {
+// secp256k1-TR counterpart of DKG `part_2`.
+func Secp256k1TrPart2(secretPackage *DkgRound1SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package) (*DkgPart2Result, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_frost_uniffi_sdk_fn_func_part_2(FfiConverterDkgRound1SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), _uniffiStatus)
+		return C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_2(FfiConverterDkgRound1SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), _uniffiStatus)
 	})
 	if _uniffiErr != nil {
 		var _uniffiDefaultValue *DkgPart2Result
@@ -5437,10 +7117,11 @@ func Part2(secretPackage *DkgRound1SecretPackage, round1Packages map[Participant
 	}
 }
 
-func Part3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package) (DkgPart3Result, error) {
+// secp256k1-TR counterpart of DKG `part_3`.
+func Secp256k1TrPart3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package) (DkgPart3Result, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_part_3(FfiConverterDkgRound2SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE.Lower(round2Packages), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_part_3(FfiConverterDkgRound2SecretPackageINSTANCE.Lower(secretPackage), FfiConverterMapParticipantIdentifierDkgRound1PackageINSTANCE.Lower(round1Packages), FfiConverterMapParticipantIdentifierDkgRound2PackageINSTANCE.Lower(round2Packages), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
@@ -5451,60 +7132,108 @@ func Part3(secretPackage *DkgRound2SecretPackage, round1Packages map[Participant
 	}
 }
 
-func PublicKeyPackageToJson(publicKeyPackage FrostPublicKeyPackage) (string, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+// secp256k1-TR signing. Like Ed25519 signing, it takes no randomizer.
+func Secp256k1TrSign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage) (FrostSignatureShare, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round2Error](FfiConverterRound2Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_public_key_package_to_json(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKeyPackage), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_sign(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSigningNoncesINSTANCE.Lower(nonces), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue string
+		var _uniffiDefaultValue FrostSignatureShare
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostSignatureShareINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func RandomizedParamsFromPublicKeyAndSigningPackage(publicKey FrostPublicKeyPackage, signingPackage FrostSigningPackage) (*FrostRandomizedParams, error) {
-	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) unsafe.Pointer {
-		return C.uniffi_frost_uniffi_sdk_fn_func_randomized_params_from_public_key_and_signing_package(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(publicKey), FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), _uniffiStatus)
+// secp256k1-TR counterpart of `sign_with_tweak`: signs with the key
+// package tweaked with `merkle_root`, like
+// `secp256k1_tr_tweak_public_key_package`.
+func Secp256k1TrSignWithTweak(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage, merkleRoot *[]byte) (FrostSignatureShare, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round2Error](FfiConverterRound2Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_sign_with_tweak(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSigningNoncesINSTANCE.Lower(nonces), FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), FfiConverterOptionalBytesINSTANCE.Lower(merkleRoot), _uniffiStatus),
+		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue *FrostRandomizedParams
+		var _uniffiDefaultValue FrostSignatureShare
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostRandomizedParamsINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostSignatureShareINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func RandomizerFromParams(randomizedParams *FrostRandomizedParams) (FrostRandomizer, error) {
+// secp256k1-TR counterpart of `trusted_dealer_keygen_from`.
+func Secp256k1TrTrustedDealerKeygenFrom(configuration Configuration) (TrustedKeyGeneration, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_randomizer_from_params(FfiConverterFrostRandomizedParamsINSTANCE.Lower(randomizedParams), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_trusted_dealer_keygen_from(FfiConverterConfigurationINSTANCE.Lower(configuration), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue FrostRandomizer
+		var _uniffiDefaultValue TrustedKeyGeneration
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterFrostRandomizerINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterTrustedKeyGenerationINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
-func RandomizerToJson(randomizer FrostRandomizer) (string, error) {
+// secp256k1-TR counterpart of `PublicKeyPackage::tweak`: the public key
+// package of the group tweaked with `merkle_root`, the root of the script
+// tree of a Taproot output, or with none for a BIP-86 output without
+// scripts. Its verifying key is the output key, which the signatures of
+// `secp256k1_tr_aggregate_with_tweak` verify against, and its verifying
+// shares verify the signature shares of `secp256k1_tr_sign_with_tweak`.
+func Secp256k1TrTweakPublicKeyPackage(pubkeyPackage FrostPublicKeyPackage, merkleRoot *[]byte) (FrostPublicKeyPackage, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
-			inner: C.uniffi_frost_uniffi_sdk_fn_func_randomizer_to_json(FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus),
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_tweak_public_key_package(FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), FfiConverterOptionalBytesINSTANCE.Lower(merkleRoot), _uniffiStatus),
 		}
 	})
 	if _uniffiErr != nil {
-		var _uniffiDefaultValue string
+		var _uniffiDefaultValue FrostPublicKeyPackage
 		return _uniffiDefaultValue, _uniffiErr
 	} else {
-		return FfiConverterStringINSTANCE.Lift(_uniffiRV), nil
+		return FfiConverterFrostPublicKeyPackageINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
+// secp256k1-TR counterpart of `verify_and_get_key_package_from`.
+func Secp256k1TrVerifyAndGetKeyPackageFrom(secretShare FrostSecretKeyShare) (FrostKeyPackage, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_and_get_key_package_from(FfiConverterFrostSecretKeyShareINSTANCE.Lower(secretShare), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostKeyPackage
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostKeyPackageINSTANCE.Lift(_uniffiRV), nil
 	}
 }
 
+// Verifies a BIP-340 signature of `message` by the group of `pubkey`.
+func Secp256k1TrVerifySignature(message Message, signature FrostSignature, pubkey FrostPublicKeyPackage) error {
+	_, _uniffiErr := rustCallWithError[FrostSignatureVerificationError](FfiConverterFrostSignatureVerificationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_signature(FfiConverterMessageINSTANCE.Lower(message), FfiConverterFrostSignatureINSTANCE.Lower(signature), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkey), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Verifies a single secp256k1-TR signature share against the verifying
+// share of its signer, so that a coordinator can reject it before
+// aggregation.
+func Secp256k1TrVerifySignatureShare(signingPackage FrostSigningPackage, signatureShare FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) error {
+	_, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_secp256k1_tr_verify_signature_share(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSignatureShareINSTANCE.Lower(signatureShare), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
 func Sign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage, randomizer FrostRandomizer) (FrostSignatureShare, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[Round2Error](FfiConverterRound2Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
@@ -5519,6 +7248,25 @@ func Sign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPack
 	}
 }
 
+// Signs `message` with the signing share of `key_package` alone, outside
+// of a FROST signing session. The signature verifies against the
+// verifying share of the participant with
+// `verify_signature_with_verifying_share`, which lets a coordinator
+// authenticate the requests of its signers.
+func SignWithSigningShare(keyPackage FrostKeyPackage, message Message) (FrostSignature, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[Round2Error](FfiConverterRound2Error{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_sign_with_signing_share(FfiConverterFrostKeyPackageINSTANCE.Lower(keyPackage), FfiConverterMessageINSTANCE.Lower(message), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue FrostSignature
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterFrostSignatureINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 func SignatureSharePackageToJson(signatureShare FrostSignatureShare) (string, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
@@ -5533,6 +7281,22 @@ func SignatureSharePackageToJson(signatureShare FrostSignatureShare) (string, er
 	}
 }
 
+// Returns the message that `signing_package` signs, so that a signer can
+// check that it is the message it agreed to sign before using its nonces.
+func SigningPackageMessage(signingPackage FrostSigningPackage) (Message, error) {
+	_uniffiRV, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
+		return GoRustBuffer{
+			inner: C.uniffi_frost_uniffi_sdk_fn_func_signing_package_message(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), _uniffiStatus),
+		}
+	})
+	if _uniffiErr != nil {
+		var _uniffiDefaultValue Message
+		return _uniffiDefaultValue, _uniffiErr
+	} else {
+		return FfiConverterMessageINSTANCE.Lift(_uniffiRV), nil
+	}
+}
+
 func TrustedDealerKeygenFrom(configuration Configuration) (TrustedKeyGeneration, error) {
 	_uniffiRV, _uniffiErr := rustCallWithError[FrostError](FfiConverterFrostError{}, func(_uniffiStatus *C.RustCallStatus) RustBufferI {
 		return GoRustBuffer{
@@ -5598,3 +7362,35 @@ func VerifySignature(message Message, signature FrostSignature, pubkey FrostPubl
 	})
 	return _uniffiErr.AsError()
 }
+
+// Verifies a single signature share against the verifying share of its
+// signer, re-randomized with the randomizer of the signing round, so that
+// a coordinator can reject it before aggregation.
+func VerifySignatureShare(signingPackage FrostSigningPackage, signatureShare FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage, randomizer FrostRandomizer) error {
+	_, _uniffiErr := rustCallWithError[CoordinationError](FfiConverterCoordinationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_verify_signature_share(FfiConverterFrostSigningPackageINSTANCE.Lower(signingPackage), FfiConverterFrostSignatureShareINSTANCE.Lower(signatureShare), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), FfiConverterFrostRandomizerINSTANCE.Lower(randomizer), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Verifies a signature of `message` against a randomized verifying key
+// `rk` alone, such as the `rk` of a Zcash action, without the public key
+// package of the group that signed it.
+func VerifySignatureWithRandomizedVerifyingKey(randomizedVerifyingKey []byte, message Message, signature FrostSignature) error {
+	_, _uniffiErr := rustCallWithError[FrostSignatureVerificationError](FfiConverterFrostSignatureVerificationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_verify_signature_with_randomized_verifying_key(FfiConverterBytesINSTANCE.Lower(randomizedVerifyingKey), FfiConverterMessageINSTANCE.Lower(message), FfiConverterFrostSignatureINSTANCE.Lower(signature), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
+
+// Verifies a signature of `message` made by `sign_with_signing_share`
+// against the verifying share of `identifier` in `pubkey_package`.
+func VerifySignatureWithVerifyingShare(message Message, signature FrostSignature, identifier ParticipantIdentifier, pubkeyPackage FrostPublicKeyPackage) error {
+	_, _uniffiErr := rustCallWithError[FrostSignatureVerificationError](FfiConverterFrostSignatureVerificationError{}, func(_uniffiStatus *C.RustCallStatus) bool {
+		C.uniffi_frost_uniffi_sdk_fn_func_verify_signature_with_verifying_share(FfiConverterMessageINSTANCE.Lower(message), FfiConverterFrostSignatureINSTANCE.Lower(signature), FfiConverterParticipantIdentifierINSTANCE.Lower(identifier), FfiConverterFrostPublicKeyPackageINSTANCE.Lower(pubkeyPackage), _uniffiStatus)
+		return false
+	})
+	return _uniffiErr.AsError()
+}
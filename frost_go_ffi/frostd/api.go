@@ -0,0 +1,144 @@
+// Package frostd talks to frostd, the FROST coordination server of the
+// Zcash Foundation described in the FROST Book, and runs the DKG and
+// signing ceremonies of the frost_uniffi_sdk bindings through it.
+//
+// Users of a server are identified by the public part of their
+// communication key pair, a CommKeyPair. They log in by signing a
+// challenge, then meet in sessions created by a coordinator, where they
+// exchange messages that the server relays without being able to read
+// them: every message is encrypted end to end by a Cipher.
+//
+// Every request is a POST with a JSON body to one of these paths:
+//
+//	/challenge           challenge to sign to log in
+//	/login               access token for a signed challenge
+//	/logout              invalidate the access token
+//	/create_new_session  create a session with a set of users
+//	/list_sessions       sessions the user is part of
+//	/get_session_info    users and coordinator of a session
+//	/send                send a message to users of a session
+//	/receive             receive the messages sent to the user
+//	/close_session       close a session, by its coordinator
+//
+// Only the server API is the one of frostd. The ceremonies run by RunDkg,
+// CoordinateSigning and JoinSigning are a protocol of this package: the
+// plaintext of their messages and the identifiers of DkgIdentifiers are
+// not those of frost-client, the command line client of frostd, so every
+// user of a ceremony must run this package. The key packages and
+// signatures they produce are regular FROST ones.
+package frostd
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// HexBytes are binary values hex encoded on the wire.
+type HexBytes []byte
+
+func (b HexBytes) MarshalText() ([]byte, error) {
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	decoded, err := hex.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+	*b = decoded
+	return nil
+}
+
+// ChallengeResponse is the body returned by /challenge.
+type ChallengeResponse struct {
+	Challenge string `json:"challenge"`
+}
+
+// LoginRequest is the body of /login. Signature is the XEdDSA signature
+// of the 16 bytes of the challenge UUID.
+type LoginRequest struct {
+	Challenge string    `json:"challenge"`
+	PublicKey PublicKey `json:"pubkey"`
+	Signature HexBytes  `json:"signature"`
+}
+
+// LoginResponse is the body returned by /login.
+type LoginResponse struct {
+	AccessToken string `json:"access_token"`
+}
+
+// CreateNewSessionRequest is the body of /create_new_session.
+type CreateNewSessionRequest struct {
+	PublicKeys   []PublicKey `json:"pubkeys"`
+	MessageCount uint8       `json:"message_count"`
+}
+
+// CreateNewSessionResponse is the body returned by /create_new_session.
+type CreateNewSessionResponse struct {
+	SessionID string `json:"session_id"`
+}
+
+// ListSessionsResponse is the body returned by /list_sessions.
+type ListSessionsResponse struct {
+	SessionIDs []string `json:"session_ids"`
+}
+
+// SessionRequest is the body of /get_session_info and /close_session.
+type SessionRequest struct {
+	SessionID string `json:"session_id"`
+}
+
+// SessionInfo is the body returned by /get_session_info.
+type SessionInfo struct {
+	MessageCount         uint8       `json:"message_count"`
+	PublicKeys           []PublicKey `json:"pubkeys"`
+	CoordinatorPublicKey PublicKey   `json:"coordinator_pubkey"`
+}
+
+// SendRequest is the body of /send. An empty list of recipients sends the
+// message to the coordinator of the session.
+type SendRequest struct {
+	SessionID  string      `json:"session_id"`
+	Recipients []PublicKey `json:"recipients"`
+	Message    HexBytes    `json:"msg"`
+}
+
+// ReceiveRequest is the body of /receive. The coordinator of a session
+// receives the messages sent to it with AsCoordinator.
+type ReceiveRequest struct {
+	SessionID     string `json:"session_id"`
+	AsCoordinator bool   `json:"as_coordinator"`
+}
+
+// Msg is a message relayed by the server.
+type Msg struct {
+	Sender  PublicKey `json:"sender"`
+	Message HexBytes  `json:"msg"`
+}
+
+// ReceiveResponse is the body returned by /receive. Messages are returned
+// once, in the order they were sent.
+type ReceiveResponse struct {
+	Messages []Msg `json:"msgs"`
+}
+
+// ErrorCode tells apart the failures reported by the server.
+type ErrorCode uint32
+
+const (
+	ErrorCodeInvalidArgument ErrorCode = 1
+	ErrorCodeUnauthorized    ErrorCode = 2
+	ErrorCodeSessionNotFound ErrorCode = 3
+	ErrorCodeNotCoordinator  ErrorCode = 4
+	ErrorCodeNotInSession    ErrorCode = 5
+)
+
+// ErrorResponse is the body of every response with an error status.
+type ErrorResponse struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"msg"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("frostd: error %d: %s", e.Code, e.Message)
+}
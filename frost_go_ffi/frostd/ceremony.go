@@ -0,0 +1,497 @@
+package frostd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// Err* are used for checking ceremony errors with `errors.Is`
+var ErrUserNotInSession = errors.New("user is not part of the session")
+var ErrUnexpectedMessage = errors.New("unexpected message in the session")
+var ErrSigningDeclined = errors.New("signing declined")
+var ErrUnexpectedSigningPackage = errors.New("signing package is not made of the message and the commitment of the signer")
+
+// PeerError is returned when a message received from a user of a session
+// is rejected.
+type PeerError struct {
+	PublicKey PublicKey
+	Err       error
+}
+
+func (e *PeerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.PublicKey)
+}
+
+func (e *PeerError) Unwrap() error {
+	return e.Err
+}
+
+// pollInterval is the delay between /receive requests while waiting for
+// the messages of the other users of a session.
+const pollInterval = 100 * time.Millisecond
+
+// The kinds of the messages exchanged in the ceremonies.
+const (
+	kindDkgRound1      = "dkg_round1"
+	kindDkgRound1Echo  = "dkg_round1_echo"
+	kindDkgRound2      = "dkg_round2"
+	kindCommitment     = "commitment"
+	kindSigningPackage = "signing_package"
+	kindSignatureShare = "signature_share"
+)
+
+// ceremonyMessage is the plaintext of the messages exchanged in the
+// ceremonies, as JSON. Senders are identified by their communication key,
+// never by the content of their messages. This envelope is specific to
+// this package, frost-client can't read it.
+type ceremonyMessage struct {
+	Kind           string               `json:"kind"`
+	Package        []byte               `json:"package,omitempty"`
+	Echo           *frost.DkgRound1Echo `json:"echo,omitempty"`
+	Commitment     json.RawMessage      `json:"commitment,omitempty"`
+	Message        []byte               `json:"message,omitempty"`
+	SigningPackage []byte               `json:"signing_package,omitempty"`
+	Randomizer     json.RawMessage      `json:"randomizer,omitempty"`
+	// Commitments the signing package is made of, next to Message
+	Commitments    []signerCommitment `json:"commitments,omitempty"`
+	SignatureShare json.RawMessage    `json:"signature_share,omitempty"`
+}
+
+// signerCommitment is the commitment of the signer of Identifier, in the
+// JSON formats of ParticipantIdentifier.Data and CommitmentToJson.
+type signerCommitment struct {
+	Identifier json.RawMessage `json:"identifier"`
+	Commitment json.RawMessage `json:"commitment"`
+}
+
+// ceremonySession sends and receives the encrypted messages of a session.
+type ceremonySession struct {
+	client        *Client
+	id            string
+	cipher        *Cipher
+	asCoordinator bool
+}
+
+// send encrypts m for recipient and sends it, in the queue of the
+// coordinator of the session with toCoordinator.
+func (s *ceremonySession) send(ctx context.Context, recipient PublicKey, toCoordinator bool, m ceremonyMessage) error {
+	plaintext, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	ciphertext, err := s.cipher.Encrypt(recipient, plaintext)
+	if err != nil {
+		return err
+	}
+
+	var recipients []PublicKey
+	if !toCoordinator {
+		recipients = []PublicKey{recipient}
+	}
+
+	return s.client.Send(ctx, s.id, recipients, ciphertext)
+}
+
+// receive waits for messages and calls handle with each of them until it
+// returns done.
+func (s *ceremonySession) receive(ctx context.Context, handle func(sender PublicKey, m ceremonyMessage) (done bool, err error)) error {
+	for {
+		msgs, err := s.client.Receive(ctx, s.id, s.asCoordinator)
+		if err != nil {
+			return err
+		}
+
+		for _, msg := range msgs {
+			plaintext, err := s.cipher.Decrypt(msg.Sender, msg.Message)
+			if err != nil {
+				return &PeerError{PublicKey: msg.Sender, Err: err}
+			}
+
+			var m ceremonyMessage
+			if err := json.Unmarshal(plaintext, &m); err != nil {
+				return &PeerError{PublicKey: msg.Sender, Err: fmt.Errorf("%w: %v", ErrUnexpectedMessage, err)}
+			}
+
+			done, err := handle(msg.Sender, m)
+			if err != nil {
+				return err
+			}
+			if done {
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// DkgIdentifiers returns the FROST identifiers of the users of a DKG
+// session, keyed by the hex of their communication key: users are
+// numbered from 1 in the order of their sorted keys, so that all of them
+// agree on the identifiers without further messages. frost-client
+// derives the identifiers of its DKG differently.
+func DkgIdentifiers(publicKeys []PublicKey) (map[string]frost.ParticipantIdentifier, error) {
+	sorted := append([]PublicKey{}, publicKeys...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+
+	identifiers := make(map[string]frost.ParticipantIdentifier, len(sorted))
+	for i, publicKey := range sorted {
+		identifier, err := frost.IdentifierFromUint16(uint16(i + 1))
+		if err != nil {
+			return nil, err
+		}
+		identifiers[publicKey.String()] = identifier
+	}
+
+	return identifiers, nil
+}
+
+// RunDkg takes part in the DKG of a session created with the keys of all
+// its participants, this user included, and returns its key package and
+// the public key package of the group once minSigners is settled between
+// all of them. Identifiers are assigned by DkgIdentifiers. Round 1 packages
+// are checked with a frost.DkgBroadcast: the DKG fails with a
+// *frost.DkgEquivocationError if some participants got different ones.
+func (c *Client) RunDkg(ctx context.Context, sessionID string, minSigners uint16) (frost.DkgPart3Result, error) {
+	info, err := c.GetSessionInfo(ctx, sessionID)
+	if err != nil {
+		return frost.DkgPart3Result{}, err
+	}
+
+	identifiers, err := DkgIdentifiers(info.PublicKeys)
+	if err != nil {
+		return frost.DkgPart3Result{}, err
+	}
+
+	identifier, ok := identifiers[c.PublicKey().String()]
+	if !ok {
+		return frost.DkgPart3Result{}, ErrUserNotInSession
+	}
+
+	var participants []frost.ParticipantIdentifier
+	var peers []PublicKey
+	publicKeys := make(map[frost.ParticipantIdentifier]PublicKey, len(info.PublicKeys))
+	for _, publicKey := range info.PublicKeys {
+		participants = append(participants, identifiers[publicKey.String()])
+		publicKeys[identifiers[publicKey.String()]] = publicKey
+		if !publicKey.Equal(c.PublicKey()) {
+			peers = append(peers, publicKey)
+		}
+	}
+
+	dkg, err := frost.NewDkgSession(identifier, participants, minSigners)
+	if err != nil {
+		return frost.DkgPart3Result{}, err
+	}
+	broadcast := frost.NewDkgBroadcast(dkg)
+
+	session := &ceremonySession{client: c, id: sessionID, cipher: NewCipher(c.keyPair, peers)}
+
+	for _, peer := range peers {
+		err := session.send(ctx, peer, false, ceremonyMessage{Kind: kindDkgRound1, Package: dkg.Round1Package().Data})
+		if err != nil {
+			return frost.DkgPart3Result{}, err
+		}
+	}
+
+	sentEcho, sentRound2 := false, false
+	err = session.receive(ctx, func(sender PublicKey, m ceremonyMessage) (bool, error) {
+		senderIdentifier := identifiers[sender.String()]
+
+		var err error
+		switch {
+		case m.Kind == kindDkgRound1:
+			err = broadcast.ReceiveRound1Package(frost.DkgRound1Package{Identifier: senderIdentifier, Data: m.Package})
+		case m.Kind == kindDkgRound1Echo && m.Echo != nil:
+			echo := *m.Echo
+			echo.Sender = senderIdentifier
+			err = broadcast.ReceiveEcho(echo)
+		case m.Kind == kindDkgRound2:
+			err = dkg.ReceiveRound2Package(senderIdentifier, frost.DkgRound2Package{Identifier: identifier, Data: m.Package})
+		default:
+			err = ErrUnexpectedMessage
+		}
+		if err != nil {
+			return false, &PeerError{PublicKey: sender, Err: err}
+		}
+
+		// every participant checks that all of them got the same round 1
+		// packages before running Part2
+		if echo, err := broadcast.Echo(); !sentEcho && err == nil {
+			for _, peer := range peers {
+				if err := session.send(ctx, peer, false, ceremonyMessage{Kind: kindDkgRound1Echo, Echo: &echo}); err != nil {
+					return false, err
+				}
+			}
+			sentEcho = true
+		}
+
+		if !sentRound2 && dkg.Phase() != frost.DkgPhaseRound1 {
+			round2Packages, err := dkg.Round2Packages()
+			if err != nil {
+				return false, err
+			}
+			for _, round2Package := range round2Packages {
+				m := ceremonyMessage{Kind: kindDkgRound2, Package: round2Package.Data}
+				if err := session.send(ctx, publicKeys[round2Package.Identifier], false, m); err != nil {
+					return false, err
+				}
+			}
+			sentRound2 = true
+		}
+
+		return dkg.Phase() == frost.DkgPhaseCompleted, nil
+	})
+	if err != nil {
+		return frost.DkgPart3Result{}, err
+	}
+
+	return dkg.Result()
+}
+
+// Signer is a user of the server that holds the key package of
+// Identifier.
+type Signer struct {
+	PublicKey  PublicKey
+	Identifier frost.ParticipantIdentifier
+}
+
+// CoordinateSigning creates a session with signers and coordinates the
+// signature of message by all of them, then closes the session. The
+// signature is verified against the randomizer that is returned with it.
+func (c *Client) CoordinateSigning(ctx context.Context, configuration frost.Configuration, publicKeyPackage frost.FrostPublicKeyPackage, signers []Signer, message frost.Message) (frost.FrostSignature, frost.FrostRandomizer, error) {
+	coordinator, err := frost.NewCoordinator(configuration, publicKeyPackage, message)
+	if err != nil {
+		return frost.FrostSignature{}, frost.FrostRandomizer{}, err
+	}
+
+	var publicKeys []PublicKey
+	identifiers := make(map[string]frost.ParticipantIdentifier, len(signers))
+	for _, signer := range signers {
+		publicKeys = append(publicKeys, signer.PublicKey)
+		identifiers[signer.PublicKey.String()] = signer.Identifier
+	}
+
+	sessionID, err := c.CreateNewSession(ctx, publicKeys, 1)
+	if err != nil {
+		return frost.FrostSignature{}, frost.FrostRandomizer{}, err
+	}
+	defer c.CloseSession(context.WithoutCancel(ctx), sessionID)
+
+	session := &ceremonySession{client: c, id: sessionID, cipher: NewCipher(c.keyPair, publicKeys), asCoordinator: true}
+
+	var commitments []signerCommitment
+	var signatureShares int
+	var round2 frost.Round2Configuration
+	var signature frost.FrostSignature
+
+	err = session.receive(ctx, func(sender PublicKey, m ceremonyMessage) (bool, error) {
+		identifier, ok := identifiers[sender.String()]
+		if !ok {
+			return false, &PeerError{PublicKey: sender, Err: ErrUserNotInSession}
+		}
+
+		switch m.Kind {
+		case kindCommitment:
+			commitment, err := frost.JsonToCommitment(string(m.Commitment), identifier)
+			if err == nil {
+				err = coordinator.ReceiveCommitment(commitment)
+			}
+			if err != nil {
+				return false, &PeerError{PublicKey: sender, Err: err}
+			}
+
+			commitments = append(commitments, signerCommitment{
+				Identifier: json.RawMessage(identifier.Data),
+				Commitment: m.Commitment,
+			})
+
+			// the signing package includes every signer
+			if len(commitments) < len(signers) {
+				return false, nil
+			}
+
+			if round2, err = coordinator.CreateSigningPackage(); err != nil {
+				return false, err
+			}
+
+			randomizerJson, err := frost.RandomizerToJson(round2.Randomizer)
+			if err != nil {
+				return false, err
+			}
+
+			for _, signer := range signers {
+				err := session.send(ctx, signer.PublicKey, false, ceremonyMessage{
+					Kind:           kindSigningPackage,
+					Message:        message.Data,
+					SigningPackage: round2.SigningPackage.Data,
+					Randomizer:     json.RawMessage(randomizerJson),
+					Commitments:    commitments,
+				})
+				if err != nil {
+					return false, err
+				}
+			}
+			return false, nil
+		case kindSignatureShare:
+			signatureShare, err := frost.JsonToSignatureShare(string(m.SignatureShare), identifier)
+			if err == nil {
+				err = coordinator.ReceiveSignatureShare(signatureShare)
+			}
+			if err != nil {
+				return false, &PeerError{PublicKey: sender, Err: err}
+			}
+
+			if signatureShares++; signatureShares < len(signers) {
+				return false, nil
+			}
+
+			if signature, err = coordinator.Aggregate(); err != nil {
+				return false, err
+			}
+			return true, coordinator.Verify(signature)
+		default:
+			return false, &PeerError{PublicKey: sender, Err: ErrUnexpectedMessage}
+		}
+	})
+	if err != nil {
+		return frost.FrostSignature{}, frost.FrostRandomizer{}, err
+	}
+
+	return signature, round2.Randomizer, nil
+}
+
+// ApprovalFunc is shown the message of a signing session before the
+// signature share is produced. Returning false declines to sign.
+type ApprovalFunc func(ctx context.Context, message frost.Message) (bool, error)
+
+// JoinSigning takes part with keyPackage in the signing session created
+// by a coordinator with CoordinateSigning. The signature share is only
+// sent for messages accepted by approve, and only if the signing package
+// is made of that message and includes the commitment of this signer.
+func (c *Client) JoinSigning(ctx context.Context, sessionID string, keyPackage frost.FrostKeyPackage, approve ApprovalFunc) error {
+	info, err := c.GetSessionInfo(ctx, sessionID)
+	if err != nil {
+		return err
+	}
+
+	coordinator := info.CoordinatorPublicKey
+	session := &ceremonySession{client: c, id: sessionID, cipher: NewCipher(c.keyPair, []PublicKey{coordinator})}
+
+	signer := frost.NewSigningParticipant(keyPackage)
+	commitment, err := signer.Commit()
+	if err != nil {
+		return err
+	}
+
+	commitmentJson, err := frost.CommitmentToJson(commitment)
+	if err != nil {
+		return err
+	}
+
+	err = session.send(ctx, coordinator, true, ceremonyMessage{Kind: kindCommitment, Commitment: json.RawMessage(commitmentJson)})
+	if err != nil {
+		return err
+	}
+
+	var signatureShare frost.FrostSignatureShare
+	err = session.receive(ctx, func(sender PublicKey, m ceremonyMessage) (bool, error) {
+		if !sender.Equal(coordinator) || m.Kind != kindSigningPackage {
+			return false, &PeerError{PublicKey: sender, Err: ErrUnexpectedMessage}
+		}
+
+		randomizer, err := frost.JsonToRandomizer(string(m.Randomizer))
+		if err != nil {
+			return false, &PeerError{PublicKey: sender, Err: err}
+		}
+
+		message := frost.Message{Data: m.Message}
+
+		signingPackage, err := boundSigningPackage(m, message, commitment)
+		if err != nil {
+			return false, &PeerError{PublicKey: sender, Err: err}
+		}
+
+		approved, err := approve(ctx, message)
+		if err != nil {
+			return false, err
+		}
+		if !approved {
+			return false, ErrSigningDeclined
+		}
+
+		signatureShare, err = signer.Sign(frost.Round2Configuration{
+			SigningPackage: signingPackage,
+			Randomizer:     randomizer,
+		})
+		return true, err
+	})
+	if err != nil {
+		return err
+	}
+
+	signatureShareJson, err := frost.SignatureSharePackageToJson(signatureShare)
+	if err != nil {
+		return err
+	}
+
+	return session.send(ctx, coordinator, true, ceremonyMessage{Kind: kindSignatureShare, SignatureShare: json.RawMessage(signatureShareJson)})
+}
+
+// boundSigningPackage rebuilds the signing package of m from message and
+// the commitments of m, which must include commitment, and checks that it
+// is the one of m, so that the message shown for approval is the one that
+// gets signed.
+func boundSigningPackage(m ceremonyMessage, message frost.Message, commitment frost.FrostSigningCommitments) (frost.FrostSigningPackage, error) {
+	var commitments []frost.FrostSigningCommitments
+	included := false
+	for _, signer := range m.Commitments {
+		identifier := frost.IdentifierFromJsonString(string(signer.Identifier))
+		if identifier == nil {
+			return frost.FrostSigningPackage{}, fmt.Errorf("%w: malformed identifier %s", ErrUnexpectedSigningPackage, signer.Identifier)
+		}
+
+		parsed, err := frost.JsonToCommitment(string(signer.Commitment), *identifier)
+		if err != nil {
+			return frost.FrostSigningPackage{}, err
+		}
+
+		if *identifier == commitment.Identifier {
+			if !bytes.Equal(parsed.Data, commitment.Data) {
+				return frost.FrostSigningPackage{}, fmt.Errorf("%w: another commitment for this signer", ErrUnexpectedSigningPackage)
+			}
+			included = true
+		}
+
+		commitments = append(commitments, parsed)
+	}
+
+	if !included {
+		return frost.FrostSigningPackage{}, fmt.Errorf("%w: no commitment for this signer", ErrUnexpectedSigningPackage)
+	}
+
+	signingPackage, err := frost.NewSigningPackage(message, commitments)
+	if err != nil {
+		return frost.FrostSigningPackage{}, err
+	}
+
+	if !bytes.Equal(signingPackage.Data, m.SigningPackage) {
+		return frost.FrostSigningPackage{}, fmt.Errorf("%w: another message", ErrUnexpectedSigningPackage)
+	}
+
+	return signingPackage, nil
+}
@@ -0,0 +1,82 @@
+package frostd
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+func TestBoundSigningPackageRejectsSwappedMessage(t *testing.T) {
+	config := frost.Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	keygen, err := frost.TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	var commitments []frost.FrostSigningCommitments
+	var signerCommitments []signerCommitment
+	for _, secretShare := range keygen.SecretShares {
+		keyPackage, err := frost.VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+
+		commitment, err := frost.NewSigningParticipant(keyPackage).Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		commitmentJson, err := frost.CommitmentToJson(commitment)
+		if err != nil {
+			t.Fatalf("Failed to serialize commitment: %v", err)
+		}
+
+		commitments = append(commitments, commitment)
+		signerCommitments = append(signerCommitments, signerCommitment{
+			Identifier: json.RawMessage(commitment.Identifier.Data),
+			Commitment: json.RawMessage(commitmentJson),
+		})
+	}
+
+	message := frost.Message{Data: []byte("i am a message")}
+	own := commitments[0]
+
+	signingPackage, err := frost.NewSigningPackage(message, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	swapped, err := frost.NewSigningPackage(frost.Message{Data: []byte("i am another message")}, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	m := ceremonyMessage{Kind: kindSigningPackage, Message: message.Data, SigningPackage: signingPackage.Data, Commitments: signerCommitments}
+
+	bound, err := boundSigningPackage(m, message, own)
+	if err != nil {
+		t.Fatalf("Failed to bind signing package: %v", err)
+	}
+	if string(bound.Data) != string(signingPackage.Data) {
+		t.Fatalf("Expected the signing package of the message")
+	}
+
+	// shown the approved message, handed the package of another one
+	m.SigningPackage = swapped.Data
+	if _, err := boundSigningPackage(m, message, own); !errors.Is(err, ErrUnexpectedSigningPackage) {
+		t.Fatalf("Expected ErrUnexpectedSigningPackage, got %v", err)
+	}
+
+	withoutOwn := ceremonyMessage{Kind: kindSigningPackage, Message: message.Data, Commitments: signerCommitments[1:]}
+	withoutOwnPackage, err := frost.NewSigningPackage(message, commitments[1:])
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+	withoutOwn.SigningPackage = withoutOwnPackage.Data
+	if _, err := boundSigningPackage(withoutOwn, message, own); !errors.Is(err, ErrUnexpectedSigningPackage) {
+		t.Fatalf("Expected ErrUnexpectedSigningPackage, got %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package frostd_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	frost "frost_go_ffi/frost_go_ffi"
+	"frost_go_ffi/frost_go_ffi/frostd"
+	"frost_go_ffi/frost_go_ffi/frostd/frostdtest"
+)
+
+// loggedInClients returns n clients of the server at url with fresh
+// communication keys, logged in.
+func loggedInClients(t *testing.T, ctx context.Context, url string, n int) []*frostd.Client {
+	t.Helper()
+
+	var clients []*frostd.Client
+	for i := 0; i < n; i++ {
+		keyPair, err := frostd.GenerateCommKeyPair()
+		if err != nil {
+			t.Fatalf("Failed to generate key pair: %v", err)
+		}
+
+		client := frostd.NewClient(url, keyPair)
+		if err := client.Login(ctx); err != nil {
+			t.Fatalf("Failed to log in: %v", err)
+		}
+		clients = append(clients, client)
+	}
+
+	return clients
+}
+
+func TestClientRelaysMessagesThroughServer(t *testing.T) {
+	server := httptest.NewServer(frostdtest.NewServer())
+	defer server.Close()
+
+	ctx := context.Background()
+	clients := loggedInClients(t, ctx, server.URL, 2)
+	coordinator, participant := clients[0], clients[1]
+
+	if _, err := frostd.NewClient(server.URL, nil).ListSessions(ctx); !errors.Is(err, frostd.ErrNotLoggedIn) {
+		t.Fatalf("Expected ErrNotLoggedIn, got %v", err)
+	}
+
+	sessionID, err := coordinator.CreateNewSession(ctx, []frostd.PublicKey{participant.PublicKey()}, 1)
+	if err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	sessions, err := participant.ListSessions(ctx)
+	if err != nil {
+		t.Fatalf("Failed to list sessions: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0] != sessionID {
+		t.Fatalf("Expected session %s, got %v", sessionID, sessions)
+	}
+
+	info, err := participant.GetSessionInfo(ctx, sessionID)
+	if err != nil {
+		t.Fatalf("Failed to get session info: %v", err)
+	}
+	if !info.CoordinatorPublicKey.Equal(coordinator.PublicKey()) {
+		t.Fatalf("Expected coordinator %s, got %s", coordinator.PublicKey(), info.CoordinatorPublicKey)
+	}
+
+	if err := participant.Send(ctx, sessionID, nil, []byte("to the coordinator")); err != nil {
+		t.Fatalf("Failed to send: %v", err)
+	}
+
+	msgs, err := coordinator.Receive(ctx, sessionID, true)
+	if err != nil {
+		t.Fatalf("Failed to receive: %v", err)
+	}
+	if len(msgs) != 1 || string(msgs[0].Message) != "to the coordinator" || !msgs[0].Sender.Equal(participant.PublicKey()) {
+		t.Fatalf("Unexpected messages %+v", msgs)
+	}
+
+	var errorResponse *frostd.ErrorResponse
+	if _, err := participant.Receive(ctx, sessionID, true); !errors.As(err, &errorResponse) || errorResponse.Code != frostd.ErrorCodeNotCoordinator {
+		t.Fatalf("Expected error code %d, got %v", frostd.ErrorCodeNotCoordinator, err)
+	}
+
+	if err := coordinator.CloseSession(ctx, sessionID); err != nil {
+		t.Fatalf("Failed to close session: %v", err)
+	}
+
+	if err := coordinator.Logout(ctx); err != nil {
+		t.Fatalf("Failed to log out: %v", err)
+	}
+}
+
+func TestDkgAndSigningThroughServer(t *testing.T) {
+	server := httptest.NewServer(frostdtest.NewServer())
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	const minSigners = 2
+	participants := loggedInClients(t, ctx, server.URL, 3)
+
+	var publicKeys []frostd.PublicKey
+	for _, participant := range participants {
+		publicKeys = append(publicKeys, participant.PublicKey())
+	}
+
+	dkgSessionID, err := participants[0].CreateNewSession(ctx, publicKeys, 1)
+	if err != nil {
+		t.Fatalf("Failed to create DKG session: %v", err)
+	}
+
+	results := make([]frost.DkgPart3Result, len(participants))
+	errs := make([]error, len(participants))
+	var wg sync.WaitGroup
+	for i, participant := range participants {
+		wg.Add(1)
+		go func(i int, participant *frostd.Client) {
+			defer wg.Done()
+			results[i], errs[i] = participant.RunDkg(ctx, dkgSessionID, minSigners)
+		}(i, participant)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			t.Fatalf("Failed to run DKG: %v", err)
+		}
+	}
+
+	if err := participants[0].CloseSession(ctx, dkgSessionID); err != nil {
+		t.Fatalf("Failed to close DKG session: %v", err)
+	}
+
+	publicKeyPackage := results[0].PublicKeyPackage
+	for _, result := range results[1:] {
+		if result.PublicKeyPackage.VerifyingKey != publicKeyPackage.VerifyingKey {
+			t.Fatalf("Expected every participant to get the same group key")
+		}
+	}
+
+	coordinator := loggedInClients(t, ctx, server.URL, 1)[0]
+	message := frost.Message{Data: []byte("i am a message")}
+	configuration := frost.Configuration{MinSigners: minSigners, MaxSigners: uint16(len(participants)), Secret: []byte{}}
+
+	var signers []frostd.Signer
+	for i := 0; i < minSigners; i++ {
+		signers = append(signers, frostd.Signer{
+			PublicKey:  participants[i].PublicKey(),
+			Identifier: results[i].KeyPackage.Identifier,
+		})
+	}
+
+	for i := 0; i < minSigners; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = joinFirstSession(ctx, participants[i], results[i].KeyPackage, message)
+		}(i)
+	}
+
+	signature, randomizer, err := coordinator.CoordinateSigning(ctx, configuration, publicKeyPackage, signers, message)
+	if err != nil {
+		t.Fatalf("Failed to coordinate signing: %v", err)
+	}
+	wg.Wait()
+
+	for _, err := range errs[:minSigners] {
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+	}
+
+	if err := frost.VerifyRandomizedSignature(randomizer, message, signature, publicKeyPackage); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+// joinFirstSession waits for a session of participant and signs message
+// in it.
+func joinFirstSession(ctx context.Context, participant *frostd.Client, keyPackage frost.FrostKeyPackage, message frost.Message) error {
+	for {
+		sessionIDs, err := participant.ListSessions(ctx)
+		if err != nil {
+			return err
+		}
+
+		if len(sessionIDs) > 0 {
+			return participant.JoinSigning(ctx, sessionIDs[0], keyPackage, func(ctx context.Context, received frost.Message) (bool, error) {
+				return string(received.Data) == string(message.Data), nil
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
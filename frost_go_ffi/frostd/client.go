@@ -0,0 +1,206 @@
+package frostd
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ErrNotLoggedIn is returned for requests made before Login.
+var ErrNotLoggedIn = errors.New("frostd: not logged in")
+
+// Client makes the requests of a frostd user. It must Login before any
+// other request.
+//
+// A Client is safe for concurrent use by multiple goroutines.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	keyPair    *CommKeyPair
+
+	mu          sync.Mutex
+	accessToken string
+}
+
+// NewClient creates a client of the server at baseURL for the user of
+// keyPair.
+func NewClient(baseURL string, keyPair *CommKeyPair) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		keyPair:    keyPair,
+	}
+}
+
+// PublicKey identifies the user of this client.
+func (c *Client) PublicKey() PublicKey {
+	return c.keyPair.Public
+}
+
+// Login signs a challenge of the server to get an access token.
+func (c *Client) Login(ctx context.Context) error {
+	var challenge ChallengeResponse
+	if err := c.post(ctx, "/challenge", "", nil, &challenge); err != nil {
+		return err
+	}
+
+	challengeBytes, err := parseUUID(challenge.Challenge)
+	if err != nil {
+		return err
+	}
+
+	signature, err := c.keyPair.Sign(challengeBytes)
+	if err != nil {
+		return err
+	}
+
+	var login LoginResponse
+	err = c.post(ctx, "/login", "", LoginRequest{
+		Challenge: challenge.Challenge,
+		PublicKey: c.keyPair.Public,
+		Signature: signature,
+	}, &login)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.accessToken = login.AccessToken
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Logout invalidates the access token of the client.
+func (c *Client) Logout(ctx context.Context) error {
+	if err := c.authorizedPost(ctx, "/logout", nil, nil); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.accessToken = ""
+	c.mu.Unlock()
+
+	return nil
+}
+
+// CreateNewSession creates a session coordinated by this user with the
+// users of publicKeys, to sign messageCount messages.
+func (c *Client) CreateNewSession(ctx context.Context, publicKeys []PublicKey, messageCount uint8) (string, error) {
+	var response CreateNewSessionResponse
+	err := c.authorizedPost(ctx, "/create_new_session", CreateNewSessionRequest{
+		PublicKeys:   publicKeys,
+		MessageCount: messageCount,
+	}, &response)
+	return response.SessionID, err
+}
+
+// ListSessions returns the sessions this user is part of.
+func (c *Client) ListSessions(ctx context.Context) ([]string, error) {
+	var response ListSessionsResponse
+	err := c.authorizedPost(ctx, "/list_sessions", nil, &response)
+	return response.SessionIDs, err
+}
+
+// GetSessionInfo returns the users and coordinator of a session.
+func (c *Client) GetSessionInfo(ctx context.Context, sessionID string) (SessionInfo, error) {
+	var info SessionInfo
+	err := c.authorizedPost(ctx, "/get_session_info", SessionRequest{SessionID: sessionID}, &info)
+	return info, err
+}
+
+// Send relays message, as is, to recipients of a session, or to its
+// coordinator if there are none. Use a Cipher to encrypt it first.
+func (c *Client) Send(ctx context.Context, sessionID string, recipients []PublicKey, message []byte) error {
+	if recipients == nil {
+		recipients = []PublicKey{}
+	}
+	return c.authorizedPost(ctx, "/send", SendRequest{
+		SessionID:  sessionID,
+		Recipients: recipients,
+		Message:    message,
+	}, nil)
+}
+
+// Receive returns the messages sent to this user in a session since the
+// last call, or those sent to the coordinator with asCoordinator.
+func (c *Client) Receive(ctx context.Context, sessionID string, asCoordinator bool) ([]Msg, error) {
+	var response ReceiveResponse
+	err := c.authorizedPost(ctx, "/receive", ReceiveRequest{
+		SessionID:     sessionID,
+		AsCoordinator: asCoordinator,
+	}, &response)
+	return response.Messages, err
+}
+
+// CloseSession closes a session coordinated by this user.
+func (c *Client) CloseSession(ctx context.Context, sessionID string) error {
+	return c.authorizedPost(ctx, "/close_session", SessionRequest{SessionID: sessionID}, nil)
+}
+
+func (c *Client) authorizedPost(ctx context.Context, path string, request any, response any) error {
+	c.mu.Lock()
+	accessToken := c.accessToken
+	c.mu.Unlock()
+
+	if accessToken == "" {
+		return ErrNotLoggedIn
+	}
+
+	return c.post(ctx, path, accessToken, request, response)
+}
+
+func (c *Client) post(ctx context.Context, path string, accessToken string, request any, response any) error {
+	var body []byte
+	if request != nil {
+		var err error
+		if body, err = json.Marshal(request); err != nil {
+			return err
+		}
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	if request != nil {
+		httpRequest.Header.Set("Content-Type", "application/json")
+	}
+	if accessToken != "" {
+		httpRequest.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	httpResponse, err := c.httpClient.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode >= http.StatusBadRequest {
+		errorResponse := &ErrorResponse{}
+		if err := json.NewDecoder(httpResponse.Body).Decode(errorResponse); err != nil {
+			return fmt.Errorf("frostd: %s", httpResponse.Status)
+		}
+		return errorResponse
+	}
+
+	if response == nil {
+		return nil
+	}
+	return json.NewDecoder(httpResponse.Body).Decode(response)
+}
+
+// parseUUID returns the 16 bytes of a UUID in its hyphenated form.
+func parseUUID(s string) ([]byte, error) {
+	decoded, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(decoded) != 16 || len(s) != 36 {
+		return nil, fmt.Errorf("frostd: malformed UUID %q", s)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,380 @@
+// Package frostdtest provides an in-process stand-in for a frostd server,
+// to test code that uses package frostd without running one.
+package frostdtest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"frost_go_ffi/frost_go_ffi/frostd"
+)
+
+// maxRequestBytes bounds the size of request bodies.
+const maxRequestBytes = 1 << 20
+
+// Server implements the requests of frostd documented in package frostd,
+// keeping everything in memory. Like frostd it never sees the content of
+// the messages it relays.
+type Server struct {
+	mu         sync.Mutex
+	challenges map[string]bool
+	tokens     map[string]string
+	sessions   map[string]*session
+}
+
+type session struct {
+	coordinator  frostd.PublicKey
+	publicKeys   []frostd.PublicKey
+	messageCount uint8
+	// queues of messages by recipient, "" being the coordinator
+	queues map[string][]frostd.Msg
+}
+
+func (s *session) has(publicKey frostd.PublicKey) bool {
+	for _, member := range s.publicKeys {
+		if member.Equal(publicKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewServer creates an empty server.
+func NewServer() *Server {
+	return &Server{
+		challenges: make(map[string]bool),
+		tokens:     make(map[string]string),
+		sessions:   make(map[string]*session),
+	}
+}
+
+// ServeHTTP handles the requests of a frostd server.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, frostd.ErrorCodeInvalidArgument, "method not allowed")
+		return
+	}
+
+	switch r.URL.Path {
+	case "/challenge":
+		s.challenge(w)
+		return
+	case "/login":
+		s.login(w, r)
+		return
+	}
+
+	user, ok := s.authenticate(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, frostd.ErrorCodeUnauthorized, "missing or invalid access token")
+		return
+	}
+
+	switch r.URL.Path {
+	case "/logout":
+		s.logout(w, r)
+	case "/create_new_session":
+		s.createNewSession(w, r, user)
+	case "/list_sessions":
+		s.listSessions(w, user)
+	case "/get_session_info":
+		s.getSessionInfo(w, r, user)
+	case "/send":
+		s.send(w, r, user)
+	case "/receive":
+		s.receive(w, r, user)
+	case "/close_session":
+		s.closeSession(w, r, user)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) challenge(w http.ResponseWriter) {
+	challenge, err := newUUID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, frostd.ErrorCodeInvalidArgument, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.challenges[challenge] = true
+	s.mu.Unlock()
+
+	writeJson(w, frostd.ChallengeResponse{Challenge: challenge})
+}
+
+func (s *Server) login(w http.ResponseWriter, r *http.Request) {
+	var request frostd.LoginRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	challenge, err := hex.DecodeString(strings.ReplaceAll(request.Challenge, "-", ""))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, frostd.ErrorCodeInvalidArgument, "malformed challenge")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.challenges[request.Challenge] {
+		writeError(w, http.StatusUnauthorized, frostd.ErrorCodeUnauthorized, "unknown challenge")
+		return
+	}
+	delete(s.challenges, request.Challenge)
+
+	if !frostd.VerifySignature(request.PublicKey, challenge, request.Signature) {
+		writeError(w, http.StatusUnauthorized, frostd.ErrorCodeUnauthorized, "invalid challenge signature")
+		return
+	}
+
+	accessToken, err := newUUID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, frostd.ErrorCodeInvalidArgument, err.Error())
+		return
+	}
+	s.tokens[accessToken] = request.PublicKey.String()
+
+	writeJson(w, frostd.LoginResponse{AccessToken: accessToken})
+}
+
+func (s *Server) authenticate(r *http.Request) (frostd.PublicKey, bool) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	user, ok := s.tokens[accessToken]
+	if !ok {
+		return nil, false
+	}
+
+	publicKey, err := frostd.ParsePublicKey(user)
+	return publicKey, err == nil
+}
+
+func (s *Server) logout(w http.ResponseWriter, r *http.Request) {
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	s.mu.Lock()
+	delete(s.tokens, accessToken)
+	s.mu.Unlock()
+
+	writeJson(w, struct{}{})
+}
+
+func (s *Server) createNewSession(w http.ResponseWriter, r *http.Request, user frostd.PublicKey) {
+	var request frostd.CreateNewSessionRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	if len(request.PublicKeys) == 0 || request.MessageCount == 0 {
+		writeError(w, http.StatusBadRequest, frostd.ErrorCodeInvalidArgument, "a session needs users and messages")
+		return
+	}
+
+	id, err := newUUID()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, frostd.ErrorCodeInvalidArgument, err.Error())
+		return
+	}
+
+	s.mu.Lock()
+	s.sessions[id] = &session{
+		coordinator:  user,
+		publicKeys:   request.PublicKeys,
+		messageCount: request.MessageCount,
+		queues:       make(map[string][]frostd.Msg),
+	}
+	s.mu.Unlock()
+
+	writeJson(w, frostd.CreateNewSessionResponse{SessionID: id})
+}
+
+func (s *Server) listSessions(w http.ResponseWriter, user frostd.PublicKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	response := frostd.ListSessionsResponse{SessionIDs: []string{}}
+	for id, sess := range s.sessions {
+		if sess.has(user) {
+			response.SessionIDs = append(response.SessionIDs, id)
+		}
+	}
+
+	writeJson(w, response)
+}
+
+// session returns the session with id if user is one of its users or its
+// coordinator, writing the error otherwise. s.mu must be held.
+func (s *Server) session(w http.ResponseWriter, id string, user frostd.PublicKey) (*session, bool) {
+	sess, ok := s.sessions[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, frostd.ErrorCodeSessionNotFound, "session not found")
+		return nil, false
+	}
+
+	if !sess.has(user) && !sess.coordinator.Equal(user) {
+		writeError(w, http.StatusForbidden, frostd.ErrorCodeNotInSession, "user is not part of the session")
+		return nil, false
+	}
+
+	return sess, true
+}
+
+func (s *Server) getSessionInfo(w http.ResponseWriter, r *http.Request, user frostd.PublicKey) {
+	var request frostd.SessionRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.session(w, request.SessionID, user)
+	if !ok {
+		return
+	}
+
+	writeJson(w, frostd.SessionInfo{
+		MessageCount:         sess.messageCount,
+		PublicKeys:           sess.publicKeys,
+		CoordinatorPublicKey: sess.coordinator,
+	})
+}
+
+func (s *Server) send(w http.ResponseWriter, r *http.Request, user frostd.PublicKey) {
+	var request frostd.SendRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.session(w, request.SessionID, user)
+	if !ok {
+		return
+	}
+
+	msg := frostd.Msg{Sender: user, Message: request.Message}
+
+	if len(request.Recipients) == 0 {
+		sess.queues[""] = append(sess.queues[""], msg)
+		writeJson(w, struct{}{})
+		return
+	}
+
+	for _, recipient := range request.Recipients {
+		if !sess.has(recipient) {
+			writeError(w, http.StatusBadRequest, frostd.ErrorCodeInvalidArgument, fmt.Sprintf("recipient %s is not part of the session", recipient))
+			return
+		}
+	}
+
+	for _, recipient := range request.Recipients {
+		sess.queues[recipient.String()] = append(sess.queues[recipient.String()], msg)
+	}
+
+	writeJson(w, struct{}{})
+}
+
+func (s *Server) receive(w http.ResponseWriter, r *http.Request, user frostd.PublicKey) {
+	var request frostd.ReceiveRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.session(w, request.SessionID, user)
+	if !ok {
+		return
+	}
+
+	queue := user.String()
+	if request.AsCoordinator {
+		if !sess.coordinator.Equal(user) {
+			writeError(w, http.StatusForbidden, frostd.ErrorCodeNotCoordinator, "user is not the coordinator of the session")
+			return
+		}
+		queue = ""
+	}
+
+	response := frostd.ReceiveResponse{Messages: sess.queues[queue]}
+	if response.Messages == nil {
+		response.Messages = []frostd.Msg{}
+	}
+	delete(sess.queues, queue)
+
+	writeJson(w, response)
+}
+
+func (s *Server) closeSession(w http.ResponseWriter, r *http.Request, user frostd.PublicKey) {
+	var request frostd.SessionRequest
+	if !readJson(w, r, &request) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.session(w, request.SessionID, user)
+	if !ok {
+		return
+	}
+
+	if !sess.coordinator.Equal(user) {
+		writeError(w, http.StatusForbidden, frostd.ErrorCodeNotCoordinator, "user is not the coordinator of the session")
+		return
+	}
+
+	delete(s.sessions, request.SessionID)
+
+	writeJson(w, struct{}{})
+}
+
+func newUUID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	// version 4, variant 10
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+
+	h := hex.EncodeToString(b)
+	return h[:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:], nil
+}
+
+func readJson(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBytes)).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, frostd.ErrorCodeInvalidArgument, err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJson(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code frostd.ErrorCode, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(&frostd.ErrorResponse{Code: code, Message: message})
+}
@@ -0,0 +1,53 @@
+package frostd
+
+import "frost_go_ffi/frost_go_ffi/commkey"
+
+// Communication keys and the Cipher of session messages are those of the
+// commkey package, shared with the DkgChannel of the frost_uniffi_sdk
+// bindings.
+
+// ErrMalformedPublicKey is returned for communication keys that are not
+// 32 bytes long.
+var ErrMalformedPublicKey = commkey.ErrMalformedPublicKey
+
+// Err* are used for checking Cipher errors with `errors.Is`
+var ErrCipherUnknownPeer = commkey.ErrCipherUnknownPeer
+var ErrCipherDecryption = commkey.ErrCipherDecryption
+var ErrCipherMessageTooLarge = commkey.ErrCipherMessageTooLarge
+
+// PublicKey is the communication key that identifies a user of a frostd
+// server.
+type PublicKey = commkey.PublicKey
+
+// CommKeyPair is the communication key pair of a user of a frostd server.
+// It logs in to the server and encrypts the messages to other users; it
+// is unrelated to FROST key material.
+type CommKeyPair = commkey.KeyPair
+
+// Cipher encrypts the messages exchanged with the peers of a session.
+type Cipher = commkey.Cipher
+
+// ParsePublicKey parses the hex encoding of a communication key.
+func ParsePublicKey(s string) (PublicKey, error) {
+	return commkey.ParsePublicKey(s)
+}
+
+// GenerateCommKeyPair generates a random communication key pair.
+func GenerateCommKeyPair() (*CommKeyPair, error) {
+	return commkey.GenerateKeyPair()
+}
+
+// NewCommKeyPair returns the key pair of an X25519 private key.
+func NewCommKeyPair(private []byte) (*CommKeyPair, error) {
+	return commkey.NewKeyPair(private)
+}
+
+// VerifySignature checks an XEdDSA signature of message by publicKey.
+func VerifySignature(publicKey PublicKey, message []byte, signature []byte) bool {
+	return commkey.VerifySignature(publicKey, message, signature)
+}
+
+// NewCipher creates a Cipher for the messages of keyPair with peers.
+func NewCipher(keyPair *CommKeyPair, peers []PublicKey) *Cipher {
+	return commkey.NewCipher(keyPair, peers)
+}
@@ -0,0 +1,371 @@
+package frost_uniffi_sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Err* are used for checking Keystore errors with `errors.Is`
+var ErrKeystoreExists = errors.New("keystore file already exists")
+var ErrKeystoreUnsupportedVersion = errors.New("unsupported keystore version")
+var ErrKeystoreGroupNotFound = errors.New("no group with the given verifying key in keystore")
+var ErrKeystoreKeyPackageNotInGroup = errors.New("key package does not belong to the group")
+
+const keystoreVersion = 1
+
+const sealedKeystoreKind = "keystore"
+
+// KeystoreOrchardViewingKey is the Orchard viewing key metadata of a
+// group whose verifying key is used as the Orchard spend validating key.
+type KeystoreOrchardViewingKey struct {
+	// UnifiedFullViewingKey is the string encoding of the Orchard-only
+	// Unified Full Viewing Key.
+	UnifiedFullViewingKey string       `json:"ufvk"`
+	Network               ZcashNetwork `json:"network"`
+	// BirthdayHeight is the height of the block before which the group
+	// could not have received funds. Zero if unknown.
+	BirthdayHeight uint32 `json:"birthday_height,omitempty"`
+}
+
+// NewKeystoreOrchardViewingKey returns the keystore metadata for fvk.
+func NewKeystoreOrchardViewingKey(fvk *OrchardFullViewingKey, network ZcashNetwork, birthdayHeight uint32) (*KeystoreOrchardViewingKey, error) {
+	ufvk, err := fvk.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &KeystoreOrchardViewingKey{
+		UnifiedFullViewingKey: ufvk,
+		Network:               network,
+		BirthdayHeight:        birthdayHeight,
+	}, nil
+}
+
+// FullViewingKey decodes the stored Unified Full Viewing Key.
+func (k *KeystoreOrchardViewingKey) FullViewingKey() (*OrchardFullViewingKey, error) {
+	return OrchardFullViewingKeyDecode(k.UnifiedFullViewingKey, k.Network)
+}
+
+// KeystoreGroup is the key material of a FROST group held in a Keystore.
+type KeystoreGroup struct {
+	Label            string
+	PublicKeyPackage FrostPublicKeyPackage
+	// KeyPackage of the participant owning the keystore. Nil for groups
+	// that are only tracked, e.g. by a coordinator.
+	KeyPackage        *FrostKeyPackage
+	OrchardViewingKey *KeystoreOrchardViewingKey
+}
+
+// keystoreEntry stores the packages in the format of KeyPackageToJson
+// and PublicKeyPackageToJson.
+type keystoreEntry struct {
+	Label             string                     `json:"label,omitempty"`
+	PublicKeyPackage  json.RawMessage            `json:"public_key_package"`
+	KeyPackage        json.RawMessage            `json:"key_package,omitempty"`
+	OrchardViewingKey *KeystoreOrchardViewingKey `json:"orchard_viewing_key,omitempty"`
+}
+
+type keystoreContents struct {
+	Version int                      `json:"version"`
+	Groups  map[string]keystoreEntry `json:"groups"`
+}
+
+// Keystore is a file holding the key material of any number of FROST
+// groups, indexed by their verifying key. The whole file is encrypted
+// with a key derived from a passphrase with scrypt and authenticated
+// with XChaCha20-Poly1305. Every change is written to disk right away.
+//
+// A Keystore is safe for concurrent use by multiple goroutines, but not
+// by multiple processes.
+type Keystore struct {
+	mu         sync.Mutex
+	path       string
+	passphrase []byte
+	groups     map[string]keystoreEntry
+}
+
+// CreateKeystore creates an empty keystore at path protected with
+// passphrase. It fails with ErrKeystoreExists if path exists.
+func CreateKeystore(path string, passphrase []byte) (*Keystore, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, ErrKeystoreExists
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	k := &Keystore{
+		path:       path,
+		passphrase: append([]byte(nil), passphrase...),
+		groups:     make(map[string]keystoreEntry),
+	}
+
+	if err := k.save(); err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// OpenKeystore decrypts the keystore at path with passphrase.
+func OpenKeystore(path string, passphrase []byte) (*Keystore, error) {
+	sealed, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := openSecret(sealedKeystoreKind, sealed, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	var contents keystoreContents
+	if err := json.Unmarshal(plaintext, &contents); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSealedSecretMalformed, err)
+	}
+
+	if contents.Version != keystoreVersion {
+		return nil, fmt.Errorf("%w: %d", ErrKeystoreUnsupportedVersion, contents.Version)
+	}
+
+	if contents.Groups == nil {
+		contents.Groups = make(map[string]keystoreEntry)
+	}
+
+	return &Keystore{
+		path:       path,
+		passphrase: append([]byte(nil), passphrase...),
+		groups:     contents.Groups,
+	}, nil
+}
+
+// VerifyingKeys lists the groups in the keystore by verifying key, in
+// lexicographic order.
+func (k *Keystore) VerifyingKeys() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	verifyingKeys := make([]string, 0, len(k.groups))
+	for verifyingKey := range k.groups {
+		verifyingKeys = append(verifyingKeys, verifyingKey)
+	}
+	sort.Strings(verifyingKeys)
+
+	return verifyingKeys
+}
+
+// Group returns the group with the given verifying key.
+func (k *Keystore) Group(verifyingKey string) (KeystoreGroup, error) {
+	k.mu.Lock()
+	entry, ok := k.groups[verifyingKey]
+	k.mu.Unlock()
+
+	if !ok {
+		return KeystoreGroup{}, ErrKeystoreGroupNotFound
+	}
+
+	publicKeyPackage, err := JsonToPublicKeyPackage(string(entry.PublicKeyPackage))
+	if err != nil {
+		return KeystoreGroup{}, err
+	}
+
+	group := KeystoreGroup{
+		Label:             entry.Label,
+		PublicKeyPackage:  publicKeyPackage,
+		OrchardViewingKey: entry.OrchardViewingKey,
+	}
+
+	if entry.KeyPackage != nil {
+		keyPackage, err := JsonToKeyPackage(string(entry.KeyPackage))
+		if err != nil {
+			return KeystoreGroup{}, err
+		}
+		group.KeyPackage = &keyPackage
+	}
+
+	return group, nil
+}
+
+// keystoreKeyPackageKeys are the public keys held in the JSON of a key
+// package, hex encoded like those of a FrostPublicKeyPackage.
+type keystoreKeyPackageKeys struct {
+	VerifyingShare string `json:"verifying_share"`
+	VerifyingKey   string `json:"verifying_key"`
+}
+
+// PutGroup adds group to the keystore, replacing the group with the same
+// verifying key if there is one, and returns its verifying key. Its key
+// package, if any, must hold the verifying key of the group and the
+// verifying share the group has for its identifier.
+func (k *Keystore) PutGroup(group KeystoreGroup) (string, error) {
+	entry := keystoreEntry{
+		Label:             group.Label,
+		OrchardViewingKey: group.OrchardViewingKey,
+	}
+
+	publicKeyPackageJson, err := PublicKeyPackageToJson(group.PublicKeyPackage)
+	if err != nil {
+		return "", err
+	}
+	entry.PublicKeyPackage = json.RawMessage(publicKeyPackageJson)
+
+	if group.KeyPackage != nil {
+		keyPackageJson, err := KeyPackageToJson(*group.KeyPackage)
+		if err != nil {
+			return "", err
+		}
+
+		var keys keystoreKeyPackageKeys
+		if err := json.Unmarshal([]byte(keyPackageJson), &keys); err != nil {
+			return "", err
+		}
+
+		verifyingShare, ok := group.PublicKeyPackage.VerifyingShares[group.KeyPackage.Identifier]
+		if !ok || keys.VerifyingShare != verifyingShare || keys.VerifyingKey != group.PublicKeyPackage.VerifyingKey {
+			return "", ErrKeystoreKeyPackageNotInGroup
+		}
+
+		entry.KeyPackage = json.RawMessage(keyPackageJson)
+	}
+
+	verifyingKey := group.PublicKeyPackage.VerifyingKey
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	previous, replaced := k.groups[verifyingKey]
+	k.groups[verifyingKey] = entry
+
+	if err := k.save(); err != nil {
+		if replaced {
+			k.groups[verifyingKey] = previous
+		} else {
+			delete(k.groups, verifyingKey)
+		}
+		return "", err
+	}
+
+	return verifyingKey, nil
+}
+
+// RemoveGroup deletes the group with the given verifying key.
+func (k *Keystore) RemoveGroup(verifyingKey string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry, ok := k.groups[verifyingKey]
+	if !ok {
+		return ErrKeystoreGroupNotFound
+	}
+
+	delete(k.groups, verifyingKey)
+
+	if err := k.save(); err != nil {
+		k.groups[verifyingKey] = entry
+		return err
+	}
+
+	return nil
+}
+
+// ImportJson adds a group from a public key package and, optionally, a
+// key package serialized with PublicKeyPackageToJson and KeyPackageToJson.
+// Pass an empty keyPackageJson to only track the group.
+func (k *Keystore) ImportJson(label string, publicKeyPackageJson string, keyPackageJson string) (string, error) {
+	publicKeyPackage, err := JsonToPublicKeyPackage(publicKeyPackageJson)
+	if err != nil {
+		return "", err
+	}
+
+	group := KeystoreGroup{
+		Label:            label,
+		PublicKeyPackage: publicKeyPackage,
+	}
+
+	if keyPackageJson != "" {
+		keyPackage, err := JsonToKeyPackage(keyPackageJson)
+		if err != nil {
+			return "", err
+		}
+		group.KeyPackage = &keyPackage
+	}
+
+	return k.PutGroup(group)
+}
+
+// ExportJson returns the public key package and the key package of the
+// group with the given verifying key in the formats of
+// PublicKeyPackageToJson and KeyPackageToJson. keyPackageJson is empty if
+// the group has no key package.
+//
+// The key package is secret material in the clear.
+func (k *Keystore) ExportJson(verifyingKey string) (publicKeyPackageJson string, keyPackageJson string, err error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	entry, ok := k.groups[verifyingKey]
+	if !ok {
+		return "", "", ErrKeystoreGroupNotFound
+	}
+
+	return string(entry.PublicKeyPackage), string(entry.KeyPackage), nil
+}
+
+// ChangePassphrase encrypts the keystore with a new passphrase.
+func (k *Keystore) ChangePassphrase(passphrase []byte) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	previous := k.passphrase
+	k.passphrase = append([]byte(nil), passphrase...)
+
+	if err := k.save(); err != nil {
+		k.passphrase = previous
+		return err
+	}
+
+	return nil
+}
+
+// save writes the keystore to a temporary file and renames it over the
+// keystore file so that a crash never leaves a truncated keystore.
+func (k *Keystore) save() error {
+	plaintext, err := json.Marshal(keystoreContents{
+		Version: keystoreVersion,
+		Groups:  k.groups,
+	})
+	if err != nil {
+		return err
+	}
+
+	sealed, err := sealSecret(sealedKeystoreKind, plaintext, k.passphrase)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(k.path), filepath.Base(k.path)+".tmp*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(sealed); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), k.path)
+}
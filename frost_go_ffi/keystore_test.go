@@ -0,0 +1,142 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestKeystoreStoresGroupsEncrypted(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	passphrase := []byte("correct horse battery staple")
+	path := filepath.Join(t.TempDir(), "frost.keystore")
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	keystore, err := CreateKeystore(path, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to create keystore: %v", err)
+	}
+
+	if _, err := CreateKeystore(path, passphrase); !errors.Is(err, ErrKeystoreExists) {
+		t.Fatalf("Expected keystore exists error, got %v", err)
+	}
+
+	var keyPackage FrostKeyPackage
+	for _, keyPackage = range keyPackages {
+		break
+	}
+
+	orchardViewingKey := &KeystoreOrchardViewingKey{
+		UnifiedFullViewingKey: "uviewtest1jd7ucm0fdh9s0gqk9cse9xtqcyycj2k06krm3l9r6snakdzqz5tdp3ua4nerj8uttfepzjxrhp9a4c3wl7h508fmjwqgmqgvslcgvc8htqzm8gg5h9sygqt76un40xvzyyk7fvlestphmmz9emyqhjkl60u4dx25t86lhs30jreghq40cfnw9nqh858z4",
+		Network:               ZcashNetworkTestnet,
+	}
+
+	verifyingKey, err := keystore.PutGroup(KeystoreGroup{
+		Label:             "treasury",
+		PublicKeyPackage:  publicKey,
+		KeyPackage:        &keyPackage,
+		OrchardViewingKey: orchardViewingKey,
+	})
+	if err != nil {
+		t.Fatalf("Failed to put group: %v", err)
+	}
+
+	if _, err := OpenKeystore(path, []byte("wrong passphrase")); !errors.Is(err, ErrSealedSecretWrongPassphrase) {
+		t.Fatalf("Expected wrong passphrase error, got %v", err)
+	}
+
+	reopened, err := OpenKeystore(path, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to open keystore: %v", err)
+	}
+
+	verifyingKeys := reopened.VerifyingKeys()
+	if len(verifyingKeys) != 1 || verifyingKeys[0] != verifyingKey {
+		t.Fatalf("Expected group %s, got %v", verifyingKey, verifyingKeys)
+	}
+
+	group, err := reopened.Group(verifyingKey)
+	if err != nil {
+		t.Fatalf("Failed to get group: %v", err)
+	}
+
+	if group.Label != "treasury" || group.KeyPackage == nil || group.KeyPackage.Identifier != keyPackage.Identifier {
+		t.Fatalf("Unexpected group %+v", group)
+	}
+
+	if group.OrchardViewingKey == nil || *group.OrchardViewingKey != *orchardViewingKey {
+		t.Fatalf("Unexpected Orchard viewing key %+v", group.OrchardViewingKey)
+	}
+
+	if _, err := group.OrchardViewingKey.FullViewingKey(); err != nil {
+		t.Fatalf("Failed to decode Orchard viewing key: %v", err)
+	}
+
+	publicKeyPackageJson, keyPackageJson, err := reopened.ExportJson(verifyingKey)
+	if err != nil {
+		t.Fatalf("Failed to export group: %v", err)
+	}
+
+	if err := reopened.RemoveGroup(verifyingKey); err != nil {
+		t.Fatalf("Failed to remove group: %v", err)
+	}
+
+	if _, err := reopened.Group(verifyingKey); !errors.Is(err, ErrKeystoreGroupNotFound) {
+		t.Fatalf("Expected group not found error, got %v", err)
+	}
+
+	imported, err := reopened.ImportJson("imported", publicKeyPackageJson, keyPackageJson)
+	if err != nil {
+		t.Fatalf("Failed to import group: %v", err)
+	}
+
+	if imported != verifyingKey {
+		t.Fatalf("Expected imported group %s, got %s", verifyingKey, imported)
+	}
+}
+
+func TestKeystoreRejectsKeyPackageOfAnotherGroup(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+	otherPublicKey, otherKeyPackages := trustedDealerKeyPackages(t, config)
+
+	keystore, err := CreateKeystore(filepath.Join(t.TempDir(), "frost.keystore"), []byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("Failed to create keystore: %v", err)
+	}
+
+	var keyPackage FrostKeyPackage
+	for _, keyPackage = range otherKeyPackages {
+		break
+	}
+
+	// both groups have a participant with the identifier of keyPackage
+	if _, err := keystore.PutGroup(KeystoreGroup{PublicKeyPackage: publicKey, KeyPackage: &keyPackage}); !errors.Is(err, ErrKeystoreKeyPackageNotInGroup) {
+		t.Fatalf("Expected ErrKeystoreKeyPackageNotInGroup, got %v", err)
+	}
+
+	// same verifying key but another verifying share for keyPackage
+	tampered := FrostPublicKeyPackage{
+		VerifyingShares: make(map[ParticipantIdentifier]string),
+		VerifyingKey:    otherPublicKey.VerifyingKey,
+	}
+	for identifier, verifyingShare := range otherPublicKey.VerifyingShares {
+		tampered.VerifyingShares[identifier] = verifyingShare
+	}
+	for identifier, verifyingShare := range otherPublicKey.VerifyingShares {
+		if identifier != keyPackage.Identifier {
+			tampered.VerifyingShares[keyPackage.Identifier] = verifyingShare
+			break
+		}
+	}
+
+	if _, err := keystore.PutGroup(KeystoreGroup{PublicKeyPackage: tampered, KeyPackage: &keyPackage}); !errors.Is(err, ErrKeystoreKeyPackageNotInGroup) {
+		t.Fatalf("Expected ErrKeystoreKeyPackageNotInGroup, got %v", err)
+	}
+
+	if _, err := keystore.PutGroup(KeystoreGroup{PublicKeyPackage: otherPublicKey, KeyPackage: &keyPackage}); err != nil {
+		t.Fatalf("Failed to put group: %v", err)
+	}
+}
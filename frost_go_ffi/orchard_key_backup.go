@@ -0,0 +1,186 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Err* are used for checking Orchard key backup errors with `errors.Is`
+var ErrOrchardKeyBackupMalformed = errors.New("malformed Orchard key backup")
+var ErrOrchardKeyBackupUnsupportedVersion = errors.New("unsupported Orchard key backup version")
+var ErrOrchardKeyBackupChecksumMismatch = errors.New("Orchard key backup checksum mismatch")
+var ErrOrchardKeyBackupUnsupportedNetwork = errors.New("unsupported Orchard key backup network")
+
+const orchardKeyBackupVersion = 1
+
+const sealedOrchardKeyBackupKind = "orchard-key-backup"
+
+// version, network, birthday height, ak, nk, rivk and checksum
+const orchardKeyBackupLength = 1 + 1 + 4 + 3*32 + 4
+
+// OrchardKeyBackup holds what the FROST Book backup procedure asks to keep
+// next to the key shares of a group to restore its Orchard full viewing
+// key: the spend validating key ak of the group, which is its verifying
+// key, and the nk and rivk generated with OrchardKeyPartsRandom, along with
+// the network and the birthday height of the wallet.
+//
+// It isn't secret like the key shares are, but anyone holding it can see
+// every transaction of the group.
+type OrchardKeyBackup struct {
+	Ak      []byte
+	Nk      []byte
+	Rivk    []byte
+	Network ZcashNetwork
+	// BirthdayHeight is the height of the block before which the group
+	// could not have received funds. Zero if unknown.
+	BirthdayHeight uint32
+}
+
+// NewOrchardKeyBackup creates the backup of the full viewing key made of
+// the spend validating key ak of the group and parts.
+func NewOrchardKeyBackup(ak *OrchardSpendValidatingKey, parts *OrchardKeyParts, network ZcashNetwork, birthdayHeight uint32) (OrchardKeyBackup, error) {
+	nk, err := parts.Nk()
+	if err != nil {
+		return OrchardKeyBackup{}, err
+	}
+
+	rivk, err := parts.Rivk()
+	if err != nil {
+		return OrchardKeyBackup{}, err
+	}
+
+	return OrchardKeyBackup{
+		Ak:             ak.ToBytes(),
+		Nk:             nk.ToBytes(),
+		Rivk:           rivk.ToBytes(),
+		Network:        network,
+		BirthdayHeight: birthdayHeight,
+	}, nil
+}
+
+// NewOrchardKeyBackupFromFullViewingKey creates the backup of fvk.
+func NewOrchardKeyBackupFromFullViewingKey(fvk *OrchardFullViewingKey, network ZcashNetwork, birthdayHeight uint32) OrchardKeyBackup {
+	return OrchardKeyBackup{
+		Ak:             fvk.Ak().ToBytes(),
+		Nk:             fvk.Nk().ToBytes(),
+		Rivk:           fvk.Rivk().ToBytes(),
+		Network:        network,
+		BirthdayHeight: birthdayHeight,
+	}
+}
+
+// FullViewingKey restores the Orchard full viewing key of the backup with
+// OrchardFullViewingKeyNewFromCheckedParts.
+func (b OrchardKeyBackup) FullViewingKey() (*OrchardFullViewingKey, error) {
+	ak, err := OrchardSpendValidatingKeyFromBytes(b.Ak)
+	if err != nil {
+		return nil, err
+	}
+
+	nk, err := NewOrchardNullifierDerivingKey(b.Nk)
+	if err != nil {
+		return nil, err
+	}
+
+	rivk, err := NewOrchardCommitIvkRandomness(b.Rivk)
+	if err != nil {
+		return nil, err
+	}
+
+	return OrchardFullViewingKeyNewFromCheckedParts(ak, nk, rivk, b.Network)
+}
+
+// Export serializes the backup as a versioned blob ending with a checksum
+// that ImportOrchardKeyBackup checks, so that typos and truncation are
+// caught when it is restored from paper or another medium.
+func (b OrchardKeyBackup) Export() ([]byte, error) {
+	if len(b.Ak) != 32 || len(b.Nk) != 32 || len(b.Rivk) != 32 {
+		return nil, ErrOrchardKeyBackupMalformed
+	}
+
+	if err := validateOrchardKeyBackupNetwork(b.Network); err != nil {
+		return nil, err
+	}
+
+	exported := make([]byte, 0, orchardKeyBackupLength)
+	exported = append(exported, orchardKeyBackupVersion, byte(b.Network))
+	exported = binary.BigEndian.AppendUint32(exported, b.BirthdayHeight)
+	exported = append(exported, b.Ak...)
+	exported = append(exported, b.Nk...)
+	exported = append(exported, b.Rivk...)
+
+	return append(exported, orchardKeyBackupChecksum(exported)...), nil
+}
+
+// ImportOrchardKeyBackup parses a backup serialized with Export. The keys
+// it holds are only checked by FullViewingKey.
+func ImportOrchardKeyBackup(exported []byte) (OrchardKeyBackup, error) {
+	if len(exported) == 0 {
+		return OrchardKeyBackup{}, ErrOrchardKeyBackupMalformed
+	}
+
+	if exported[0] != orchardKeyBackupVersion {
+		return OrchardKeyBackup{}, fmt.Errorf("%w: %d", ErrOrchardKeyBackupUnsupportedVersion, exported[0])
+	}
+
+	if len(exported) != orchardKeyBackupLength {
+		return OrchardKeyBackup{}, ErrOrchardKeyBackupMalformed
+	}
+
+	body, checksum := exported[:orchardKeyBackupLength-4], exported[orchardKeyBackupLength-4:]
+	if !bytes.Equal(orchardKeyBackupChecksum(body), checksum) {
+		return OrchardKeyBackup{}, ErrOrchardKeyBackupChecksumMismatch
+	}
+
+	network := ZcashNetwork(body[1])
+	if err := validateOrchardKeyBackupNetwork(network); err != nil {
+		return OrchardKeyBackup{}, err
+	}
+
+	keys := body[6:]
+
+	return OrchardKeyBackup{
+		Ak:             bytes.Clone(keys[0:32]),
+		Nk:             bytes.Clone(keys[32:64]),
+		Rivk:           bytes.Clone(keys[64:96]),
+		Network:        network,
+		BirthdayHeight: binary.BigEndian.Uint32(body[2:6]),
+	}, nil
+}
+
+// ExportSealed serializes the backup with Export and encrypts it with
+// passphrase. The result is restored with ImportSealedOrchardKeyBackup.
+func (b OrchardKeyBackup) ExportSealed(passphrase []byte) ([]byte, error) {
+	exported, err := b.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	return sealSecret(sealedOrchardKeyBackupKind, exported, passphrase)
+}
+
+// ImportSealedOrchardKeyBackup decrypts a backup exported with
+// ExportSealed.
+func ImportSealedOrchardKeyBackup(sealed []byte, passphrase []byte) (OrchardKeyBackup, error) {
+	exported, err := openSecret(sealedOrchardKeyBackupKind, sealed, passphrase)
+	if err != nil {
+		return OrchardKeyBackup{}, err
+	}
+
+	return ImportOrchardKeyBackup(exported)
+}
+
+func validateOrchardKeyBackupNetwork(network ZcashNetwork) error {
+	if network != ZcashNetworkMainnet && network != ZcashNetworkTestnet {
+		return fmt.Errorf("%w: %d", ErrOrchardKeyBackupUnsupportedNetwork, network)
+	}
+	return nil
+}
+
+func orchardKeyBackupChecksum(body []byte) []byte {
+	digest := sha256.Sum256(body)
+	return digest[:4]
+}
@@ -0,0 +1,156 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func orchardKeyBackupFixture(t *testing.T) OrchardKeyBackup {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	akBytes, err := hex.DecodeString(publicKey.VerifyingKey)
+	if err != nil {
+		t.Fatalf("Failed to decode verifying key: %v", err)
+	}
+
+	ak, err := OrchardSpendValidatingKeyFromBytes(akBytes)
+	if err != nil {
+		t.Fatalf("Failed to create OrchardSpendValidatingKey: %v", err)
+	}
+
+	parts, err := OrchardKeyPartsRandom(ZcashNetworkTestnet)
+	if err != nil {
+		t.Fatalf("Failed to create OrchardKeyParts: %v", err)
+	}
+
+	backup, err := NewOrchardKeyBackup(ak, parts, ZcashNetworkTestnet, 2_500_000)
+	if err != nil {
+		t.Fatalf("Failed to create Orchard key backup: %v", err)
+	}
+
+	return backup
+}
+
+func TestOrchardKeyBackupRestoresFullViewingKey(t *testing.T) {
+	backup := orchardKeyBackupFixture(t)
+
+	fvk, err := backup.FullViewingKey()
+	if err != nil {
+		t.Fatalf("Failed to restore full viewing key: %v", err)
+	}
+
+	ufvk, err := fvk.Encode()
+	if err != nil {
+		t.Fatalf("Failed to encode full viewing key: %v", err)
+	}
+
+	if !bytes.Equal(fvk.Ak().ToBytes(), backup.Ak) {
+		t.Fatalf("Restored full viewing key has another ak")
+	}
+
+	exported, err := backup.Export()
+	if err != nil {
+		t.Fatalf("Failed to export Orchard key backup: %v", err)
+	}
+
+	sealed, err := backup.ExportSealed([]byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("Failed to seal Orchard key backup: %v", err)
+	}
+
+	imported, err := ImportOrchardKeyBackup(exported)
+	if err != nil {
+		t.Fatalf("Failed to import Orchard key backup: %v", err)
+	}
+
+	unsealed, err := ImportSealedOrchardKeyBackup(sealed, []byte("correct horse battery staple"))
+	if err != nil {
+		t.Fatalf("Failed to import sealed Orchard key backup: %v", err)
+	}
+
+	for _, restored := range []OrchardKeyBackup{imported, unsealed, NewOrchardKeyBackupFromFullViewingKey(fvk, backup.Network, backup.BirthdayHeight)} {
+		if restored.Network != backup.Network || restored.BirthdayHeight != backup.BirthdayHeight {
+			t.Fatalf("Expected network %d and birthday height %d, got %d and %d", backup.Network, backup.BirthdayHeight, restored.Network, restored.BirthdayHeight)
+		}
+
+		restoredFvk, err := restored.FullViewingKey()
+		if err != nil {
+			t.Fatalf("Failed to restore full viewing key: %v", err)
+		}
+
+		restoredUfvk, err := restoredFvk.Encode()
+		if err != nil {
+			t.Fatalf("Failed to encode full viewing key: %v", err)
+		}
+
+		if restoredUfvk != ufvk {
+			t.Fatalf("Expected UFVK %s, got %s", ufvk, restoredUfvk)
+		}
+	}
+
+	if _, err := ImportSealedOrchardKeyBackup(sealed, []byte("wrong")); !errors.Is(err, ErrSealedSecretWrongPassphrase) {
+		t.Fatalf("Expected ErrSealedSecretWrongPassphrase, got %v", err)
+	}
+}
+
+func TestOrchardKeyBackupRejectsCorruptedBlob(t *testing.T) {
+	backup := orchardKeyBackupFixture(t)
+
+	exported, err := backup.Export()
+	if err != nil {
+		t.Fatalf("Failed to export Orchard key backup: %v", err)
+	}
+
+	corrupted := bytes.Clone(exported)
+	corrupted[40] ^= 0x01
+	if _, err := ImportOrchardKeyBackup(corrupted); !errors.Is(err, ErrOrchardKeyBackupChecksumMismatch) {
+		t.Fatalf("Expected ErrOrchardKeyBackupChecksumMismatch, got %v", err)
+	}
+
+	if _, err := ImportOrchardKeyBackup(exported[:len(exported)-1]); !errors.Is(err, ErrOrchardKeyBackupMalformed) {
+		t.Fatalf("Expected ErrOrchardKeyBackupMalformed, got %v", err)
+	}
+
+	future := bytes.Clone(exported)
+	future[0] = orchardKeyBackupVersion + 1
+	if _, err := ImportOrchardKeyBackup(future); !errors.Is(err, ErrOrchardKeyBackupUnsupportedVersion) {
+		t.Fatalf("Expected ErrOrchardKeyBackupUnsupportedVersion, got %v", err)
+	}
+
+	backup.Nk = backup.Nk[:31]
+	if _, err := backup.Export(); !errors.Is(err, ErrOrchardKeyBackupMalformed) {
+		t.Fatalf("Expected ErrOrchardKeyBackupMalformed, got %v", err)
+	}
+}
+
+func TestOrchardKeyBackupRejectsUnsupportedNetworks(t *testing.T) {
+	backup := orchardKeyBackupFixture(t)
+
+	exported, err := backup.Export()
+	if err != nil {
+		t.Fatalf("Failed to export Orchard key backup: %v", err)
+	}
+
+	for _, network := range []ZcashNetwork{0, ZcashNetworkTestnet + 1, 0xff, 0x101} {
+		unsupported := backup
+		unsupported.Network = network
+		if _, err := unsupported.Export(); !errors.Is(err, ErrOrchardKeyBackupUnsupportedNetwork) {
+			t.Fatalf("Expected ErrOrchardKeyBackupUnsupportedNetwork for network %d, got %v", network, err)
+		}
+
+		if network > 0xff {
+			continue
+		}
+
+		// a blob with a valid checksum, as a buggy or newer exporter would write
+		body := bytes.Clone(exported[:orchardKeyBackupLength-4])
+		body[1] = byte(network)
+		if _, err := ImportOrchardKeyBackup(append(body, orchardKeyBackupChecksum(body)...)); !errors.Is(err, ErrOrchardKeyBackupUnsupportedNetwork) {
+			t.Fatalf("Expected ErrOrchardKeyBackupUnsupportedNetwork for network %d, got %v", network, err)
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Err* are used for checking Orchard spend authorization errors with `errors.Is`
+var ErrOrchardSpendAuthNoActions = errors.New("spend authorization has no actions")
+var ErrOrchardSpendAuthInvalidSighash = errors.New("sighash must be 32 bytes long")
+var ErrOrchardSpendAuthActionCount = errors.New("one item is expected per action")
+var ErrOrchardSpendAuthMixedParticipants = errors.New("items of a participant don't have the same identifier")
+var ErrOrchardSpendAuthUnexpectedRandomizer = errors.New("randomizer is not the one of the alpha of the action")
+var ErrOrchardSpendAuthUnexpectedMessage = errors.New("signing package doesn't sign the sighash of the authorization")
+
+// OrchardSpendAuthActionError is returned when the signing round of a
+// given action of an OrchardSpendAuthorization fails. Action is the index
+// of the action in Alphas.
+type OrchardSpendAuthActionError struct {
+	Action int
+	Err    error
+}
+
+func (e *OrchardSpendAuthActionError) Error() string {
+	return fmt.Sprintf("action %d: %s", e.Action, e.Err.Error())
+}
+
+func (e *OrchardSpendAuthActionError) Unwrap() error {
+	return e.Err
+}
+
+// OrchardSpendAuthorization is what a FROST group signs to spend its
+// Orchard notes: the 32-byte sighash of the transaction and the alpha
+// randomizer of each action spending a note of the group, as chosen by
+// the transaction builder.
+type OrchardSpendAuthorization struct {
+	Sighash []byte
+	Alphas  [][]byte
+}
+
+// randomizers validates the authorization and returns the randomizer of
+// each action.
+func (a OrchardSpendAuthorization) randomizers() ([]FrostRandomizer, error) {
+	if len(a.Sighash) != 32 {
+		return nil, ErrOrchardSpendAuthInvalidSighash
+	}
+
+	if len(a.Alphas) == 0 {
+		return nil, ErrOrchardSpendAuthNoActions
+	}
+
+	randomizers := make([]FrostRandomizer, 0, len(a.Alphas))
+	for action, alpha := range a.Alphas {
+		randomizer, err := RandomizerFromBytes(alpha)
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+		randomizers = append(randomizers, randomizer)
+	}
+
+	return randomizers, nil
+}
+
+// OrchardSpendAuthCoordinator runs a randomized FROST signing round for
+// each action of an OrchardSpendAuthorization, with the alpha of the
+// action as randomizer:
+//
+//  1. feed the commitments of each participant, one per action, to
+//     ReceiveCommitments.
+//  2. send the Round2Configuration of each action returned by
+//     CreateSigningPackages to the participants.
+//  3. feed their signature shares, one per action, to
+//     ReceiveSignatureShares.
+//  4. SpendAuthSignatures returns the spendAuthSig of each action.
+//
+// An OrchardSpendAuthCoordinator is safe for concurrent use by multiple
+// goroutines.
+type OrchardSpendAuthCoordinator struct {
+	mu            sync.Mutex
+	authorization OrchardSpendAuthorization
+	coordinators  []*Coordinator
+}
+
+// NewOrchardSpendAuthCoordinator creates a coordinator that gets
+// authorization signed by the participants of publicKeyPackage.
+func NewOrchardSpendAuthCoordinator(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, authorization OrchardSpendAuthorization) (*OrchardSpendAuthCoordinator, error) {
+	randomizers, err := authorization.randomizers()
+	if err != nil {
+		return nil, err
+	}
+
+	message := Message{Data: authorization.Sighash}
+
+	coordinators := make([]*Coordinator, 0, len(randomizers))
+	for _, randomizer := range randomizers {
+		coordinator, err := NewCoordinatorWithRandomizer(configuration, publicKeyPackage, message, randomizer)
+		if err != nil {
+			return nil, err
+		}
+		coordinators = append(coordinators, coordinator)
+	}
+
+	return &OrchardSpendAuthCoordinator{
+		authorization: authorization,
+		coordinators:  coordinators,
+	}, nil
+}
+
+// Authorization signed by this coordinator.
+func (c *OrchardSpendAuthCoordinator) Authorization() OrchardSpendAuthorization {
+	return c.authorization
+}
+
+// ReceiveCommitments receives the commitments of a participant, one per
+// action in the order of the actions.
+func (c *OrchardSpendAuthCoordinator) ReceiveCommitments(commitments []FrostSigningCommitments) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.checkParticipantItems(len(commitments), func(i int) ParticipantIdentifier { return commitments[i].Identifier }); err != nil {
+		return err
+	}
+
+	for action, commitment := range commitments {
+		if err := c.coordinators[action].ReceiveCommitment(commitment); err != nil {
+			return &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+	}
+
+	return nil
+}
+
+// CreateSigningPackages creates the Round2Configuration of each action,
+// in the order of the actions.
+func (c *OrchardSpendAuthCoordinator) CreateSigningPackages() ([]Round2Configuration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	round2Configs := make([]Round2Configuration, 0, len(c.coordinators))
+	for action, coordinator := range c.coordinators {
+		round2Config, err := coordinator.CreateSigningPackage()
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+		round2Configs = append(round2Configs, round2Config)
+	}
+
+	return round2Configs, nil
+}
+
+// ReceiveSignatureShares receives the signature shares of a participant,
+// one per action in the order of the actions. The shares of every action
+// are checked like ReceiveSignatureShare of a Coordinator does before any
+// of them is recorded: when one is rejected, none is, so the participant
+// can be asked to send all of them again.
+func (c *OrchardSpendAuthCoordinator) ReceiveSignatureShares(signatureShares []FrostSignatureShare) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.checkParticipantItems(len(signatureShares), func(i int) ParticipantIdentifier { return signatureShares[i].Identifier }); err != nil {
+		return err
+	}
+
+	for action, signatureShare := range signatureShares {
+		if err := c.coordinators[action].validateSignatureShare(signatureShare); err != nil {
+			return &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+	}
+
+	for action, signatureShare := range signatureShares {
+		c.coordinators[action].recordSignatureShare(signatureShare)
+	}
+
+	return nil
+}
+
+// SpendAuthSignatures aggregates the signature shares of each action into
+// its 64-byte spendAuthSig, in the order of the actions, ready to be
+// inserted in the transaction. Each signature is verified against the
+// randomized verifying key rk of its action.
+func (c *OrchardSpendAuthCoordinator) SpendAuthSignatures() ([][]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	signatures := make([][]byte, 0, len(c.coordinators))
+	for action, coordinator := range c.coordinators {
+		signature, err := coordinator.Aggregate()
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+
+		if err := coordinator.Verify(signature); err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+
+		signatures = append(signatures, signature.Data)
+	}
+
+	return signatures, nil
+}
+
+func (c *OrchardSpendAuthCoordinator) checkParticipantItems(count int, identifier func(int) ParticipantIdentifier) error {
+	if count != len(c.coordinators) {
+		return ErrOrchardSpendAuthActionCount
+	}
+
+	for i := 1; i < count; i++ {
+		if identifier(i) != identifier(0) {
+			return &CoordinatorIdentifierError{Identifier: identifier(i), Err: ErrOrchardSpendAuthMixedParticipants}
+		}
+	}
+
+	return nil
+}
+
+// OrchardSpendAuthSigner is a participant of the signing rounds of an
+// OrchardSpendAuthorization, with a SigningParticipant per action.
+//
+// The authorization must come from the transaction the participant
+// checked, e.g. by computing its sighash: Sign rejects the
+// Round2Configuration of an action whose signing package doesn't sign its
+// sighash or whose randomizer isn't the one of its alpha, before using
+// any nonces.
+//
+// An OrchardSpendAuthSigner is safe for concurrent use by multiple
+// goroutines.
+type OrchardSpendAuthSigner struct {
+	mu           sync.Mutex
+	sighash      []byte
+	randomizers  []FrostRandomizer
+	participants []*SigningParticipant
+}
+
+// NewOrchardSpendAuthSigner creates a signer of authorization with
+// keyPackage.
+func NewOrchardSpendAuthSigner(keyPackage FrostKeyPackage, authorization OrchardSpendAuthorization) (*OrchardSpendAuthSigner, error) {
+	randomizers, err := authorization.randomizers()
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]*SigningParticipant, 0, len(randomizers))
+	for range randomizers {
+		participants = append(participants, NewSigningParticipant(keyPackage))
+	}
+
+	return &OrchardSpendAuthSigner{
+		sighash:      bytes.Clone(authorization.Sighash),
+		randomizers:  randomizers,
+		participants: participants,
+	}, nil
+}
+
+// Identifier of this participant.
+func (s *OrchardSpendAuthSigner) Identifier() ParticipantIdentifier {
+	return s.participants[0].Identifier()
+}
+
+// Commit generates the commitment of each action, in the order of the
+// actions, with fresh signing nonces.
+func (s *OrchardSpendAuthSigner) Commit() ([]FrostSigningCommitments, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	commitments := make([]FrostSigningCommitments, 0, len(s.participants))
+	for action, participant := range s.participants {
+		commitment, err := participant.Commit()
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments, nil
+}
+
+// Sign produces the signature share of each action out of its
+// Round2Configuration, in the order of the actions, consuming the nonces
+// of the last commitments. The nonces are left untouched when a
+// Round2Configuration is rejected.
+func (s *OrchardSpendAuthSigner) Sign(round2Configs []Round2Configuration) ([]FrostSignatureShare, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(round2Configs) != len(s.participants) {
+		return nil, ErrOrchardSpendAuthActionCount
+	}
+
+	for action, round2Config := range round2Configs {
+		message, err := SigningPackageMessage(round2Config.SigningPackage)
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+
+		if !bytes.Equal(message.Data, s.sighash) {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: ErrOrchardSpendAuthUnexpectedMessage}
+		}
+
+		if !bytes.Equal(round2Config.Randomizer.Data, s.randomizers[action].Data) {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: ErrOrchardSpendAuthUnexpectedRandomizer}
+		}
+	}
+
+	signatureShares := make([]FrostSignatureShare, 0, len(s.participants))
+	for action, participant := range s.participants {
+		signatureShare, err := participant.Sign(round2Configs[action])
+		if err != nil {
+			return nil, &OrchardSpendAuthActionError{Action: action, Err: err}
+		}
+		signatureShares = append(signatureShares, signatureShare)
+	}
+
+	return signatureShares, nil
+}
@@ -0,0 +1,329 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+// arbitrary fixed group secret, sighash and alphas of two actions. They
+// don't come from a real transaction: any 32 bytes are a valid sighash,
+// and the alphas only need to be canonical Pallas scalars
+const (
+	orchardSpendAuthSecret  = "0e2da4d6c0ee1e73e83d4e1c5f3a5bd2d6a8e55b53b02b7bb6c8d7a6f2e1c103"
+	orchardSpendAuthSighash = "5d2ab3dc1e48ff9b63d2a2d6e8fdd1cbb0bd80a7e84e1c8a84db83c5e3c5a2f7"
+	orchardSpendAuthAlpha0  = "4a6f9c1d2e3b5a7c8d9e0f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d1e"
+	orchardSpendAuthAlpha1  = "c1b2a3948576a8b9cadbecfd0e1f20314253647586978a9bacbdcedfe0f10213"
+)
+
+func orchardSpendAuthFixture(t *testing.T) (Configuration, OrchardSpendAuthorization) {
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatalf("Failed to decode fixture: %v", err)
+		}
+		return b
+	}
+
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: decode(orchardSpendAuthSecret)}
+
+	return config, OrchardSpendAuthorization{
+		Sighash: decode(orchardSpendAuthSighash),
+		Alphas:  [][]byte{decode(orchardSpendAuthAlpha0), decode(orchardSpendAuthAlpha1)},
+	}
+}
+
+func TestOrchardSpendAuthSignsEachAction(t *testing.T) {
+	config, authorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewOrchardSpendAuthCoordinator(config, publicKey, authorization)
+	if err != nil {
+		t.Fatalf("Failed to create spend authorization coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, authorization)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		signatureShares, err := signer.Sign(round2Configs)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShares(signatureShares); err != nil {
+			t.Fatalf("Failed to receive signature shares: %v", err)
+		}
+	}
+
+	signatures, err := coordinator.SpendAuthSignatures()
+	if err != nil {
+		t.Fatalf("Failed to aggregate spend authorization signatures: %v", err)
+	}
+
+	if len(signatures) != len(authorization.Alphas) {
+		t.Fatalf("Expected %d signatures, got %d", len(authorization.Alphas), len(signatures))
+	}
+
+	message := Message{Data: authorization.Sighash}
+	for action, signature := range signatures {
+		if len(signature) != 64 {
+			t.Fatalf("Expected a 64-byte spendAuthSig, got %d bytes", len(signature))
+		}
+
+		randomizer, err := RandomizerFromBytes(authorization.Alphas[action])
+		if err != nil {
+			t.Fatalf("Failed to create randomizer from alpha: %v", err)
+		}
+
+		if err := VerifyRandomizedSignature(randomizer, message, FrostSignature{Data: signature}, publicKey); err != nil {
+			t.Fatalf("Failed to verify signature of action %d: %v", action, err)
+		}
+
+		// the signature of an action is bound to its alpha
+		other, err := RandomizerFromBytes(authorization.Alphas[1-action])
+		if err != nil {
+			t.Fatalf("Failed to create randomizer from alpha: %v", err)
+		}
+
+		if err := VerifyRandomizedSignature(other, message, FrostSignature{Data: signature}, publicKey); err == nil {
+			t.Fatalf("Signature of action %d verified with the alpha of another action", action)
+		}
+	}
+}
+
+func TestOrchardSpendAuthRejectsInvalidInput(t *testing.T) {
+	config, authorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	_, err := NewOrchardSpendAuthCoordinator(config, publicKey, OrchardSpendAuthorization{Sighash: authorization.Sighash[:31], Alphas: authorization.Alphas})
+	if !errors.Is(err, ErrOrchardSpendAuthInvalidSighash) {
+		t.Fatalf("Expected ErrOrchardSpendAuthInvalidSighash, got %v", err)
+	}
+
+	_, err = NewOrchardSpendAuthCoordinator(config, publicKey, OrchardSpendAuthorization{Sighash: authorization.Sighash})
+	if !errors.Is(err, ErrOrchardSpendAuthNoActions) {
+		t.Fatalf("Expected ErrOrchardSpendAuthNoActions, got %v", err)
+	}
+
+	// not a canonical Pallas scalar
+	notAScalar := bytes.Repeat([]byte{0xff}, 32)
+	_, err = NewOrchardSpendAuthCoordinator(config, publicKey, OrchardSpendAuthorization{Sighash: authorization.Sighash, Alphas: [][]byte{authorization.Alphas[0], notAScalar}})
+	var actionError *OrchardSpendAuthActionError
+	var frostError *FrostError
+	if !errors.As(err, &actionError) || actionError.Action != 1 || !errors.As(err, &frostError) {
+		t.Fatalf("Expected a FrostError for action 1, got %v", err)
+	}
+
+	coordinator, err := NewOrchardSpendAuthCoordinator(config, publicKey, authorization)
+	if err != nil {
+		t.Fatalf("Failed to create spend authorization coordinator: %v", err)
+	}
+
+	// a signer expecting other alphas refuses to sign
+	swapped := OrchardSpendAuthorization{Sighash: authorization.Sighash, Alphas: [][]byte{authorization.Alphas[1], authorization.Alphas[0]}}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, swapped)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments[:1]); !errors.Is(err, ErrOrchardSpendAuthActionCount) {
+			t.Fatalf("Expected ErrOrchardSpendAuthActionCount, got %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	if _, err := signers[0].Sign(round2Configs); !errors.Is(err, ErrOrchardSpendAuthUnexpectedRandomizer) {
+		t.Fatalf("Expected ErrOrchardSpendAuthUnexpectedRandomizer, got %v", err)
+	}
+}
+
+func TestOrchardSpendAuthSignerRejectsSwappedMessage(t *testing.T) {
+	config, authorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewOrchardSpendAuthCoordinator(config, publicKey, authorization)
+	if err != nil {
+		t.Fatalf("Failed to create spend authorization coordinator: %v", err)
+	}
+
+	// a coordinator swapping the sighash of another transaction spending
+	// with the same alphas
+	otherSighash := bytes.Repeat([]byte{0x42}, 32)
+	swapping, err := NewOrchardSpendAuthCoordinator(config, publicKey, OrchardSpendAuthorization{Sighash: otherSighash, Alphas: authorization.Alphas})
+	if err != nil {
+		t.Fatalf("Failed to create spend authorization coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, authorization)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		if err := swapping.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	swappedConfigs, err := swapping.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		_, err := signer.Sign(swappedConfigs)
+		var actionError *OrchardSpendAuthActionError
+		if !errors.As(err, &actionError) || actionError.Action != 0 || !errors.Is(err, ErrOrchardSpendAuthUnexpectedMessage) {
+			t.Fatalf("Expected ErrOrchardSpendAuthUnexpectedMessage for action 0, got %v", err)
+		}
+	}
+
+	// the nonces weren't used, the signers can still sign the sighash they
+	// agreed to
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		signatureShares, err := signer.Sign(round2Configs)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShares(signatureShares); err != nil {
+			t.Fatalf("Failed to receive signature shares: %v", err)
+		}
+	}
+
+	if _, err := coordinator.SpendAuthSignatures(); err != nil {
+		t.Fatalf("Failed to aggregate spend authorization signatures: %v", err)
+	}
+}
+
+func TestOrchardSpendAuthCoordinatorRecordsAllSharesOrNone(t *testing.T) {
+	config, authorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewOrchardSpendAuthCoordinator(config, publicKey, authorization)
+	if err != nil {
+		t.Fatalf("Failed to create spend authorization coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, authorization)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	signatureShares, err := signers[0].Sign(round2Configs)
+	if err != nil {
+		t.Fatalf("Failed to sign: %v", err)
+	}
+
+	// the share of action 0 is valid, the one of action 1 isn't
+	invalid := []FrostSignatureShare{signatureShares[0], signatureShares[0]}
+	err = coordinator.ReceiveSignatureShares(invalid)
+	var actionError *OrchardSpendAuthActionError
+	var culpritError *CoordinatorCulpritError
+	if !errors.As(err, &actionError) || actionError.Action != 1 || !errors.As(err, &culpritError) {
+		t.Fatalf("Expected a *CoordinatorCulpritError for action 1, got %v", err)
+	}
+
+	// nothing was recorded, the valid shares are still accepted
+	if err := coordinator.ReceiveSignatureShares(signatureShares); err != nil {
+		t.Fatalf("Failed to receive signature shares: %v", err)
+	}
+
+	err = coordinator.ReceiveSignatureShares(signatureShares)
+	if !errors.As(err, &actionError) || actionError.Action != 0 || !errors.Is(err, ErrCoordinatorRepeatedSignatureShare) {
+		t.Fatalf("Expected ErrCoordinatorRepeatedSignatureShare for action 0, got %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// Err* are used for checking PCZT signing errors with `errors.Is`
+var ErrPcztNoGroupActions = errors.New("PCZT has no unsigned Orchard action spending a note of the group")
+
+// PcztActionError is returned when the Orchard action of a PCZT at a given
+// index of its Orchard bundle is rejected.
+type PcztActionError struct {
+	Index uint32
+	Err   error
+}
+
+func (e *PcztActionError) Error() string {
+	return fmt.Sprintf("PCZT action %d: %s", e.Index, e.Err.Error())
+}
+
+func (e *PcztActionError) Unwrap() error {
+	return e.Err
+}
+
+// PcztSpendAuthorization is the OrchardSpendAuthorization of the Orchard
+// actions of a PCZT (partially created Zcash transaction) that a FROST
+// group has to sign: the unsigned ones whose rk is the verifying key of
+// the group randomized by their alpha. Actions[i] is the action of
+// Alphas[i].
+//
+// Actions of other signers, dummy actions and actions whose alpha was
+// redacted from the PCZT are left out.
+type PcztSpendAuthorization struct {
+	OrchardSpendAuthorization
+	Actions []PcztOrchardAction
+}
+
+// NewPcztSpendAuthorization parses pczt and returns the spend
+// authorization of the actions spending notes of the group of
+// publicKeyPackage.
+func NewPcztSpendAuthorization(pczt []byte, publicKeyPackage FrostPublicKeyPackage) (PcztSpendAuthorization, error) {
+	spendAuth, err := OrchardSpendAuthFromPczt(pczt)
+	if err != nil {
+		return PcztSpendAuthorization{}, err
+	}
+
+	return pcztSpendAuthorization(spendAuth, publicKeyPackage)
+}
+
+func pcztSpendAuthorization(spendAuth PcztOrchardSpendAuth, publicKeyPackage FrostPublicKeyPackage) (PcztSpendAuthorization, error) {
+	authorization := PcztSpendAuthorization{
+		OrchardSpendAuthorization: OrchardSpendAuthorization{Sighash: spendAuth.Sighash},
+	}
+
+	for _, action := range spendAuth.Actions {
+		if action.Alpha == nil || action.SpendAuthSig != nil {
+			continue
+		}
+
+		randomization, err := NewRandomization(publicKeyPackage, *action.Alpha)
+		if err != nil {
+			return PcztSpendAuthorization{}, &PcztActionError{Index: action.Index, Err: err}
+		}
+
+		if !bytes.Equal(randomization.RandomizedVerifyingKey, action.Rk) {
+			continue
+		}
+
+		authorization.Alphas = append(authorization.Alphas, *action.Alpha)
+		authorization.Actions = append(authorization.Actions, action)
+	}
+
+	if len(authorization.Actions) == 0 {
+		return PcztSpendAuthorization{}, ErrPcztNoGroupActions
+	}
+
+	return authorization, nil
+}
+
+// PcztSignerCoordinator plays the signer role of a PCZT for a FROST group.
+// It runs an OrchardSpendAuthCoordinator over the actions of the
+// PcztSpendAuthorization of the PCZT, whose participants are created with
+// NewPcztSignerParticipant, and then writes the spendAuthSig of each
+// action back into the PCZT with SignedPczt.
+//
+// A PcztSignerCoordinator is safe for concurrent use by multiple
+// goroutines.
+type PcztSignerCoordinator struct {
+	*OrchardSpendAuthCoordinator
+	pczt    []byte
+	actions []PcztOrchardAction
+}
+
+// NewPcztSignerCoordinator creates the signer role of pczt for the group
+// of publicKeyPackage.
+func NewPcztSignerCoordinator(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, pczt []byte) (*PcztSignerCoordinator, error) {
+	authorization, err := NewPcztSpendAuthorization(pczt, publicKeyPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPcztSignerCoordinator(configuration, publicKeyPackage, pczt, authorization)
+}
+
+func newPcztSignerCoordinator(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, pczt []byte, authorization PcztSpendAuthorization) (*PcztSignerCoordinator, error) {
+	coordinator, err := NewOrchardSpendAuthCoordinator(configuration, publicKeyPackage, authorization.OrchardSpendAuthorization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PcztSignerCoordinator{
+		OrchardSpendAuthCoordinator: coordinator,
+		pczt:                        pczt,
+		actions:                     authorization.Actions,
+	}, nil
+}
+
+// SignedActions returns the actions of the group with their spendAuthSig,
+// in the order of the actions of the spend authorization.
+func (c *PcztSignerCoordinator) SignedActions() ([]PcztOrchardAction, error) {
+	signatures, err := c.SpendAuthSignatures()
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]PcztOrchardAction, 0, len(c.actions))
+	for i, action := range c.actions {
+		signature := signatures[i]
+		action.SpendAuthSig = &signature
+		actions = append(actions, action)
+	}
+
+	return actions, nil
+}
+
+// SignedPczt returns the PCZT with the spendAuthSig of each action of the
+// group, ready to be handed to the next role.
+func (c *PcztSignerCoordinator) SignedPczt() ([]byte, error) {
+	actions, err := c.SignedActions()
+	if err != nil {
+		return nil, err
+	}
+
+	return PcztWithOrchardSpendAuthSignatures(c.pczt, actions)
+}
+
+// NewPcztSignerParticipant creates the signer of keyPackage for the
+// actions of pczt spending notes of the group of publicKeyPackage.
+// Participants must parse their own copy of the PCZT, after checking the
+// transaction it describes, so that they only sign its sighash with the
+// alphas of its actions: Sign of the signer rejects the signing packages
+// of a coordinator working on another PCZT before using any nonces.
+func NewPcztSignerParticipant(keyPackage FrostKeyPackage, publicKeyPackage FrostPublicKeyPackage, pczt []byte) (*OrchardSpendAuthSigner, error) {
+	authorization, err := NewPcztSpendAuthorization(pczt, publicKeyPackage)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOrchardSpendAuthSigner(keyPackage, authorization.OrchardSpendAuthorization)
+}
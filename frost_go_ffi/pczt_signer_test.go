@@ -0,0 +1,293 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+)
+
+// pcztSpendAuthFixture is the Orchard bundle of a PCZT spending notes of
+// the group of publicKey in actions 1 and 3, next to the spend of another
+// signer, a spend whose alpha was redacted and an already signed spend.
+func pcztSpendAuthFixture(t *testing.T, publicKey FrostPublicKeyPackage, authorization OrchardSpendAuthorization) PcztOrchardSpendAuth {
+	rk := func(publicKey FrostPublicKeyPackage, alpha []byte) []byte {
+		randomization, err := NewRandomization(publicKey, alpha)
+		if err != nil {
+			t.Fatalf("Failed to create randomization: %v", err)
+		}
+		return randomization.RandomizedVerifyingKey
+	}
+
+	otherPublicKey, _ := trustedDealerKeyPackages(t, Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}})
+
+	alpha0, alpha1 := authorization.Alphas[0], authorization.Alphas[1]
+	signature := bytes.Repeat([]byte{0x01}, 64)
+
+	return PcztOrchardSpendAuth{
+		Sighash: authorization.Sighash,
+		Actions: []PcztOrchardAction{
+			{Index: 0, Rk: rk(otherPublicKey, alpha0), Alpha: &alpha0},
+			{Index: 1, Rk: rk(publicKey, alpha0), Alpha: &alpha0},
+			{Index: 2, Rk: rk(publicKey, alpha1)},
+			{Index: 3, Rk: rk(publicKey, alpha1), Alpha: &alpha1},
+			{Index: 4, Rk: rk(publicKey, alpha1), Alpha: &alpha1, SpendAuthSig: &signature},
+		},
+	}
+}
+
+func TestPcztSignerSignsActionsOfTheGroup(t *testing.T) {
+	config, orchardAuthorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	authorization, err := pcztSpendAuthorization(pcztSpendAuthFixture(t, publicKey, orchardAuthorization), publicKey)
+	if err != nil {
+		t.Fatalf("Failed to get PCZT spend authorization: %v", err)
+	}
+
+	if len(authorization.Actions) != 2 || authorization.Actions[0].Index != 1 || authorization.Actions[1].Index != 3 {
+		t.Fatalf("Expected actions 1 and 3 to be signed by the group, got %v", authorization.Actions)
+	}
+
+	coordinator, err := newPcztSignerCoordinator(config, publicKey, nil, authorization)
+	if err != nil {
+		t.Fatalf("Failed to create PCZT signer coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, authorization.OrchardSpendAuthorization)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		signatureShares, err := signer.Sign(round2Configs)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShares(signatureShares); err != nil {
+			t.Fatalf("Failed to receive signature shares: %v", err)
+		}
+	}
+
+	actions, err := coordinator.SignedActions()
+	if err != nil {
+		t.Fatalf("Failed to get signed actions: %v", err)
+	}
+
+	message := Message{Data: orchardAuthorization.Sighash}
+	for _, action := range actions {
+		if action.SpendAuthSig == nil {
+			t.Fatalf("Action %d was not signed", action.Index)
+		}
+
+		// a verifier only sees the rk of the action
+		if err := VerifySignatureWithRandomizedVerifyingKey(action.Rk, message, FrostSignature{Data: *action.SpendAuthSig}); err != nil {
+			t.Fatalf("Failed to verify signature of action %d: %v", action.Index, err)
+		}
+	}
+}
+
+func TestPcztSignerRejectsPcztWithoutGroupActions(t *testing.T) {
+	config, orchardAuthorization := orchardSpendAuthFixture(t)
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+	otherPublicKey, _ := trustedDealerKeyPackages(t, Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}})
+
+	spendAuth := pcztSpendAuthFixture(t, publicKey, orchardAuthorization)
+
+	if _, err := pcztSpendAuthorization(spendAuth, otherPublicKey); !errors.Is(err, ErrPcztNoGroupActions) {
+		t.Fatalf("Expected ErrPcztNoGroupActions, got %v", err)
+	}
+
+	// not a canonical Pallas scalar
+	notAScalar := bytes.Repeat([]byte{0xff}, 32)
+	spendAuth.Actions[2].Alpha = &notAScalar
+
+	_, err := pcztSpendAuthorization(spendAuth, publicKey)
+	var actionError *PcztActionError
+	if !errors.As(err, &actionError) || actionError.Index != 2 {
+		t.Fatalf("Expected PcztActionError for action 2, got %v", err)
+	}
+
+	if _, err := NewPcztSignerCoordinator(config, publicKey, []byte("PCZT")); !errors.Is(err, ErrOrchardKeyErrorDeserializationError) {
+		t.Fatalf("Expected ErrOrchardKeyErrorDeserializationError, got %v", err)
+	}
+}
+
+func TestPcztSignerParticipantRejectsAnotherPczt(t *testing.T) {
+	config, orchardAuthorization := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	spendAuth := pcztSpendAuthFixture(t, publicKey, orchardAuthorization)
+
+	// the participants sign the PCZT they checked, as
+	// NewPcztSignerParticipant does with their own copy
+	authorization, err := pcztSpendAuthorization(spendAuth, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to get PCZT spend authorization: %v", err)
+	}
+
+	// the coordinator works on a PCZT of another transaction spending the
+	// same notes
+	otherSpendAuth := spendAuth
+	otherSpendAuth.Sighash = bytes.Repeat([]byte{0x42}, 32)
+
+	otherAuthorization, err := pcztSpendAuthorization(otherSpendAuth, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to get PCZT spend authorization: %v", err)
+	}
+
+	coordinator, err := newPcztSignerCoordinator(config, publicKey, nil, otherAuthorization)
+	if err != nil {
+		t.Fatalf("Failed to create PCZT signer coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewOrchardSpendAuthSigner(keyPackage, authorization.OrchardSpendAuthorization)
+		if err != nil {
+			t.Fatalf("Failed to create spend authorization signer: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		if _, err := signer.Sign(round2Configs); !errors.Is(err, ErrOrchardSpendAuthUnexpectedMessage) {
+			t.Fatalf("Expected ErrOrchardSpendAuthUnexpectedMessage, got %v", err)
+		}
+	}
+}
+
+// pcztFixture is a PCZT spending a note of the group of the orchard spend
+// authorization fixture next to an already signed dummy spend. It is
+// generated by the pczt_fixture example of the frost-uniffi-sdk crate.
+const pcztFixture = "testdata/orchard_spend.pczt"
+
+func TestPcztSignerSignsPcztFixture(t *testing.T) {
+	pczt, err := os.ReadFile(pcztFixture)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("%s not generated, run `cargo run -p frost-uniffi-sdk --example pczt_fixture`", pcztFixture)
+	}
+	if err != nil {
+		t.Fatalf("Failed to read PCZT fixture: %v", err)
+	}
+
+	config, _ := orchardSpendAuthFixture(t)
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewPcztSignerCoordinator(config, publicKey, pczt)
+	if err != nil {
+		t.Fatalf("Failed to create PCZT signer coordinator: %v", err)
+	}
+
+	var signers []*OrchardSpendAuthSigner
+	for _, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			break
+		}
+
+		signer, err := NewPcztSignerParticipant(keyPackage, publicKey, pczt)
+		if err != nil {
+			t.Fatalf("Failed to create PCZT signer participant: %v", err)
+		}
+
+		commitments, err := signer.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitments(commitments); err != nil {
+			t.Fatalf("Failed to receive commitments: %v", err)
+		}
+
+		signers = append(signers, signer)
+	}
+
+	round2Configs, err := coordinator.CreateSigningPackages()
+	if err != nil {
+		t.Fatalf("Failed to create signing packages: %v", err)
+	}
+
+	for _, signer := range signers {
+		signatureShares, err := signer.Sign(round2Configs)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShares(signatureShares); err != nil {
+			t.Fatalf("Failed to receive signature shares: %v", err)
+		}
+	}
+
+	signedPczt, err := coordinator.SignedPczt()
+	if err != nil {
+		t.Fatalf("Failed to write signatures into PCZT: %v", err)
+	}
+
+	spendAuth, err := OrchardSpendAuthFromPczt(signedPczt)
+	if err != nil {
+		t.Fatalf("Failed to parse signed PCZT: %v", err)
+	}
+
+	message := Message{Data: spendAuth.Sighash}
+	for _, action := range spendAuth.Actions {
+		if action.SpendAuthSig == nil {
+			t.Fatalf("Action %d of the signed PCZT has no spend_auth_sig", action.Index)
+		}
+
+		if err := VerifySignatureWithRandomizedVerifyingKey(action.Rk, message, FrostSignature{Data: *action.SpendAuthSig}); err != nil {
+			t.Fatalf("Failed to verify spend_auth_sig of action %d: %v", action.Index, err)
+		}
+	}
+
+	// nothing is left for the group to sign
+	if _, err := NewPcztSpendAuthorization(signedPczt, publicKey); !errors.Is(err, ErrPcztNoGroupActions) {
+		t.Fatalf("Expected ErrPcztNoGroupActions, got %v", err)
+	}
+}
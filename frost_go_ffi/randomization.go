@@ -0,0 +1,41 @@
+package frost_uniffi_sdk
+
+// Randomization is a randomizer chosen by the caller, instead of the
+// random one of CreateSigningPackage, along with the randomized verifying
+// key it gives to a group. Zcash requires the randomizer of an action to
+// be the alpha chosen by the transaction builder.
+//
+// Randomizer is the one to sign, aggregate and verify with, e.g. with
+// NewCoordinatorWithRandomizer or Sign, Aggregate and
+// VerifyRandomizedSignature. RandomizedVerifyingKey is the key the
+// signatures verify against, the rk of a Zcash action.
+type Randomization struct {
+	Randomizer             FrostRandomizer
+	RandomizedVerifyingKey []byte
+}
+
+// NewRandomization validates randomizer, a 32-byte little-endian encoded
+// scalar, and computes the randomized verifying key it gives to the group
+// of publicKeyPackage.
+func NewRandomization(publicKeyPackage FrostPublicKeyPackage, randomizer []byte) (Randomization, error) {
+	frostRandomizer, err := RandomizerFromBytes(randomizer)
+	if err != nil {
+		return Randomization{}, err
+	}
+
+	randomizedParams, err := RandomizedParamsFromPublicKeyAndRandomizer(publicKeyPackage, frostRandomizer)
+	if err != nil {
+		return Randomization{}, err
+	}
+	defer randomizedParams.Destroy()
+
+	randomizedVerifyingKey, err := randomizedParams.RandomizedVerifyingKey()
+	if err != nil {
+		return Randomization{}, err
+	}
+
+	return Randomization{
+		Randomizer:             frostRandomizer,
+		RandomizedVerifyingKey: randomizedVerifyingKey,
+	}, nil
+}
@@ -0,0 +1,250 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestRandomizationSignsWithCallerRandomizer(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	randomizer, _ := hex.DecodeString("4a6f9c1d2e3b5a7c8d9e0f1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d1e")
+
+	randomization, err := NewRandomization(publicKey, randomizer)
+	if err != nil {
+		t.Fatalf("Failed to create randomization: %v", err)
+	}
+
+	if !bytes.Equal(randomization.Randomizer.Data, randomizer) {
+		t.Fatalf("Expected randomizer %x, got %x", randomizer, randomization.Randomizer.Data)
+	}
+
+	if len(randomization.RandomizedVerifyingKey) != 32 {
+		t.Fatalf("Expected a 32-byte randomized verifying key, got %d bytes", len(randomization.RandomizedVerifyingKey))
+	}
+
+	if hex.EncodeToString(randomization.RandomizedVerifyingKey) == publicKey.VerifyingKey {
+		t.Fatalf("Randomized verifying key is the verifying key of the group")
+	}
+
+	coordinator, err := NewCoordinatorWithRandomizer(config, publicKey, message, randomization.Randomizer)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		participant := NewSigningParticipant(keyPackage)
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	if !bytes.Equal(round2Config.Randomizer.Data, randomizer) {
+		t.Fatalf("Coordinator did not use the randomizer of the caller")
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := VerifyRandomizedSignature(randomization.Randomizer, message, signature, publicKey); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+func TestRandomizationMatchesRandomParams(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var commitments []FrostSigningCommitments
+	for _, keyPackage := range keyPackages {
+		firstRoundCommitment, err := GenerateNoncesAndCommitments(keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to generate nonces and commitments: %v", err)
+		}
+		commitments = append(commitments, firstRoundCommitment.Commitments)
+	}
+
+	signingPackage, err := NewSigningPackage(Message{Data: []byte("i am a message")}, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	randomizedParams, err := RandomizedParamsFromPublicKeyAndSigningPackage(publicKey, signingPackage)
+	if err != nil {
+		t.Fatalf("Failed to derive randomized params: %v", err)
+	}
+	defer randomizedParams.Destroy()
+
+	randomizer, err := RandomizerFromParams(randomizedParams)
+	if err != nil {
+		t.Fatalf("Failed to get randomizer: %v", err)
+	}
+
+	randomizedVerifyingKey, err := randomizedParams.RandomizedVerifyingKey()
+	if err != nil {
+		t.Fatalf("Failed to get randomized verifying key: %v", err)
+	}
+
+	randomization, err := NewRandomization(publicKey, randomizer.Data)
+	if err != nil {
+		t.Fatalf("Failed to create randomization: %v", err)
+	}
+
+	if !bytes.Equal(randomization.RandomizedVerifyingKey, randomizedVerifyingKey) {
+		t.Fatalf("Expected randomized verifying key %x, got %x", randomizedVerifyingKey, randomization.RandomizedVerifyingKey)
+	}
+}
+
+func TestRandomizationRejectsInvalidRandomizers(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	// too short, and not a canonical Pallas scalar
+	for _, randomizer := range [][]byte{make([]byte, 31), bytes.Repeat([]byte{0xff}, 32)} {
+		_, err := NewRandomization(publicKey, randomizer)
+
+		var frostError *FrostError
+		if !errors.As(err, &frostError) {
+			t.Fatalf("Expected a FrostError for randomizer %x, got %v", randomizer, err)
+		}
+	}
+}
+
+func TestSignatureVerifiesAgainstRandomizedVerifyingKey(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	randomizer, _ := hex.DecodeString("c1b2a3948576a8b9cadbecfd0e1f20314253647586978a9bacbdcedfe0f10213")
+
+	frostRandomizer, err := RandomizerFromBytes(randomizer)
+	if err != nil {
+		t.Fatalf("Failed to create randomizer: %v", err)
+	}
+
+	randomizedParams, err := RandomizedParamsFromPublicKeyAndRandomizer(publicKey, frostRandomizer)
+	if err != nil {
+		t.Fatalf("Failed to create randomized params: %v", err)
+	}
+	defer randomizedParams.Destroy()
+
+	paramsRandomizer, err := randomizedParams.Randomizer()
+	if err != nil {
+		t.Fatalf("Failed to get randomizer: %v", err)
+	}
+
+	if !bytes.Equal(paramsRandomizer.Data, randomizer) {
+		t.Fatalf("Expected randomizer %x, got %x", randomizer, paramsRandomizer.Data)
+	}
+
+	rk, err := randomizedParams.RandomizedVerifyingKey()
+	if err != nil {
+		t.Fatalf("Failed to get randomized verifying key: %v", err)
+	}
+
+	rkHex, err := randomizedParams.RandomizedVerifyingKeyHex()
+	if err != nil {
+		t.Fatalf("Failed to get randomized verifying key: %v", err)
+	}
+
+	if rkHex != hex.EncodeToString(rk) {
+		t.Fatalf("Expected randomized verifying key %x, got %s", rk, rkHex)
+	}
+
+	coordinator, err := NewCoordinatorWithRandomizer(config, publicKey, message, paramsRandomizer)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		participant := NewSigningParticipant(keyPackage)
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := VerifySignatureWithRandomizedVerifyingKey(rk, message, signature); err != nil {
+		t.Fatalf("Failed to verify signature against rk: %v", err)
+	}
+
+	err = VerifySignatureWithRandomizedVerifyingKey(rk, Message{Data: []byte("another message")}, signature)
+	if !errors.Is(err, ErrFrostSignatureVerificationErrorValidationFailed) {
+		t.Fatalf("Expected ErrFrostSignatureVerificationErrorValidationFailed, got %v", err)
+	}
+
+	groupKey, _ := hex.DecodeString(publicKey.VerifyingKey)
+	err = VerifySignatureWithRandomizedVerifyingKey(groupKey, message, signature)
+	if !errors.Is(err, ErrFrostSignatureVerificationErrorValidationFailed) {
+		t.Fatalf("Expected ErrFrostSignatureVerificationErrorValidationFailed, got %v", err)
+	}
+
+	err = VerifySignatureWithRandomizedVerifyingKey(rk[:31], message, signature)
+	if !errors.Is(err, ErrFrostSignatureVerificationErrorInvalidVerifyingKey) {
+		t.Fatalf("Expected ErrFrostSignatureVerificationErrorInvalidVerifyingKey, got %v", err)
+	}
+}
@@ -0,0 +1,33 @@
+package frost_uniffi_sdk
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// ErrReconstructNotOrchardKey is returned when the verifying key of a group
+// is not a valid Orchard spend validating key `ak`.
+var ErrReconstructNotOrchardKey = errors.New("verifying key of the group is not an Orchard spend validating key")
+
+// ReconstructOrchardSpendAuthorizingKey reconstructs the signing key of a
+// redpallas group, as ReconstructSigningKey does, and returns it as the
+// Orchard spend authorizing key `ask` of the group verifying key, which
+// must be a valid Orchard spend validating key `ak`.
+//
+// WARNING: the spend authorizing key is no longer protected by the
+// threshold once reconstructed. Whoever holds it can spend the funds of the
+// wallet alone. This is only meant for disaster recovery, in an air-gapped
+// environment.
+func ReconstructOrchardSpendAuthorizingKey(keyPackages []FrostKeyPackage, publicKeyPackage FrostPublicKeyPackage) ([]byte, error) {
+	verifyingKey, err := hex.DecodeString(publicKeyPackage.VerifyingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := OrchardSpendValidatingKeyFromBytes(verifyingKey); err != nil {
+		return nil, ErrReconstructNotOrchardKey
+	}
+
+	// the redpallas signing key and `ask` share their encoding
+	return ReconstructSigningKey(keyPackages, publicKeyPackage)
+}
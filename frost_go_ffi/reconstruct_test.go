@@ -0,0 +1,79 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+func TestReconstructSigningKeyDealsTheSameGroup(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var threshold []FrostKeyPackage
+	for _, keyPackage := range keyPackages {
+		if len(threshold) < int(config.MinSigners) {
+			threshold = append(threshold, keyPackage)
+		}
+	}
+
+	secret, err := ReconstructSigningKey(threshold, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to reconstruct signing key: %v", err)
+	}
+
+	recovered := Configuration{MinSigners: config.MinSigners, MaxSigners: config.MaxSigners, Secret: secret}
+	keygen, err := TrustedDealerKeygenFrom(recovered)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	if keygen.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+		t.Fatalf("Expected verifying key %s, got %s", publicKey.VerifyingKey, keygen.PublicKeyPackage.VerifyingKey)
+	}
+
+	verifyingKey, err := hex.DecodeString(publicKey.VerifyingKey)
+	if err != nil {
+		t.Fatalf("Failed to decode verifying key: %v", err)
+	}
+
+	// only about half of the verifying keys are valid Orchard keys
+	ask, err := ReconstructOrchardSpendAuthorizingKey(threshold, publicKey)
+	if _, akErr := OrchardSpendValidatingKeyFromBytes(verifyingKey); akErr != nil {
+		if !errors.Is(err, ErrReconstructNotOrchardKey) {
+			t.Fatalf("Expected ErrReconstructNotOrchardKey, got %v", err)
+		}
+	} else if err != nil {
+		t.Fatalf("Failed to reconstruct spend authorizing key: %v", err)
+	} else if !bytes.Equal(ask, secret) {
+		t.Fatalf("Spend authorizing key differs from the signing key")
+	}
+}
+
+func TestReconstructSigningKeyRejectsInvalidKeyPackages(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+	_, otherKeyPackages := trustedDealerKeyPackages(t, config)
+
+	var identifiers []ParticipantIdentifier
+	for identifier := range keyPackages {
+		identifiers = append(identifiers, identifier)
+	}
+
+	if _, err := ReconstructSigningKey([]FrostKeyPackage{keyPackages[identifiers[0]]}, publicKey); !errors.Is(err, ErrFrostErrorIncorrectNumberOfShares) {
+		t.Fatalf("Expected ErrFrostErrorIncorrectNumberOfShares, got %v", err)
+	}
+
+	duplicated := []FrostKeyPackage{keyPackages[identifiers[0]], keyPackages[identifiers[0]]}
+	if _, err := ReconstructSigningKey(duplicated, publicKey); !errors.Is(err, ErrFrostErrorDuplicatedShares) {
+		t.Fatalf("Expected ErrFrostErrorDuplicatedShares, got %v", err)
+	}
+
+	foreign := []FrostKeyPackage{keyPackages[identifiers[0]], otherKeyPackages[identifiers[1]]}
+	if _, err := ReconstructSigningKey(foreign, publicKey); !errors.Is(err, ErrFrostErrorInvalidKeyPackage) {
+		t.Fatalf("Expected ErrFrostErrorInvalidKeyPackage, got %v", err)
+	}
+}
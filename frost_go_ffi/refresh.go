@@ -0,0 +1,119 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"sort"
+)
+
+// Err* are used for checking refresh errors with `errors.Is`
+var ErrRefreshVerifyingKeyChanged = errors.New("refreshed shares changed the verifying key of the group")
+var ErrRefreshInvalidMinSigners = errors.New("minimum number of signers must be between 2 and the number of participants of the group")
+
+// RefreshWithDealer computes, as a trusted dealer, a refreshing share for
+// every participant of the group of publicKeyPackage, along with the
+// public key package of the group once refreshed. minSigners must be the
+// threshold of the group, which the public key package doesn't tell: the
+// dealer needs no secret of the participants.
+//
+// Each refreshing share must be sent privately to its participant, which
+// applies it with RefreshKeyPackage and discards its current key package.
+// The verifying key of the group doesn't change, so neither do the
+// addresses derived from it.
+func RefreshWithDealer(publicKeyPackage FrostPublicKeyPackage, minSigners uint16) (TrustedKeyGeneration, error) {
+	participants := ParticipantList{Identifiers: refreshParticipants(publicKeyPackage)}
+
+	if minSigners < 2 || int(minSigners) > len(participants.Identifiers) {
+		return TrustedKeyGeneration{}, ErrRefreshInvalidMinSigners
+	}
+
+	refreshing, err := ComputeRefreshingSharesFrom(publicKeyPackage, minSigners, participants)
+	if err != nil {
+		return TrustedKeyGeneration{}, err
+	}
+
+	if refreshing.PublicKeyPackage.VerifyingKey != publicKeyPackage.VerifyingKey {
+		return TrustedKeyGeneration{}, ErrRefreshVerifyingKeyChanged
+	}
+
+	return refreshing, nil
+}
+
+// dkgRefresh holds the shares that a session created with
+// NewRefreshSession refreshes.
+type dkgRefresh struct {
+	keyPackage       FrostKeyPackage
+	publicKeyPackage FrostPublicKeyPackage
+}
+
+func (r *dkgRefresh) part3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package) (DkgPart3Result, error) {
+	result, err := RefreshPart3(secretPackage, round1Packages, round2Packages, r.publicKeyPackage, r.keyPackage)
+	if err != nil {
+		return DkgPart3Result{}, err
+	}
+
+	if result.PublicKeyPackage.VerifyingKey != r.publicKeyPackage.VerifyingKey {
+		return DkgPart3Result{}, ErrRefreshVerifyingKeyChanged
+	}
+
+	return result, nil
+}
+
+// NewRefreshSession starts refreshing the share of keyPackage together
+// with every other participant of the group of publicKeyPackage, without
+// a trusted dealer. It runs RefreshPart1 right away.
+//
+// The session is driven like any DkgSession, e.g. along with a
+// DkgBroadcast and a DkgChannel. Once completed, Result() holds the
+// refreshed key package of this participant and public key package of the
+// group, which must replace the current ones. The verifying key of the
+// group doesn't change.
+func NewRefreshSession(keyPackage FrostKeyPackage, publicKeyPackage FrostPublicKeyPackage) (*DkgSession, error) {
+	identifier := keyPackage.Identifier
+	participants := refreshParticipants(publicKeyPackage)
+
+	var peers []ParticipantIdentifier
+	for _, participant := range participants {
+		if participant != identifier {
+			peers = append(peers, participant)
+		}
+	}
+
+	if len(peers) == len(participants) {
+		return nil, &DkgPeerError{Identifier: identifier, Err: ErrDkgParticipantNotInGroup}
+	}
+
+	part1, err := RefreshPart1(keyPackage, uint16(len(participants)))
+	if err != nil {
+		return nil, err
+	}
+	defer part1.Destroy()
+
+	return &DkgSession{
+		identifier:     identifier,
+		peers:          peers,
+		phase:          DkgPhaseRound1,
+		round1Secret:   part1.Secret(),
+		round1Package:  part1.Package(),
+		receivedRound1: make(map[ParticipantIdentifier]DkgRound1Package),
+		receivedRound2: make(map[ParticipantIdentifier]DkgRound2Package),
+		refresh: &dkgRefresh{
+			keyPackage:       keyPackage,
+			publicKeyPackage: publicKeyPackage,
+		},
+	}, nil
+}
+
+// refreshParticipants returns the holders of a share of the group of
+// publicKeyPackage, sorted so that every participant lists them alike.
+func refreshParticipants(publicKeyPackage FrostPublicKeyPackage) []ParticipantIdentifier {
+	participants := make([]ParticipantIdentifier, 0, len(publicKeyPackage.VerifyingShares))
+	for identifier := range publicKeyPackage.VerifyingShares {
+		participants = append(participants, identifier)
+	}
+
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].Data < participants[j].Data
+	})
+
+	return participants
+}
@@ -0,0 +1,157 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func signWithKeyPackages(t *testing.T, config Configuration, publicKey FrostPublicKeyPackage, keyPackages map[ParticipantIdentifier]FrostKeyPackage) {
+	t.Helper()
+
+	coordinator, err := NewCoordinator(config, publicKey, Message{Data: []byte("i am a message")})
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		participant := NewSigningParticipant(keyPackage)
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
+
+func TestRefreshWithDealerKeepsVerifyingKey(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	refreshing, err := RefreshWithDealer(publicKey, config.MinSigners)
+	if err != nil {
+		t.Fatalf("Failed to compute refreshing shares: %v", err)
+	}
+
+	if refreshing.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+		t.Fatalf("Expected verifying key %s, got %s", publicKey.VerifyingKey, refreshing.PublicKeyPackage.VerifyingKey)
+	}
+
+	refreshed := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for identifier, keyPackage := range keyPackages {
+		refreshedKeyPackage, err := RefreshKeyPackage(refreshing.SecretShares[identifier], keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to refresh key package: %v", err)
+		}
+		refreshed[identifier] = refreshedKeyPackage
+	}
+
+	signWithKeyPackages(t, config, refreshing.PublicKeyPackage, refreshed)
+}
+
+func TestRefreshWithDealerRejectsInvalidMinSigners(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, _ := trustedDealerKeyPackages(t, config)
+
+	for _, minSigners := range []uint16{0, 1, config.MaxSigners + 1} {
+		if _, err := RefreshWithDealer(publicKey, minSigners); !errors.Is(err, ErrRefreshInvalidMinSigners) {
+			t.Fatalf("Expected ErrRefreshInvalidMinSigners for %d, got %v", minSigners, err)
+		}
+	}
+}
+
+func TestRefreshSessionKeepsVerifyingKey(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var sessions []*DkgSession
+	byIdentifier := make(map[ParticipantIdentifier]*DkgSession)
+	for _, keyPackage := range keyPackages {
+		session, err := NewRefreshSession(keyPackage, publicKey)
+		if err != nil {
+			t.Fatalf("Failed to create refresh session: %v", err)
+		}
+		sessions = append(sessions, session)
+		byIdentifier[session.Identifier()] = session
+	}
+
+	for _, sender := range sessions {
+		for _, receiver := range sessions {
+			if receiver == sender {
+				continue
+			}
+			if err := receiver.ReceiveRound1Package(sender.Round1Package()); err != nil {
+				t.Fatalf("Failed to receive round 1 package: %v", err)
+			}
+		}
+	}
+
+	for _, sender := range sessions {
+		round2Packages, err := sender.Round2Packages()
+		if err != nil {
+			t.Fatalf("Failed to get round 2 packages: %v", err)
+		}
+		for _, round2Package := range round2Packages {
+			receiver := byIdentifier[round2Package.Identifier]
+			if err := receiver.ReceiveRound2Package(sender.Identifier(), round2Package); err != nil {
+				t.Fatalf("Failed to receive round 2 package: %v", err)
+			}
+		}
+	}
+
+	var refreshedPublicKey FrostPublicKeyPackage
+	refreshed := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for _, session := range sessions {
+		result, err := session.Result()
+		if err != nil {
+			t.Fatalf("Failed to get refresh result: %v", err)
+		}
+
+		if result.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+			t.Fatalf("Expected verifying key %s, got %s", publicKey.VerifyingKey, result.PublicKeyPackage.VerifyingKey)
+		}
+
+		if result.PublicKeyPackage.VerifyingShares[session.Identifier()] == publicKey.VerifyingShares[session.Identifier()] {
+			t.Fatalf("Expected the share of %s to be refreshed", session.Identifier().Data)
+		}
+
+		refreshedPublicKey = result.PublicKeyPackage
+		refreshed[session.Identifier()] = result.KeyPackage
+	}
+
+	signWithKeyPackages(t, config, refreshedPublicKey, refreshed)
+}
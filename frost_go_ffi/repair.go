@@ -0,0 +1,217 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Err* are used for checking share repair errors with `errors.Is`
+var ErrRepairDuplicatedHelper = errors.New("helper appears more than once in the repair")
+var ErrRepairHelperNotInRepair = errors.New("participant is not one of the helpers of the repair")
+var ErrRepairParticipantIsHelper = errors.New("the participant being repaired can't be a helper")
+var ErrRepairUnknownHelper = errors.New("sender is not a helper of this repair")
+var ErrRepairRepeatedDelta = errors.New("delta already received from helper")
+var ErrRepairNotAddressedToHelper = errors.New("delta is addressed to another helper or participant")
+var ErrRepairIncomplete = errors.New("deltas of some helpers are missing")
+var ErrRepairNotEnoughSigmas = errors.New("fewer sigmas than required to sign")
+
+// RepairPeerError is returned when a message received from a given helper
+// is rejected.
+type RepairPeerError struct {
+	Identifier ParticipantIdentifier
+	Err        error
+}
+
+func (e *RepairPeerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Identifier.Data)
+}
+
+func (e *RepairPeerError) Unwrap() error {
+	return e.Err
+}
+
+// RepairDelta is the contribution of Sender to the sigma that Recipient
+// computes for Participant. It is secret material: it must only be sent
+// privately to Recipient.
+type RepairDelta struct {
+	Sender      ParticipantIdentifier
+	Recipient   ParticipantIdentifier
+	Participant ParticipantIdentifier
+	Data        string
+}
+
+// RepairSigma is the contribution of Sender to the signing share of
+// Participant. It is secret material: it must only be sent privately to
+// Participant.
+type RepairSigma struct {
+	Sender      ParticipantIdentifier
+	Participant ParticipantIdentifier
+	Data        string
+}
+
+// RepairHelper drives a helper through the repair of the share of a
+// participant that lost its key package, following the repairable
+// threshold scheme (RTS):
+//
+//  1. send each of Deltas() privately to the helper it is addressed to,
+//     and feed the deltas addressed to this helper to ReceiveDelta.
+//  2. once all of them arrived, send Sigma() privately to the participant
+//     being repaired, which recovers its key package with
+//     RepairKeyPackage.
+//
+// At least MinSigners helpers must take part. None of them learns the
+// repaired share, nor the share of another helper.
+//
+// A RepairHelper is safe for concurrent use by multiple goroutines.
+type RepairHelper struct {
+	mu          sync.Mutex
+	identifier  ParticipantIdentifier
+	participant ParticipantIdentifier
+	helpers     []ParticipantIdentifier
+	deltas      []RepairDelta
+	received    map[ParticipantIdentifier]string
+	sigma       *RepairSigma
+}
+
+// NewRepairHelper starts helping participant recover its share along with
+// helpers, the full list of helpers including the one of keyPackage. It
+// runs RepairShareStep1 right away.
+func NewRepairHelper(keyPackage FrostKeyPackage, helpers []ParticipantIdentifier, participant ParticipantIdentifier) (*RepairHelper, error) {
+	identifier := keyPackage.Identifier
+
+	seen := make(map[ParticipantIdentifier]bool, len(helpers))
+	for _, helper := range helpers {
+		if seen[helper] {
+			return nil, &RepairPeerError{Identifier: helper, Err: ErrRepairDuplicatedHelper}
+		}
+		seen[helper] = true
+	}
+
+	if seen[participant] {
+		return nil, &RepairPeerError{Identifier: participant, Err: ErrRepairParticipantIsHelper}
+	}
+
+	if !seen[identifier] {
+		return nil, &RepairPeerError{Identifier: identifier, Err: ErrRepairHelperNotInRepair}
+	}
+
+	deltas, err := RepairShareStep1(helpers, keyPackage, participant)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &RepairHelper{
+		identifier:  identifier,
+		participant: participant,
+		helpers:     helpers,
+		received:    map[ParticipantIdentifier]string{identifier: deltas[identifier]},
+	}
+
+	for _, helper := range helpers {
+		if helper == identifier {
+			continue
+		}
+		h.deltas = append(h.deltas, RepairDelta{
+			Sender:      identifier,
+			Recipient:   helper,
+			Participant: participant,
+			Data:        deltas[helper],
+		})
+	}
+
+	return h, nil
+}
+
+// Identifier of the helper running this repair.
+func (h *RepairHelper) Identifier() ParticipantIdentifier {
+	return h.identifier
+}
+
+// Deltas returns the deltas of this helper for the other helpers.
+func (h *RepairHelper) Deltas() []RepairDelta {
+	return h.deltas
+}
+
+// ReceiveDelta receives the delta that another helper addressed to this
+// one. When the last missing delta arrives, Sigma becomes available.
+func (h *RepairHelper) ReceiveDelta(delta RepairDelta) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	sender := delta.Sender
+
+	if delta.Recipient != h.identifier || delta.Participant != h.participant {
+		return &RepairPeerError{Identifier: sender, Err: ErrRepairNotAddressedToHelper}
+	}
+
+	isHelper := false
+	for _, helper := range h.helpers {
+		if helper == sender {
+			isHelper = true
+			break
+		}
+	}
+
+	if !isHelper {
+		return &RepairPeerError{Identifier: sender, Err: ErrRepairUnknownHelper}
+	}
+
+	if _, ok := h.received[sender]; ok {
+		return &RepairPeerError{Identifier: sender, Err: ErrRepairRepeatedDelta}
+	}
+
+	h.received[sender] = delta.Data
+
+	if len(h.received) < len(h.helpers) {
+		return nil
+	}
+
+	sigma, err := RepairShareStep2(h.received)
+	if err != nil {
+		return err
+	}
+
+	h.sigma = &RepairSigma{
+		Sender:      h.identifier,
+		Participant: h.participant,
+		Data:        sigma,
+	}
+
+	return nil
+}
+
+// Sigma returns the sigma of this helper once every delta was received.
+func (h *RepairHelper) Sigma() (RepairSigma, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.sigma == nil {
+		return RepairSigma{}, ErrRepairIncomplete
+	}
+
+	return *h.sigma, nil
+}
+
+// RepairKeyPackage recovers the key package of participant, in the group
+// of publicKeyPackage where minSigners are required to sign, from the
+// sigmas of all the helpers. The key package is checked against the
+// verifying share of participant: it is the one that was lost.
+func RepairKeyPackage(participant ParticipantIdentifier, sigmas []RepairSigma, publicKeyPackage FrostPublicKeyPackage, minSigners uint16) (FrostKeyPackage, error) {
+	if len(sigmas) < int(minSigners) {
+		return FrostKeyPackage{}, ErrRepairNotEnoughSigmas
+	}
+
+	bySender := make(map[ParticipantIdentifier]string, len(sigmas))
+	for _, sigma := range sigmas {
+		if sigma.Participant != participant {
+			return FrostKeyPackage{}, &RepairPeerError{Identifier: sigma.Sender, Err: ErrRepairNotAddressedToHelper}
+		}
+		if _, ok := bySender[sigma.Sender]; ok {
+			return FrostKeyPackage{}, &RepairPeerError{Identifier: sigma.Sender, Err: ErrRepairDuplicatedHelper}
+		}
+		bySender[sigma.Sender] = sigma.Data
+	}
+
+	return RepairShareStep3(bySender, participant, publicKeyPackage, minSigners)
+}
@@ -0,0 +1,119 @@
+package frost_uniffi_sdk
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestRepairHelpersRecoverLostKeyPackage(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var lost ParticipantIdentifier
+	var helpers []ParticipantIdentifier
+	for identifier := range keyPackages {
+		if lost.Data == "" {
+			lost = identifier
+			continue
+		}
+		helpers = append(helpers, identifier)
+	}
+
+	byIdentifier := make(map[ParticipantIdentifier]*RepairHelper)
+	for _, identifier := range helpers {
+		helper, err := NewRepairHelper(keyPackages[identifier], helpers, lost)
+		if err != nil {
+			t.Fatalf("Failed to create repair helper: %v", err)
+		}
+		byIdentifier[identifier] = helper
+	}
+
+	for _, sender := range byIdentifier {
+		for _, delta := range sender.Deltas() {
+			if err := byIdentifier[delta.Recipient].ReceiveDelta(delta); err != nil {
+				t.Fatalf("Failed to receive delta: %v", err)
+			}
+		}
+	}
+
+	var sigmas []RepairSigma
+	for _, helper := range byIdentifier {
+		sigma, err := helper.Sigma()
+		if err != nil {
+			t.Fatalf("Failed to get sigma: %v", err)
+		}
+		sigmas = append(sigmas, sigma)
+	}
+
+	repaired, err := RepairKeyPackage(lost, sigmas, publicKey, config.MinSigners)
+	if err != nil {
+		t.Fatalf("Failed to repair key package: %v", err)
+	}
+
+	if repaired.Identifier != lost || !bytes.Equal(repaired.Data, keyPackages[lost].Data) {
+		t.Fatalf("Repaired key package differs from the lost one")
+	}
+
+	signWithKeyPackages(t, config, publicKey, map[ParticipantIdentifier]FrostKeyPackage{
+		lost:       repaired,
+		helpers[0]: keyPackages[helpers[0]],
+	})
+}
+
+func TestRepairRejectsUnexpectedMessages(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var identifiers []ParticipantIdentifier
+	for identifier := range keyPackages {
+		identifiers = append(identifiers, identifier)
+	}
+	lost, helpers := identifiers[0], identifiers[1:]
+
+	_, err := NewRepairHelper(keyPackages[helpers[0]], identifiers, lost)
+	if !errors.Is(err, ErrRepairParticipantIsHelper) {
+		t.Fatalf("Expected ErrRepairParticipantIsHelper, got %v", err)
+	}
+
+	helper, err := NewRepairHelper(keyPackages[helpers[0]], helpers, lost)
+	if err != nil {
+		t.Fatalf("Failed to create repair helper: %v", err)
+	}
+
+	other, err := NewRepairHelper(keyPackages[helpers[1]], helpers, lost)
+	if err != nil {
+		t.Fatalf("Failed to create repair helper: %v", err)
+	}
+
+	delta := other.Deltas()[0]
+
+	forged := delta
+	forged.Sender = lost
+	if err := helper.ReceiveDelta(forged); !errors.Is(err, ErrRepairUnknownHelper) {
+		t.Fatalf("Expected ErrRepairUnknownHelper, got %v", err)
+	}
+
+	if _, err := helper.Sigma(); !errors.Is(err, ErrRepairIncomplete) {
+		t.Fatalf("Expected ErrRepairIncomplete, got %v", err)
+	}
+
+	if err := helper.ReceiveDelta(delta); err != nil {
+		t.Fatalf("Failed to receive delta: %v", err)
+	}
+
+	if err := helper.ReceiveDelta(delta); !errors.Is(err, ErrRepairRepeatedDelta) {
+		t.Fatalf("Expected ErrRepairRepeatedDelta, got %v", err)
+	}
+
+	sigma, err := helper.Sigma()
+	if err != nil {
+		t.Fatalf("Failed to get sigma: %v", err)
+	}
+
+	if _, err := RepairKeyPackage(lost, []RepairSigma{sigma}, publicKey, config.MinSigners); !errors.Is(err, ErrRepairNotEnoughSigmas) {
+		t.Fatalf("Expected ErrRepairNotEnoughSigmas, got %v", err)
+	}
+}
@@ -0,0 +1,216 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Err* are used for checking resharing errors with `errors.Is`
+var ErrReshareDuplicatedParticipant = errors.New("participant appears more than once in the resharing")
+var ErrReshareParticipantNotInGroup = errors.New("participant is not part of the new group")
+var ErrReshareUnknownSigner = errors.New("sender is not an old signer of this resharing")
+var ErrReshareRepeatedShare = errors.New("share already received from old signer")
+var ErrReshareNotAddressedToParticipant = errors.New("share is addressed to another participant")
+var ErrReshareIncomplete = errors.New("shares of some old signers are missing")
+
+// ResharePeerError is returned when a share received from a given old
+// signer is rejected.
+type ResharePeerError struct {
+	Identifier ParticipantIdentifier
+	Err        error
+}
+
+func (e *ResharePeerError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Err.Error(), e.Identifier.Data)
+}
+
+func (e *ResharePeerError) Unwrap() error {
+	return e.Err
+}
+
+// Reshare describes how the signing key of a group is handed out from
+// OldSigners, at least MinSigners of the current group, to
+// NewParticipants, which form a group with the same verifying key where
+// Configuration.MinSigners of them are required to sign. The Secret of
+// Configuration is ignored.
+//
+// New participants may include old ones, which get a new share under the
+// same identifier, and leave out others, whose shares become useless.
+type Reshare struct {
+	OldSigners      []ParticipantIdentifier
+	Configuration   Configuration
+	NewParticipants []ParticipantIdentifier
+}
+
+// ReshareShare is the share that Sender, an old signer, dealt to the new
+// participant Share.Identifier. It is secret material: it must only be
+// sent privately to that participant.
+type ReshareShare struct {
+	Sender ParticipantIdentifier
+	Share  FrostSecretKeyShare
+}
+
+// Shares runs ReshareStep1 for the old signer of keyPackage and returns
+// its share for each new participant.
+func (r Reshare) Shares(keyPackage FrostKeyPackage) ([]ReshareShare, error) {
+	shares, err := ReshareStep1(keyPackage, r.OldSigners, r.Configuration, r.NewParticipants)
+	if err != nil {
+		return nil, err
+	}
+
+	reshareShares := make([]ReshareShare, 0, len(shares))
+	for _, participant := range r.NewParticipants {
+		reshareShares = append(reshareShares, ReshareShare{
+			Sender: keyPackage.Identifier,
+			Share:  shares[participant],
+		})
+	}
+
+	return reshareShares, nil
+}
+
+// ReshareSession collects the shares dealt to a new participant by every
+// old signer of a Reshare:
+//
+//  1. feed the share of each old signer to ReceiveShare.
+//  2. once all of them arrived, Result() holds the key package of this
+//     participant and the public key package of the new group.
+//
+// New participants must make sure that they all got the same public key
+// package, e.g. by comparing it over an authenticated channel, before
+// using their key package. Its verifying key is checked to be the one of
+// the current group.
+//
+// A ReshareSession is safe for concurrent use by multiple goroutines.
+type ReshareSession struct {
+	mu               sync.Mutex
+	identifier       ParticipantIdentifier
+	publicKeyPackage FrostPublicKeyPackage
+	reshare          Reshare
+	received         map[ParticipantIdentifier]FrostSecretKeyShare
+	result           *DkgPart3Result
+}
+
+// NewReshareSession starts collecting the shares of the new participant
+// identifier, for a group currently described by publicKeyPackage.
+func NewReshareSession(identifier ParticipantIdentifier, publicKeyPackage FrostPublicKeyPackage, reshare Reshare) (*ReshareSession, error) {
+	seen := make(map[ParticipantIdentifier]bool, len(reshare.NewParticipants))
+	for _, participant := range reshare.NewParticipants {
+		if seen[participant] {
+			return nil, &ResharePeerError{Identifier: participant, Err: ErrReshareDuplicatedParticipant}
+		}
+		seen[participant] = true
+	}
+
+	if !seen[identifier] {
+		return nil, &ResharePeerError{Identifier: identifier, Err: ErrReshareParticipantNotInGroup}
+	}
+
+	seen = make(map[ParticipantIdentifier]bool, len(reshare.OldSigners))
+	for _, signer := range reshare.OldSigners {
+		if seen[signer] {
+			return nil, &ResharePeerError{Identifier: signer, Err: ErrReshareDuplicatedParticipant}
+		}
+		seen[signer] = true
+	}
+
+	return &ReshareSession{
+		identifier:       identifier,
+		publicKeyPackage: publicKeyPackage,
+		reshare:          reshare,
+		received:         make(map[ParticipantIdentifier]FrostSecretKeyShare),
+	}, nil
+}
+
+// Identifier of the new participant running this session.
+func (s *ReshareSession) Identifier() ParticipantIdentifier {
+	return s.identifier
+}
+
+// ReceiveShare receives the share dealt to this participant by an old
+// signer. When the last missing share arrives the session runs
+// ReshareStep2. If it fails because of the share of an old signer, that
+// share is dropped so that a correct one can be received and a
+// ResharePeerError is returned for its sender. If it fails otherwise,
+// every share is dropped.
+func (s *ReshareSession) ReceiveShare(share ReshareShare) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sender := share.Sender
+
+	if share.Share.Identifier != s.identifier {
+		return &ResharePeerError{Identifier: sender, Err: ErrReshareNotAddressedToParticipant}
+	}
+
+	isSigner := false
+	for _, signer := range s.reshare.OldSigners {
+		if signer == sender {
+			isSigner = true
+			break
+		}
+	}
+
+	if !isSigner {
+		return &ResharePeerError{Identifier: sender, Err: ErrReshareUnknownSigner}
+	}
+
+	if _, ok := s.received[sender]; ok || s.result != nil {
+		return &ResharePeerError{Identifier: sender, Err: ErrReshareRepeatedShare}
+	}
+
+	s.received[sender] = share.Share
+
+	if len(s.received) < len(s.reshare.OldSigners) {
+		return nil
+	}
+
+	result, err := ReshareStep2(s.received, s.publicKeyPackage, s.reshare.Configuration, s.reshare.NewParticipants, s.identifier)
+	if err != nil {
+		// a correct share may still be received from the culprit
+		if culprit, ok := dkgCulprit(err); ok {
+			delete(s.received, culprit)
+			return &ResharePeerError{Identifier: culprit, Err: err}
+		}
+
+		// without a culprit, no share can be trusted
+		for signer := range s.received {
+			delete(s.received, signer)
+		}
+		return err
+	}
+
+	s.result = &result
+
+	return nil
+}
+
+// MissingSigners returns the old signers whose share has not been
+// received yet.
+func (s *ReshareSession) MissingSigners() []ParticipantIdentifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var missing []ParticipantIdentifier
+	for _, signer := range s.reshare.OldSigners {
+		if _, ok := s.received[signer]; !ok {
+			missing = append(missing, signer)
+		}
+	}
+
+	return missing
+}
+
+// Result returns the key package of this participant and the public key
+// package of the new group once every share was received.
+func (s *ReshareSession) Result() (DkgPart3Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.result == nil {
+		return DkgPart3Result{}, ErrReshareIncomplete
+	}
+
+	return *s.result, nil
+}
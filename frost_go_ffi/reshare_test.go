@@ -0,0 +1,217 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestReshareMovesGroupToNewThreshold(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	newConfig := Configuration{MinSigners: 3, MaxSigners: 5, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var oldSigners []ParticipantIdentifier
+	for identifier := range keyPackages {
+		if len(oldSigners) < int(config.MinSigners) {
+			oldSigners = append(oldSigners, identifier)
+		}
+	}
+
+	var newParticipants []ParticipantIdentifier
+	for i := uint16(1); i <= newConfig.MaxSigners; i++ {
+		identifier, err := IdentifierFromUint16(i)
+		if err != nil {
+			t.Fatalf("Failed to create identifier: %v", err)
+		}
+		newParticipants = append(newParticipants, identifier)
+	}
+
+	reshare := Reshare{OldSigners: oldSigners, Configuration: newConfig, NewParticipants: newParticipants}
+
+	sessions := make(map[ParticipantIdentifier]*ReshareSession)
+	for _, identifier := range newParticipants {
+		session, err := NewReshareSession(identifier, publicKey, reshare)
+		if err != nil {
+			t.Fatalf("Failed to create reshare session: %v", err)
+		}
+		sessions[identifier] = session
+	}
+
+	for _, signer := range oldSigners {
+		shares, err := reshare.Shares(keyPackages[signer])
+		if err != nil {
+			t.Fatalf("Failed to reshare: %v", err)
+		}
+		for _, share := range shares {
+			if err := sessions[share.Share.Identifier].ReceiveShare(share); err != nil {
+				t.Fatalf("Failed to receive share: %v", err)
+			}
+		}
+	}
+
+	var newPublicKey FrostPublicKeyPackage
+	newKeyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for identifier, session := range sessions {
+		result, err := session.Result()
+		if err != nil {
+			t.Fatalf("Failed to get reshare result: %v", err)
+		}
+
+		if result.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+			t.Fatalf("Expected verifying key %s, got %s", publicKey.VerifyingKey, result.PublicKeyPackage.VerifyingKey)
+		}
+
+		if len(result.PublicKeyPackage.VerifyingShares) != len(newParticipants) {
+			t.Fatalf("Expected %d verifying shares, got %d", len(newParticipants), len(result.PublicKeyPackage.VerifyingShares))
+		}
+
+		for participant, verifyingShare := range newPublicKey.VerifyingShares {
+			if result.PublicKeyPackage.VerifyingShares[participant] != verifyingShare {
+				t.Fatalf("Participants derived different verifying shares")
+			}
+		}
+
+		newPublicKey = result.PublicKeyPackage
+		if len(newKeyPackages) < int(newConfig.MinSigners) {
+			newKeyPackages[identifier] = result.KeyPackage
+		}
+	}
+
+	signWithKeyPackages(t, newConfig, newPublicKey, newKeyPackages)
+}
+
+func TestReshareRejectsUnexpectedShares(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var identifiers []ParticipantIdentifier
+	for identifier := range keyPackages {
+		identifiers = append(identifiers, identifier)
+	}
+
+	reshare := Reshare{OldSigners: identifiers[:2], Configuration: config, NewParticipants: identifiers}
+
+	session, err := NewReshareSession(identifiers[0], publicKey, reshare)
+	if err != nil {
+		t.Fatalf("Failed to create reshare session: %v", err)
+	}
+
+	shares, err := reshare.Shares(keyPackages[identifiers[0]])
+	if err != nil {
+		t.Fatalf("Failed to reshare: %v", err)
+	}
+
+	var own, other ReshareShare
+	for _, share := range shares {
+		if share.Share.Identifier == identifiers[0] {
+			own = share
+		} else {
+			other = share
+		}
+	}
+
+	if err := session.ReceiveShare(other); !errors.Is(err, ErrReshareNotAddressedToParticipant) {
+		t.Fatalf("Expected ErrReshareNotAddressedToParticipant, got %v", err)
+	}
+
+	forged := own
+	forged.Sender = identifiers[2]
+	if err := session.ReceiveShare(forged); !errors.Is(err, ErrReshareUnknownSigner) {
+		t.Fatalf("Expected ErrReshareUnknownSigner, got %v", err)
+	}
+
+	if err := session.ReceiveShare(own); err != nil {
+		t.Fatalf("Failed to receive share: %v", err)
+	}
+
+	if err := session.ReceiveShare(own); !errors.Is(err, ErrReshareRepeatedShare) {
+		t.Fatalf("Expected ErrReshareRepeatedShare, got %v", err)
+	}
+
+	if _, err := session.Result(); !errors.Is(err, ErrReshareIncomplete) {
+		t.Fatalf("Expected ErrReshareIncomplete, got %v", err)
+	}
+
+	if missing := session.MissingSigners(); len(missing) != 1 || missing[0] != identifiers[1] {
+		t.Fatalf("Expected %s to be missing, got %v", identifiers[1].Data, missing)
+	}
+
+	// a share dealt by an old signer for another resharing doesn't add up
+	misdealt, err := Reshare{OldSigners: identifiers, Configuration: config, NewParticipants: identifiers}.Shares(keyPackages[identifiers[1]])
+	if err != nil {
+		t.Fatalf("Failed to reshare: %v", err)
+	}
+
+	for _, share := range misdealt {
+		if share.Share.Identifier != identifiers[0] {
+			continue
+		}
+		if err := session.ReceiveShare(share); !errors.Is(err, ErrFrostErrorInvalidSecretShare) {
+			t.Fatalf("Expected ErrFrostErrorInvalidSecretShare, got %v", err)
+		}
+	}
+}
+
+func TestReshareDropsShareOfCulprit(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var identifiers []ParticipantIdentifier
+	for identifier := range keyPackages {
+		identifiers = append(identifiers, identifier)
+	}
+
+	reshare := Reshare{OldSigners: identifiers[:2], Configuration: config, NewParticipants: identifiers}
+
+	session, err := NewReshareSession(identifiers[2], publicKey, reshare)
+	if err != nil {
+		t.Fatalf("Failed to create reshare session: %v", err)
+	}
+
+	shareFor := func(r Reshare, sender ParticipantIdentifier) ReshareShare {
+		shares, err := r.Shares(keyPackages[sender])
+		if err != nil {
+			t.Fatalf("Failed to reshare: %v", err)
+		}
+		for _, share := range shares {
+			if share.Share.Identifier == identifiers[2] {
+				return share
+			}
+		}
+		t.Fatalf("No share for %s", identifiers[2].Data)
+		return ReshareShare{}
+	}
+
+	// the first sender deals a share for another resharing, which is only
+	// noticed once the share of the last sender arrives
+	misdealt := shareFor(Reshare{OldSigners: identifiers, Configuration: config, NewParticipants: identifiers}, identifiers[0])
+	if err := session.ReceiveShare(misdealt); err != nil {
+		t.Fatalf("Failed to receive share: %v", err)
+	}
+
+	err = session.ReceiveShare(shareFor(reshare, identifiers[1]))
+	var peerError *ResharePeerError
+	if !errors.As(err, &peerError) || peerError.Identifier != identifiers[0] || !errors.Is(err, ErrFrostErrorInvalidSecretShare) {
+		t.Fatalf("Expected ErrFrostErrorInvalidSecretShare from %s, got %v", identifiers[0].Data, err)
+	}
+
+	if missing := session.MissingSigners(); len(missing) != 1 || missing[0] != identifiers[0] {
+		t.Fatalf("Expected %s to be missing, got %v", identifiers[0].Data, missing)
+	}
+
+	if err := session.ReceiveShare(shareFor(reshare, identifiers[0])); err != nil {
+		t.Fatalf("Failed to receive share: %v", err)
+	}
+
+	result, err := session.Result()
+	if err != nil {
+		t.Fatalf("Failed to get reshare result: %v", err)
+	}
+
+	if result.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+		t.Fatalf("Expected verifying key %s, got %s", publicKey.VerifyingKey, result.PublicKeyPackage.VerifyingKey)
+	}
+}
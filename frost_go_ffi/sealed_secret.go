@@ -0,0 +1,134 @@
+package frost_uniffi_sdk
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Err* are used for checking sealed secret errors with `errors.Is`
+var ErrSealedSecretWrongPassphrase = errors.New("wrong passphrase or corrupted sealed secret")
+var ErrSealedSecretUnsupportedVersion = errors.New("unsupported sealed secret version")
+var ErrSealedSecretKindMismatch = errors.New("sealed secret holds a different kind of secret")
+var ErrSealedSecretMalformed = errors.New("malformed sealed secret")
+
+const sealedSecretVersion = 1
+
+// scrypt parameters recommended for interactive logins as of 2017. They
+// are pinned by the version: a sealed secret naming other ones is rejected
+// instead of letting its envelope pick the cost of opening it.
+const (
+	sealedSecretScryptN       = 1 << 15
+	sealedSecretScryptR       = 8
+	sealedSecretScryptP       = 1
+	sealedSecretScryptSaltLen = 32
+)
+
+type sealedSecretScryptParams struct {
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+	Salt []byte `json:"salt"`
+}
+
+// sealedSecret is the envelope of secret material encrypted with a
+// passphrase. The key is derived with scrypt and the secret is encrypted
+// with XChaCha20-Poly1305, authenticating the version and kind as well.
+type sealedSecret struct {
+	Version    int                      `json:"version"`
+	Kind       string                   `json:"kind"`
+	Scrypt     sealedSecretScryptParams `json:"scrypt"`
+	Nonce      []byte                   `json:"nonce"`
+	Ciphertext []byte                   `json:"ciphertext"`
+}
+
+func (s *sealedSecret) additionalData() []byte {
+	return []byte(fmt.Sprintf("frost-uniffi-sdk/sealed-secret/v%d/%s", s.Version, s.Kind))
+}
+
+func (s *sealedSecret) key(passphrase []byte) ([]byte, error) {
+	return scrypt.Key(passphrase, s.Scrypt.Salt, s.Scrypt.N, s.Scrypt.R, s.Scrypt.P, chacha20poly1305.KeySize)
+}
+
+// sealSecret encrypts plaintext with passphrase. kind names what the
+// plaintext is so that a secret can't be opened as another one.
+func sealSecret(kind string, plaintext []byte, passphrase []byte) ([]byte, error) {
+	sealed := sealedSecret{
+		Version: sealedSecretVersion,
+		Kind:    kind,
+		Scrypt: sealedSecretScryptParams{
+			N:    sealedSecretScryptN,
+			R:    sealedSecretScryptR,
+			P:    sealedSecretScryptP,
+			Salt: make([]byte, sealedSecretScryptSaltLen),
+		},
+		Nonce: make([]byte, chacha20poly1305.NonceSizeX),
+	}
+
+	if _, err := rand.Read(sealed.Scrypt.Salt); err != nil {
+		return nil, err
+	}
+
+	if _, err := rand.Read(sealed.Nonce); err != nil {
+		return nil, err
+	}
+
+	key, err := sealed.key(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed.Ciphertext = aead.Seal(nil, sealed.Nonce, plaintext, sealed.additionalData())
+
+	return json.Marshal(sealed)
+}
+
+// openSecret decrypts a secret of the given kind sealed with sealSecret.
+func openSecret(kind string, data []byte, passphrase []byte) ([]byte, error) {
+	var sealed sealedSecret
+	if err := json.Unmarshal(data, &sealed); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSealedSecretMalformed, err)
+	}
+
+	if sealed.Version != sealedSecretVersion {
+		return nil, fmt.Errorf("%w: %d", ErrSealedSecretUnsupportedVersion, sealed.Version)
+	}
+
+	if sealed.Kind != kind {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrSealedSecretKindMismatch, kind, sealed.Kind)
+	}
+
+	if len(sealed.Nonce) != chacha20poly1305.NonceSizeX {
+		return nil, ErrSealedSecretMalformed
+	}
+
+	if sealed.Scrypt.N != sealedSecretScryptN || sealed.Scrypt.R != sealedSecretScryptR || sealed.Scrypt.P != sealedSecretScryptP || len(sealed.Scrypt.Salt) != sealedSecretScryptSaltLen {
+		return nil, fmt.Errorf("%w: unexpected scrypt parameters", ErrSealedSecretMalformed)
+	}
+
+	key, err := sealed.key(passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSealedSecretMalformed, err)
+	}
+
+	aead, err := chacha20poly1305.NewX(key)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := aead.Open(nil, sealed.Nonce, sealed.Ciphertext, sealed.additionalData())
+	if err != nil {
+		return nil, ErrSealedSecretWrongPassphrase
+	}
+
+	return plaintext, nil
+}
@@ -0,0 +1,52 @@
+package frost_uniffi_sdk
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestOpenSecretRejectsUnpinnedScryptParameters(t *testing.T) {
+	passphrase := []byte("correct horse battery staple")
+
+	sealed, err := sealSecret("test", []byte("secret"), passphrase)
+	if err != nil {
+		t.Fatalf("Failed to seal secret: %v", err)
+	}
+
+	tamper := func(edit func(params *sealedSecretScryptParams)) []byte {
+		var envelope sealedSecret
+		if err := json.Unmarshal(sealed, &envelope); err != nil {
+			t.Fatalf("Failed to parse sealed secret: %v", err)
+		}
+		edit(&envelope.Scrypt)
+		tampered, err := json.Marshal(envelope)
+		if err != nil {
+			t.Fatalf("Failed to serialize sealed secret: %v", err)
+		}
+		return tampered
+	}
+
+	for _, tampered := range [][]byte{
+		// would take hours and gigabytes to derive
+		tamper(func(params *sealedSecretScryptParams) { params.N = 1 << 30 }),
+		// cheap to brute force
+		tamper(func(params *sealedSecretScryptParams) { params.N = 2 }),
+		tamper(func(params *sealedSecretScryptParams) { params.R = 1 }),
+		tamper(func(params *sealedSecretScryptParams) { params.P = 64 }),
+		tamper(func(params *sealedSecretScryptParams) { params.Salt = nil }),
+	} {
+		if _, err := openSecret("test", tampered, passphrase); !errors.Is(err, ErrSealedSecretMalformed) {
+			t.Fatalf("Expected ErrSealedSecretMalformed, got %v", err)
+		}
+	}
+
+	plaintext, err := openSecret("test", sealed, passphrase)
+	if err != nil {
+		t.Fatalf("Failed to open secret: %v", err)
+	}
+
+	if string(plaintext) != "secret" {
+		t.Fatalf("Expected secret, got %s", plaintext)
+	}
+}
@@ -0,0 +1,282 @@
+// Package secp256k1tr is the FROST(secp256k1, SHA-256)-TR API of
+// frost_uniffi_sdk under the names of its default API, so that a program
+// can produce BIP-340 signatures with this package and sign Zcash Orchard
+// spends with frost_uniffi_sdk side by side.
+//
+// Signatures are 64-byte BIP-340 Schnorr signatures. The ones of Aggregate
+// verify against the untweaked x-only verifying key of the group returned
+// by XOnlyVerifyingKey. To spend the key path of a Taproot output, sign
+// with SignWithTweak and AggregateWithTweak instead: their signatures
+// verify against the output key returned by XOnlyOutputKey.
+//
+// The types mirror the ones of frost_uniffi_sdk but hold secp256k1
+// values. They are distinct types, so the compiler rejects them in the
+// RedPallas functions of frost_uniffi_sdk, and the other way around.
+// Identifiers must be created with IdentifierFromUint16 of this package.
+package secp256k1tr
+
+import (
+	"encoding/hex"
+	"errors"
+
+	frost "frost_go_ffi/frost_go_ffi"
+)
+
+// ErrMalformedVerifyingKey is returned by XOnlyVerifyingKey when the
+// verifying key isn't a compressed secp256k1 point.
+var ErrMalformedVerifyingKey = errors.New("verifying key is not a compressed secp256k1 point")
+
+// ErrMalformedMerkleRoot is returned for a Taproot merkle root that isn't
+// 32 bytes long.
+var ErrMalformedMerkleRoot = errors.New("merkle root of a Taproot script tree must be 32 bytes long")
+
+// IdentifierFromUint16 creates the secp256k1 identifier of a participant.
+func IdentifierFromUint16(unsignedUint uint16) (ParticipantIdentifier, error) {
+	identifier, err := frost.Secp256k1TrIdentifierFromUint16(unsignedUint)
+	if err != nil {
+		return ParticipantIdentifier{}, err
+	}
+	return fromParticipantIdentifier(identifier), nil
+}
+
+// TrustedDealerKeygenFrom deals the shares of a new secp256k1 group, or of
+// the group of Configuration.Secret when it is not empty.
+func TrustedDealerKeygenFrom(configuration Configuration) (TrustedKeyGeneration, error) {
+	keygen, err := frost.Secp256k1TrTrustedDealerKeygenFrom(configuration)
+	if err != nil {
+		return TrustedKeyGeneration{}, err
+	}
+
+	secretShares := make(map[ParticipantIdentifier]FrostSecretKeyShare, len(keygen.SecretShares))
+	for identifier, secretShare := range keygen.SecretShares {
+		secretShares[fromParticipantIdentifier(identifier)] = fromSecretKeyShare(secretShare)
+	}
+
+	return TrustedKeyGeneration{
+		SecretShares:     secretShares,
+		PublicKeyPackage: fromPublicKeyPackage(keygen.PublicKeyPackage),
+	}, nil
+}
+
+// VerifyAndGetKeyPackageFrom verifies a secret share dealt by
+// TrustedDealerKeygenFrom and returns the key package of its participant.
+func VerifyAndGetKeyPackageFrom(secretShare FrostSecretKeyShare) (FrostKeyPackage, error) {
+	keyPackage, err := frost.Secp256k1TrVerifyAndGetKeyPackageFrom(secretShare.frost())
+	if err != nil {
+		return FrostKeyPackage{}, err
+	}
+	return fromKeyPackage(keyPackage), nil
+}
+
+// Part1 runs the first part of the DKG for participantIdentifier.
+func Part1(participantIdentifier ParticipantIdentifier, maxSigners uint16, minSigners uint16) (*DkgPart1Result, error) {
+	result, err := frost.Secp256k1TrPart1(participantIdentifier.frost(), maxSigners, minSigners)
+	if err != nil {
+		return nil, err
+	}
+	return &DkgPart1Result{result: result}, nil
+}
+
+// Part2 runs the second part of the DKG with the round 1 packages of the
+// other participants, by identifier of their sender.
+func Part2(secretPackage *DkgRound1SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package) (*DkgPart2Result, error) {
+	result, err := frost.Secp256k1TrPart2(secretPackage.secretPackage, frostRound1Packages(round1Packages))
+	if err != nil {
+		return nil, err
+	}
+	return &DkgPart2Result{result: result}, nil
+}
+
+// Part3 runs the last part of the DKG with the round 1 and round 2
+// packages of the other participants, by identifier of their sender.
+func Part3(secretPackage *DkgRound2SecretPackage, round1Packages map[ParticipantIdentifier]DkgRound1Package, round2Packages map[ParticipantIdentifier]DkgRound2Package) (DkgPart3Result, error) {
+	frostRound2Packages := make(map[frost.ParticipantIdentifier]frost.DkgRound2Package, len(round2Packages))
+	for sender, round2Package := range round2Packages {
+		frostRound2Packages[sender.frost()] = round2Package.frost()
+	}
+
+	result, err := frost.Secp256k1TrPart3(secretPackage.secretPackage, frostRound1Packages(round1Packages), frostRound2Packages)
+	if err != nil {
+		return DkgPart3Result{}, err
+	}
+
+	return DkgPart3Result{
+		PublicKeyPackage: fromPublicKeyPackage(result.PublicKeyPackage),
+		KeyPackage:       fromKeyPackage(result.KeyPackage),
+	}, nil
+}
+
+func frostRound1Packages(round1Packages map[ParticipantIdentifier]DkgRound1Package) map[frost.ParticipantIdentifier]frost.DkgRound1Package {
+	frostRound1Packages := make(map[frost.ParticipantIdentifier]frost.DkgRound1Package, len(round1Packages))
+	for sender, round1Package := range round1Packages {
+		frostRound1Packages[sender.frost()] = round1Package.frost()
+	}
+	return frostRound1Packages
+}
+
+// GenerateNoncesAndCommitments runs the first round of signing for the
+// participant of keyPackage.
+func GenerateNoncesAndCommitments(keyPackage FrostKeyPackage) (FirstRoundCommitment, error) {
+	commitment, err := frost.Secp256k1TrGenerateNoncesAndCommitments(keyPackage.frost())
+	if err != nil {
+		return FirstRoundCommitment{}, err
+	}
+
+	return FirstRoundCommitment{
+		Nonces:      FrostSigningNonces(commitment.Nonces),
+		Commitments: fromSigningCommitments(commitment.Commitments),
+	}, nil
+}
+
+// NewSigningPackage creates the signing package of message out of the
+// commitments of the signers.
+func NewSigningPackage(message Message, commitments []FrostSigningCommitments) (FrostSigningPackage, error) {
+	frostCommitments := make([]frost.FrostSigningCommitments, 0, len(commitments))
+	for _, commitment := range commitments {
+		frostCommitments = append(frostCommitments, commitment.frost())
+	}
+
+	signingPackage, err := frost.Secp256k1TrNewSigningPackage(message, frostCommitments)
+	if err != nil {
+		return FrostSigningPackage{}, err
+	}
+	return FrostSigningPackage(signingPackage), nil
+}
+
+// Sign runs the second round of signing for the participant of keyPackage.
+func Sign(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage) (FrostSignatureShare, error) {
+	signatureShare, err := frost.Secp256k1TrSign(frost.FrostSigningPackage(signingPackage), frost.FrostSigningNonces(nonces), keyPackage.frost())
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	return fromSignatureShare(signatureShare), nil
+}
+
+// Aggregate aggregates the signature shares of the signers into the
+// BIP-340 signature of the group.
+func Aggregate(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) (FrostSignature, error) {
+	frostSignatureShares := make([]frost.FrostSignatureShare, 0, len(signatureShares))
+	for _, signatureShare := range signatureShares {
+		frostSignatureShares = append(frostSignatureShares, signatureShare.frost())
+	}
+
+	signature, err := frost.Secp256k1TrAggregate(frost.FrostSigningPackage(signingPackage), frostSignatureShares, pubkeyPackage.frost())
+	if err != nil {
+		return FrostSignature{}, err
+	}
+	return FrostSignature(signature), nil
+}
+
+// VerifySignatureShare verifies a single signature share against the
+// verifying share of its signer.
+func VerifySignatureShare(signingPackage FrostSigningPackage, signatureShare FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage) error {
+	return frost.Secp256k1TrVerifySignatureShare(frost.FrostSigningPackage(signingPackage), signatureShare.frost(), pubkeyPackage.frost())
+}
+
+// VerifySignature verifies the BIP-340 signature of message by the group
+// of pubkey.
+func VerifySignature(message Message, signature FrostSignature, pubkey FrostPublicKeyPackage) error {
+	return frost.Secp256k1TrVerifySignature(message, frost.FrostSignature(signature), pubkey.frost())
+}
+
+// XOnlyVerifyingKey returns the 32-byte x-only verifying key of the group
+// of publicKeyPackage, which BIP-340 signatures of this package are
+// verified against.
+//
+// It is the untweaked internal key of a Taproot output, whose output key
+// is returned by XOnlyOutputKey.
+func XOnlyVerifyingKey(publicKeyPackage FrostPublicKeyPackage) ([]byte, error) {
+	verifyingKey, err := hex.DecodeString(publicKeyPackage.VerifyingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// the verifying key is a 33-byte SEC1 compressed point
+	if len(verifyingKey) != 33 || (verifyingKey[0] != 0x02 && verifyingKey[0] != 0x03) {
+		return nil, ErrMalformedVerifyingKey
+	}
+
+	return verifyingKey[1:], nil
+}
+
+// TweakPublicKeyPackage returns the public key package of the group tweaked
+// for the key path of a Taproot output, as BIP-341 does with the internal
+// key of the output. merkleRoot is the root of the script tree of the
+// output, or nil for a BIP-86 output without scripts.
+//
+// Its verifying key is the output key: VerifySignature checks the
+// signatures of AggregateWithTweak against it, and VerifySignatureShare
+// the signature shares of SignWithTweak.
+func TweakPublicKeyPackage(publicKeyPackage FrostPublicKeyPackage, merkleRoot []byte) (FrostPublicKeyPackage, error) {
+	frostMerkleRoot, err := taprootMerkleRoot(merkleRoot)
+	if err != nil {
+		return FrostPublicKeyPackage{}, err
+	}
+
+	tweaked, err := frost.Secp256k1TrTweakPublicKeyPackage(publicKeyPackage.frost(), frostMerkleRoot)
+	if err != nil {
+		return FrostPublicKeyPackage{}, err
+	}
+	return fromPublicKeyPackage(tweaked), nil
+}
+
+// XOnlyOutputKey returns the 32-byte x-only output key of the Taproot
+// output of the group of publicKeyPackage with the script tree of
+// merkleRoot, nil for none. It is the key of the scriptPubKey of the
+// output, which the signatures of AggregateWithTweak verify against.
+func XOnlyOutputKey(publicKeyPackage FrostPublicKeyPackage, merkleRoot []byte) ([]byte, error) {
+	tweaked, err := TweakPublicKeyPackage(publicKeyPackage, merkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	return XOnlyVerifyingKey(tweaked)
+}
+
+// SignWithTweak runs the second round of signing for the participant of
+// keyPackage, to spend the key path of the Taproot output with the script
+// tree of merkleRoot, nil for none.
+func SignWithTweak(signingPackage FrostSigningPackage, nonces FrostSigningNonces, keyPackage FrostKeyPackage, merkleRoot []byte) (FrostSignatureShare, error) {
+	frostMerkleRoot, err := taprootMerkleRoot(merkleRoot)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+
+	signatureShare, err := frost.Secp256k1TrSignWithTweak(frost.FrostSigningPackage(signingPackage), frost.FrostSigningNonces(nonces), keyPackage.frost(), frostMerkleRoot)
+	if err != nil {
+		return FrostSignatureShare{}, err
+	}
+	return fromSignatureShare(signatureShare), nil
+}
+
+// AggregateWithTweak aggregates the signature shares of SignWithTweak into
+// the BIP-340 signature of the output key returned by XOnlyOutputKey for
+// the same merkleRoot. pubkeyPackage is the untweaked public key package
+// of the group.
+func AggregateWithTweak(signingPackage FrostSigningPackage, signatureShares []FrostSignatureShare, pubkeyPackage FrostPublicKeyPackage, merkleRoot []byte) (FrostSignature, error) {
+	frostMerkleRoot, err := taprootMerkleRoot(merkleRoot)
+	if err != nil {
+		return FrostSignature{}, err
+	}
+
+	frostSignatureShares := make([]frost.FrostSignatureShare, 0, len(signatureShares))
+	for _, signatureShare := range signatureShares {
+		frostSignatureShares = append(frostSignatureShares, signatureShare.frost())
+	}
+
+	signature, err := frost.Secp256k1TrAggregateWithTweak(frost.FrostSigningPackage(signingPackage), frostSignatureShares, pubkeyPackage.frost(), frostMerkleRoot)
+	if err != nil {
+		return FrostSignature{}, err
+	}
+	return FrostSignature(signature), nil
+}
+
+// taprootMerkleRoot checks merkleRoot, nil standing for no script tree.
+func taprootMerkleRoot(merkleRoot []byte) (*[]byte, error) {
+	if merkleRoot == nil {
+		return nil, nil
+	}
+	if len(merkleRoot) != 32 {
+		return nil, ErrMalformedMerkleRoot
+	}
+	return &merkleRoot, nil
+}
@@ -0,0 +1,377 @@
+package secp256k1tr
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+)
+
+func TestDkgAndSignBip340(t *testing.T) {
+	const maxSigners, minSigners = 3, 2
+	message := Message{Data: []byte("i am a message")}
+
+	var identifiers []ParticipantIdentifier
+	for i := uint16(1); i <= maxSigners; i++ {
+		identifier, err := IdentifierFromUint16(i)
+		if err != nil {
+			t.Fatalf("Failed to create identifier: %v", err)
+		}
+		identifiers = append(identifiers, identifier)
+	}
+
+	part1 := make(map[ParticipantIdentifier]*DkgPart1Result)
+	for _, identifier := range identifiers {
+		result, err := Part1(identifier, maxSigners, minSigners)
+		if err != nil {
+			t.Fatalf("Failed to run DKG part 1: %v", err)
+		}
+		part1[identifier] = result
+	}
+
+	round1Packages := func(receiver ParticipantIdentifier) map[ParticipantIdentifier]DkgRound1Package {
+		packages := make(map[ParticipantIdentifier]DkgRound1Package)
+		for sender, result := range part1 {
+			if sender != receiver {
+				packages[sender] = result.Package()
+			}
+		}
+		return packages
+	}
+
+	part2 := make(map[ParticipantIdentifier]*DkgPart2Result)
+	round2Packages := make(map[ParticipantIdentifier]map[ParticipantIdentifier]DkgRound2Package)
+	for _, identifier := range identifiers {
+		result, err := Part2(part1[identifier].Secret(), round1Packages(identifier))
+		if err != nil {
+			t.Fatalf("Failed to run DKG part 2: %v", err)
+		}
+		part2[identifier] = result
+
+		for _, round2Package := range result.Packages() {
+			if round2Packages[round2Package.Identifier] == nil {
+				round2Packages[round2Package.Identifier] = make(map[ParticipantIdentifier]DkgRound2Package)
+			}
+			round2Packages[round2Package.Identifier][identifier] = round2Package
+		}
+	}
+
+	var publicKey FrostPublicKeyPackage
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for _, identifier := range identifiers {
+		result, err := Part3(part2[identifier].Secret(), round1Packages(identifier), round2Packages[identifier])
+		if err != nil {
+			t.Fatalf("Failed to run DKG part 3: %v", err)
+		}
+
+		if publicKey.VerifyingKey != "" && result.PublicKeyPackage.VerifyingKey != publicKey.VerifyingKey {
+			t.Fatalf("Participants derived different verifying keys")
+		}
+
+		publicKey = result.PublicKeyPackage
+		if len(keyPackages) < minSigners {
+			keyPackages[identifier] = result.KeyPackage
+		}
+	}
+
+	xOnly, err := XOnlyVerifyingKey(publicKey)
+	if err != nil {
+		t.Fatalf("Failed to get x-only verifying key: %v", err)
+	}
+
+	if len(xOnly) != 32 {
+		t.Fatalf("Expected a 32-byte x-only verifying key, got %d bytes", len(xOnly))
+	}
+
+	nonces := make(map[ParticipantIdentifier]FrostSigningNonces)
+	var commitments []FrostSigningCommitments
+	for identifier, keyPackage := range keyPackages {
+		commitment, err := GenerateNoncesAndCommitments(keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to generate nonces and commitments: %v", err)
+		}
+		nonces[identifier] = commitment.Nonces
+		commitments = append(commitments, commitment.Commitments)
+	}
+
+	signingPackage, err := NewSigningPackage(message, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	var signatureShares []FrostSignatureShare
+	for identifier, keyPackage := range keyPackages {
+		signatureShare, err := Sign(signingPackage, nonces[identifier], keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if err := VerifySignatureShare(signingPackage, signatureShare, publicKey); err != nil {
+			t.Fatalf("Failed to verify signature share: %v", err)
+		}
+
+		signatureShares = append(signatureShares, signatureShare)
+	}
+
+	signature, err := Aggregate(signingPackage, signatureShares, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if len(signature.Data) != 64 {
+		t.Fatalf("Expected a 64-byte BIP-340 signature, got %d bytes", len(signature.Data))
+	}
+
+	if err := VerifySignature(message, signature, publicKey); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+
+	if err := VerifySignature(Message{Data: []byte("another message")}, signature, publicKey); err == nil {
+		t.Fatalf("Signature verified for another message")
+	}
+}
+
+// Signatures are checked with the BIP-340 verifier of btcec, which
+// shares no code with frost-core.
+func TestSignaturesVerifyWithBtcec(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	// btcec only verifies 32-byte messages, like Taproot sighashes
+	digest := sha256.Sum256([]byte("i am a message"))
+	message := Message{Data: digest[:]}
+
+	keygen, err := TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	nonces := make(map[ParticipantIdentifier]FrostSigningNonces)
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	var commitments []FrostSigningCommitments
+	for identifier, secretShare := range keygen.SecretShares {
+		if len(keyPackages) == int(config.MinSigners) {
+			break
+		}
+
+		keyPackage, err := VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+		keyPackages[identifier] = keyPackage
+
+		commitment, err := GenerateNoncesAndCommitments(keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to generate nonces and commitments: %v", err)
+		}
+		nonces[identifier] = commitment.Nonces
+		commitments = append(commitments, commitment.Commitments)
+	}
+
+	signingPackage, err := NewSigningPackage(message, commitments)
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	var signatureShares []FrostSignatureShare
+	for identifier, keyPackage := range keyPackages {
+		signatureShare, err := Sign(signingPackage, nonces[identifier], keyPackage)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+		signatureShares = append(signatureShares, signatureShare)
+	}
+
+	signature, err := Aggregate(signingPackage, signatureShares, keygen.PublicKeyPackage)
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	xOnly, err := XOnlyVerifyingKey(keygen.PublicKeyPackage)
+	if err != nil {
+		t.Fatalf("Failed to get x-only verifying key: %v", err)
+	}
+
+	publicKey, err := schnorr.ParsePubKey(xOnly)
+	if err != nil {
+		t.Fatalf("Failed to parse x-only verifying key: %v", err)
+	}
+
+	parsed, err := schnorr.ParseSignature(signature.Data)
+	if err != nil {
+		t.Fatalf("Failed to parse signature: %v", err)
+	}
+
+	if !parsed.Verify(message.Data, publicKey) {
+		t.Fatalf("Expected the signature to verify as a BIP-340 signature")
+	}
+
+	otherDigest := sha256.Sum256([]byte("another message"))
+	if parsed.Verify(otherDigest[:], publicKey) {
+		t.Fatalf("Signature verified for another message")
+	}
+}
+
+func TestXOnlyVerifyingKeyRejectsMalformedKeys(t *testing.T) {
+	for _, verifyingKey := range []string{"", "02", "05" + strings.Repeat("00", 32), "02" + strings.Repeat("00", 33)} {
+		if _, err := XOnlyVerifyingKey(FrostPublicKeyPackage{VerifyingKey: verifyingKey}); !errors.Is(err, ErrMalformedVerifyingKey) {
+			t.Fatalf("Expected ErrMalformedVerifyingKey for %q, got %v", verifyingKey, err)
+		}
+	}
+}
+
+// bip341OutputKey computes with btcec the x-only output key of the Taproot
+// output of internalKey with the script tree of merkleRoot, nil for none:
+// the internal key plus the TapTweak hash of both times the generator.
+func bip341OutputKey(t *testing.T, internalKey []byte, merkleRoot []byte) []byte {
+	t.Helper()
+
+	tag := sha256.Sum256([]byte("TapTweak"))
+	h := sha256.New()
+	h.Write(tag[:])
+	h.Write(tag[:])
+	h.Write(internalKey)
+	h.Write(merkleRoot)
+
+	var tweak btcec.ModNScalar
+	if overflow := tweak.SetByteSlice(h.Sum(nil)); overflow {
+		t.Fatalf("TapTweak hash overflows the group order")
+	}
+
+	publicKey, err := schnorr.ParsePubKey(internalKey)
+	if err != nil {
+		t.Fatalf("Failed to parse internal key: %v", err)
+	}
+
+	var internalPoint, tweakPoint, outputPoint btcec.JacobianPoint
+	publicKey.AsJacobian(&internalPoint)
+	btcec.ScalarBaseMultNonConst(&tweak, &tweakPoint)
+	btcec.AddNonConst(&internalPoint, &tweakPoint, &outputPoint)
+	outputPoint.ToAffine()
+
+	return schnorr.SerializePubKey(btcec.NewPublicKey(&outputPoint.X, &outputPoint.Y))
+}
+
+// Tweaked signatures are checked with btcec against the output key of a
+// BIP-86 output, and of an output with a script tree, computed by btcec
+// from the internal key.
+func TestTweakedSignaturesSpendTaprootKeyPath(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	digest := sha256.Sum256([]byte("i am a sighash"))
+	message := Message{Data: digest[:]}
+
+	keygen, err := TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	keyPackages := make(map[ParticipantIdentifier]FrostKeyPackage)
+	for identifier, secretShare := range keygen.SecretShares {
+		if len(keyPackages) == int(config.MinSigners) {
+			break
+		}
+
+		keyPackage, err := VerifyAndGetKeyPackageFrom(secretShare)
+		if err != nil {
+			t.Fatalf("Failed to get key package: %v", err)
+		}
+		keyPackages[identifier] = keyPackage
+	}
+
+	internalKey, err := XOnlyVerifyingKey(keygen.PublicKeyPackage)
+	if err != nil {
+		t.Fatalf("Failed to get x-only verifying key: %v", err)
+	}
+
+	scriptTreeRoot := sha256.Sum256([]byte("i am a script tree"))
+
+	for _, merkleRoot := range [][]byte{nil, scriptTreeRoot[:]} {
+		expected := bip341OutputKey(t, internalKey, merkleRoot)
+
+		outputKey, err := XOnlyOutputKey(keygen.PublicKeyPackage, merkleRoot)
+		if err != nil {
+			t.Fatalf("Failed to get x-only output key: %v", err)
+		}
+
+		if !bytes.Equal(outputKey, expected) {
+			t.Fatalf("Expected output key %x, got %x", expected, outputKey)
+		}
+
+		tweaked, err := TweakPublicKeyPackage(keygen.PublicKeyPackage, merkleRoot)
+		if err != nil {
+			t.Fatalf("Failed to tweak public key package: %v", err)
+		}
+
+		nonces := make(map[ParticipantIdentifier]FrostSigningNonces)
+		var commitments []FrostSigningCommitments
+		for identifier, keyPackage := range keyPackages {
+			commitment, err := GenerateNoncesAndCommitments(keyPackage)
+			if err != nil {
+				t.Fatalf("Failed to generate nonces and commitments: %v", err)
+			}
+			nonces[identifier] = commitment.Nonces
+			commitments = append(commitments, commitment.Commitments)
+		}
+
+		signingPackage, err := NewSigningPackage(message, commitments)
+		if err != nil {
+			t.Fatalf("Failed to create signing package: %v", err)
+		}
+
+		var signatureShares []FrostSignatureShare
+		for identifier, keyPackage := range keyPackages {
+			signatureShare, err := SignWithTweak(signingPackage, nonces[identifier], keyPackage, merkleRoot)
+			if err != nil {
+				t.Fatalf("Failed to sign: %v", err)
+			}
+
+			if err := VerifySignatureShare(signingPackage, signatureShare, tweaked); err != nil {
+				t.Fatalf("Failed to verify signature share: %v", err)
+			}
+
+			signatureShares = append(signatureShares, signatureShare)
+		}
+
+		signature, err := AggregateWithTweak(signingPackage, signatureShares, keygen.PublicKeyPackage, merkleRoot)
+		if err != nil {
+			t.Fatalf("Failed to aggregate signature: %v", err)
+		}
+
+		if err := VerifySignature(message, signature, tweaked); err != nil {
+			t.Fatalf("Failed to verify signature: %v", err)
+		}
+
+		publicKey, err := schnorr.ParsePubKey(expected)
+		if err != nil {
+			t.Fatalf("Failed to parse output key: %v", err)
+		}
+
+		parsed, err := schnorr.ParseSignature(signature.Data)
+		if err != nil {
+			t.Fatalf("Failed to parse signature: %v", err)
+		}
+
+		if !parsed.Verify(message.Data, publicKey) {
+			t.Fatalf("Expected the signature to verify against the output key")
+		}
+	}
+}
+
+func TestTaprootTweakRejectsMalformedMerkleRoots(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+
+	keygen, err := TrustedDealerKeygenFrom(config)
+	if err != nil {
+		t.Fatalf("Failed to generate keygen: %v", err)
+	}
+
+	for _, merkleRoot := range [][]byte{{}, make([]byte, 31), make([]byte, 33)} {
+		if _, err := XOnlyOutputKey(keygen.PublicKeyPackage, merkleRoot); !errors.Is(err, ErrMalformedMerkleRoot) {
+			t.Fatalf("Expected ErrMalformedMerkleRoot for %d bytes, got %v", len(merkleRoot), err)
+		}
+	}
+}
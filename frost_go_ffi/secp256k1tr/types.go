@@ -0,0 +1,210 @@
+package secp256k1tr
+
+import frost "frost_go_ffi/frost_go_ffi"
+
+// Configuration and Message hold no secp256k1 values, they are the ones of
+// frost_uniffi_sdk.
+type (
+	Configuration = frost.Configuration
+	Message       = frost.Message
+)
+
+// ParticipantIdentifier identifies a participant of a secp256k1 group. It
+// must be created with IdentifierFromUint16 of this package.
+type ParticipantIdentifier frost.ParticipantIdentifier
+
+// TrustedKeyGeneration holds the shares dealt by TrustedDealerKeygenFrom.
+type TrustedKeyGeneration struct {
+	SecretShares     map[ParticipantIdentifier]FrostSecretKeyShare
+	PublicKeyPackage FrostPublicKeyPackage
+}
+
+// FrostSecretKeyShare is the share dealt to a participant.
+type FrostSecretKeyShare struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostKeyPackage is the key package of a participant.
+type FrostKeyPackage struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostPublicKeyPackage holds the verifying key of a group and the
+// verifying share of each participant, hex encoded.
+type FrostPublicKeyPackage struct {
+	VerifyingShares map[ParticipantIdentifier]string
+	VerifyingKey    string
+}
+
+// DkgPart1Result holds the outcome of Part1.
+type DkgPart1Result struct {
+	result *frost.DkgPart1Result
+}
+
+// Package returns the round 1 package to broadcast to every other
+// participant.
+func (r *DkgPart1Result) Package() DkgRound1Package {
+	return fromDkgRound1Package(r.result.Package())
+}
+
+// Secret returns the secret package to give to Part2.
+func (r *DkgPart1Result) Secret() *DkgRound1SecretPackage {
+	return &DkgRound1SecretPackage{secretPackage: r.result.Secret()}
+}
+
+// DkgPart2Result holds the outcome of Part2.
+type DkgPart2Result struct {
+	result *frost.DkgPart2Result
+}
+
+// Packages returns the round 2 packages, each one to be sent privately to
+// the participant it is addressed to.
+func (r *DkgPart2Result) Packages() []DkgRound2Package {
+	frostPackages := r.result.Packages()
+
+	packages := make([]DkgRound2Package, 0, len(frostPackages))
+	for _, round2Package := range frostPackages {
+		packages = append(packages, fromDkgRound2Package(round2Package))
+	}
+	return packages
+}
+
+// Secret returns the secret package to give to Part3.
+func (r *DkgPart2Result) Secret() *DkgRound2SecretPackage {
+	return &DkgRound2SecretPackage{secretPackage: r.result.Secret()}
+}
+
+// DkgPart3Result holds the key package of a participant and the public
+// key package of the group created by the DKG.
+type DkgPart3Result struct {
+	PublicKeyPackage FrostPublicKeyPackage
+	KeyPackage       FrostKeyPackage
+}
+
+// DkgRound1Package is the round 1 package of the participant Identifier.
+type DkgRound1Package struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// DkgRound2Package is the round 2 package addressed to the participant
+// Identifier.
+type DkgRound2Package struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// DkgRound1SecretPackage is the secret state of a participant between
+// Part1 and Part2.
+type DkgRound1SecretPackage struct {
+	secretPackage *frost.DkgRound1SecretPackage
+}
+
+// DkgRound2SecretPackage is the secret state of a participant between
+// Part2 and Part3.
+type DkgRound2SecretPackage struct {
+	secretPackage *frost.DkgRound2SecretPackage
+}
+
+// FirstRoundCommitment holds the nonces of the first round of signing,
+// which must be kept secret, and the commitments to send to the
+// coordinator.
+type FirstRoundCommitment struct {
+	Nonces      FrostSigningNonces
+	Commitments FrostSigningCommitments
+}
+
+// FrostSigningNonces are the secret nonces of a signer.
+type FrostSigningNonces frost.FrostSigningNonces
+
+// FrostSigningCommitments are the commitments of a signer.
+type FrostSigningCommitments struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostSigningPackage is the signing package created by the coordinator.
+type FrostSigningPackage frost.FrostSigningPackage
+
+// FrostSignatureShare is the signature share of a signer.
+type FrostSignatureShare struct {
+	Identifier ParticipantIdentifier
+	Data       []byte
+}
+
+// FrostSignature is the signature of a group.
+type FrostSignature frost.FrostSignature
+
+func fromParticipantIdentifier(identifier frost.ParticipantIdentifier) ParticipantIdentifier {
+	return ParticipantIdentifier(identifier)
+}
+
+func (i ParticipantIdentifier) frost() frost.ParticipantIdentifier {
+	return frost.ParticipantIdentifier(i)
+}
+
+func fromSecretKeyShare(share frost.FrostSecretKeyShare) FrostSecretKeyShare {
+	return FrostSecretKeyShare{Identifier: fromParticipantIdentifier(share.Identifier), Data: share.Data}
+}
+
+func (s FrostSecretKeyShare) frost() frost.FrostSecretKeyShare {
+	return frost.FrostSecretKeyShare{Identifier: s.Identifier.frost(), Data: s.Data}
+}
+
+func fromKeyPackage(keyPackage frost.FrostKeyPackage) FrostKeyPackage {
+	return FrostKeyPackage{Identifier: fromParticipantIdentifier(keyPackage.Identifier), Data: keyPackage.Data}
+}
+
+func (k FrostKeyPackage) frost() frost.FrostKeyPackage {
+	return frost.FrostKeyPackage{Identifier: k.Identifier.frost(), Data: k.Data}
+}
+
+func fromPublicKeyPackage(publicKeyPackage frost.FrostPublicKeyPackage) FrostPublicKeyPackage {
+	verifyingShares := make(map[ParticipantIdentifier]string, len(publicKeyPackage.VerifyingShares))
+	for identifier, verifyingShare := range publicKeyPackage.VerifyingShares {
+		verifyingShares[fromParticipantIdentifier(identifier)] = verifyingShare
+	}
+	return FrostPublicKeyPackage{VerifyingShares: verifyingShares, VerifyingKey: publicKeyPackage.VerifyingKey}
+}
+
+func (p FrostPublicKeyPackage) frost() frost.FrostPublicKeyPackage {
+	verifyingShares := make(map[frost.ParticipantIdentifier]string, len(p.VerifyingShares))
+	for identifier, verifyingShare := range p.VerifyingShares {
+		verifyingShares[identifier.frost()] = verifyingShare
+	}
+	return frost.FrostPublicKeyPackage{VerifyingShares: verifyingShares, VerifyingKey: p.VerifyingKey}
+}
+
+func fromSigningCommitments(commitments frost.FrostSigningCommitments) FrostSigningCommitments {
+	return FrostSigningCommitments{Identifier: fromParticipantIdentifier(commitments.Identifier), Data: commitments.Data}
+}
+
+func (c FrostSigningCommitments) frost() frost.FrostSigningCommitments {
+	return frost.FrostSigningCommitments{Identifier: c.Identifier.frost(), Data: c.Data}
+}
+
+func fromSignatureShare(signatureShare frost.FrostSignatureShare) FrostSignatureShare {
+	return FrostSignatureShare{Identifier: fromParticipantIdentifier(signatureShare.Identifier), Data: signatureShare.Data}
+}
+
+func (s FrostSignatureShare) frost() frost.FrostSignatureShare {
+	return frost.FrostSignatureShare{Identifier: s.Identifier.frost(), Data: s.Data}
+}
+
+func fromDkgRound1Package(round1Package frost.DkgRound1Package) DkgRound1Package {
+	return DkgRound1Package{Identifier: fromParticipantIdentifier(round1Package.Identifier), Data: round1Package.Data}
+}
+
+func (p DkgRound1Package) frost() frost.DkgRound1Package {
+	return frost.DkgRound1Package{Identifier: p.Identifier.frost(), Data: p.Data}
+}
+
+func fromDkgRound2Package(round2Package frost.DkgRound2Package) DkgRound2Package {
+	return DkgRound2Package{Identifier: fromParticipantIdentifier(round2Package.Identifier), Data: round2Package.Data}
+}
+
+func (p DkgRound2Package) frost() frost.DkgRound2Package {
+	return frost.DkgRound2Package{Identifier: p.Identifier.frost(), Data: p.Data}
+}
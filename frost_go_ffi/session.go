@@ -0,0 +1,319 @@
+package frost_uniffi_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SessionSigner is a signing participant of a SigningSession. It is
+// usually backed by a remote signer reached through some transport.
+// Implementations must return when ctx is done.
+type SessionSigner interface {
+	// Identifier of the participant
+	Identifier() ParticipantIdentifier
+	// Commit returns the round 1 commitment of the participant.
+	Commit(ctx context.Context) (FrostSigningCommitments, error)
+	// Sign returns the signature share of the participant for the given
+	// Round 2 configuration.
+	Sign(ctx context.Context, round2Config Round2Configuration) (FrostSignatureShare, error)
+}
+
+// LocalSessionSigner adapts a SigningParticipant living in the same
+// process to a SessionSigner.
+type LocalSessionSigner struct {
+	Participant *SigningParticipant
+}
+
+func (s LocalSessionSigner) Identifier() ParticipantIdentifier {
+	return s.Participant.Identifier()
+}
+
+func (s LocalSessionSigner) Commit(ctx context.Context) (FrostSigningCommitments, error) {
+	if err := ctx.Err(); err != nil {
+		return FrostSigningCommitments{}, err
+	}
+	return s.Participant.Commit()
+}
+
+func (s LocalSessionSigner) Sign(ctx context.Context, round2Config Round2Configuration) (FrostSignatureShare, error) {
+	if err := ctx.Err(); err != nil {
+		return FrostSignatureShare{}, err
+	}
+	return s.Participant.Sign(round2Config)
+}
+
+// SessionRound identifies the round of a SigningSession.
+type SessionRound int
+
+const (
+	// SessionRoundCommitments is the round where signers send their
+	// commitments.
+	SessionRoundCommitments SessionRound = iota + 1
+	// SessionRoundSignatureShares is the round where signers send their
+	// signature shares.
+	SessionRoundSignatureShares
+)
+
+func (r SessionRound) String() string {
+	switch r {
+	case SessionRoundCommitments:
+		return "Commitments"
+	case SessionRoundSignatureShares:
+		return "SignatureShares"
+	default:
+		return fmt.Sprintf("SessionRound(%d)", int(r))
+	}
+}
+
+// Err* are used for checking SigningSession errors with `errors.Is`
+var ErrSessionRoundFailed = errors.New("signing session round failed")
+var ErrSessionNotEnoughSigners = errors.New("not enough signers to start a signing session")
+
+// SessionRoundError is returned when one or more signers did not deliver
+// their input for a round of a SigningSession, either because the round
+// deadline passed or because their input was rejected.
+type SessionRoundError struct {
+	Round SessionRound
+	// Unresponsive are the signers that did not respond before the
+	// round deadline.
+	Unresponsive []ParticipantIdentifier
+	// Failed are the signers that responded with an error or whose
+	// input was rejected by the coordinator.
+	Failed map[ParticipantIdentifier]error
+	// Responsive are the signers that delivered valid input for the round.
+	Responsive []ParticipantIdentifier
+}
+
+func (e *SessionRoundError) Error() string {
+	return fmt.Sprintf("%s: round %s, %d unresponsive, %d failed", ErrSessionRoundFailed.Error(), e.Round, len(e.Unresponsive), len(e.Failed))
+}
+
+func (e *SessionRoundError) Unwrap() error {
+	return ErrSessionRoundFailed
+}
+
+// CanRetry tells whether enough signers were live in this round to
+// restart the session without the unresponsive and failed ones.
+func (e *SessionRoundError) CanRetry(minSigners uint16) bool {
+	return len(e.Responsive) >= int(minSigners)
+}
+
+// SessionTimeouts are the deadlines for each round of a SigningSession.
+// A zero value means that the round is only bound by the context passed
+// to Run.
+type SessionTimeouts struct {
+	Commitments     time.Duration
+	SignatureShares time.Duration
+}
+
+// SigningSession drives a signing round through a Coordinator with signers
+// that may be slow or unreachable:
+// commitments -> signing package -> signature shares -> signature.
+//
+// Every signer passed to Run must respond within each round deadline with
+// valid input. When that doesn't happen Run returns a *SessionRoundError
+// that names the signers that failed, and Run can be called again with a
+// different subset of signers.
+type SigningSession struct {
+	configuration    Configuration
+	publicKeyPackage FrostPublicKeyPackage
+	message          Message
+	timeouts         SessionTimeouts
+}
+
+// NewSigningSession creates a session to sign message with the group of
+// publicKeyPackage.
+func NewSigningSession(configuration Configuration, publicKeyPackage FrostPublicKeyPackage, message Message, timeouts SessionTimeouts) *SigningSession {
+	return &SigningSession{
+		configuration:    configuration,
+		publicKeyPackage: publicKeyPackage,
+		message:          message,
+		timeouts:         timeouts,
+	}
+}
+
+// SigningSessionResult is the outcome of a successful SigningSession run.
+type SigningSessionResult struct {
+	Signature FrostSignature
+	// Round2Configuration holds the randomizer the signature was produced
+	// with, which is needed to verify it.
+	Round2Configuration Round2Configuration
+}
+
+// Run executes both rounds of FROST with the given signers and returns
+// the aggregated and verified signature.
+func (s *SigningSession) Run(ctx context.Context, signers []SessionSigner) (SigningSessionResult, error) {
+	if len(signers) < int(s.configuration.MinSigners) {
+		return SigningSessionResult{}, ErrSessionNotEnoughSigners
+	}
+
+	coordinator, err := NewCoordinator(s.configuration, s.publicKeyPackage, s.message)
+	if err != nil {
+		return SigningSessionResult{}, err
+	}
+
+	err = runSessionRound(ctx, s.timeouts.Commitments, SessionRoundCommitments, signers,
+		func(ctx context.Context, signer SessionSigner) error {
+			commitment, err := signer.Commit(ctx)
+			if err != nil {
+				return err
+			}
+			if commitment.Identifier != signer.Identifier() {
+				return &CoordinatorIdentifierError{Identifier: commitment.Identifier, Err: ErrCoordinatorUnknownIdentifier}
+			}
+			return coordinator.ReceiveCommitment(commitment)
+		},
+	)
+	if err != nil {
+		return SigningSessionResult{}, err
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		return SigningSessionResult{}, err
+	}
+
+	err = runSessionRound(ctx, s.timeouts.SignatureShares, SessionRoundSignatureShares, signers,
+		func(ctx context.Context, signer SessionSigner) error {
+			signatureShare, err := signer.Sign(ctx, round2Config)
+			if err != nil {
+				return err
+			}
+			if signatureShare.Identifier != signer.Identifier() {
+				return &CoordinatorIdentifierError{Identifier: signatureShare.Identifier, Err: ErrCoordinatorUnexpectedSignatureShare}
+			}
+			return coordinator.ReceiveSignatureShare(signatureShare)
+		},
+	)
+	if err != nil {
+		return SigningSessionResult{}, err
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		if culprits := SignatureShareCulprits(err); culprits != nil {
+			return SigningSessionResult{}, culpritsRoundError(signers, culprits, err)
+		}
+		return SigningSessionResult{}, err
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		return SigningSessionResult{}, err
+	}
+
+	return SigningSessionResult{Signature: signature, Round2Configuration: round2Config}, nil
+}
+
+// culpritsRoundError reports the signers that sent invalid signature
+// shares as failed, so that the session can be retried without them.
+func culpritsRoundError(signers []SessionSigner, culprits []ParticipantIdentifier, err error) *SessionRoundError {
+	roundError := &SessionRoundError{
+		Round:  SessionRoundSignatureShares,
+		Failed: make(map[ParticipantIdentifier]error),
+	}
+
+	for _, culprit := range culprits {
+		roundError.Failed[culprit] = err
+	}
+
+	for _, signer := range signers {
+		if _, failed := roundError.Failed[signer.Identifier()]; !failed {
+			roundError.Responsive = append(roundError.Responsive, signer.Identifier())
+		}
+	}
+
+	return roundError
+}
+
+type sessionResult struct {
+	identifier ParticipantIdentifier
+	err        error
+}
+
+// runSessionRound calls receive for every signer concurrently and waits
+// until all of them returned or the round deadline passed.
+func runSessionRound(
+	ctx context.Context,
+	timeout time.Duration,
+	round SessionRound,
+	signers []SessionSigner,
+	receive func(context.Context, SessionSigner) error,
+) error {
+	roundCtx, cancel := ctx, context.CancelFunc(func() {})
+	if timeout > 0 {
+		roundCtx, cancel = context.WithTimeout(ctx, timeout)
+	}
+	defer cancel()
+
+	// buffered so that signers answering after the deadline don't block
+	results := make(chan sessionResult, len(signers))
+	for _, signer := range signers {
+		go func(signer SessionSigner) {
+			results <- sessionResult{identifier: signer.Identifier(), err: receive(roundCtx, signer)}
+		}(signer)
+	}
+
+	pending := make(map[ParticipantIdentifier]bool, len(signers))
+	for _, signer := range signers {
+		pending[signer.Identifier()] = true
+	}
+
+	roundError := &SessionRoundError{
+		Round:  round,
+		Failed: make(map[ParticipantIdentifier]error),
+	}
+
+	record := func(result sessionResult) {
+		if !pending[result.identifier] {
+			// duplicated signer
+			return
+		}
+		if errors.Is(result.err, context.DeadlineExceeded) || errors.Is(result.err, context.Canceled) {
+			// the signer gave up waiting, it is still pending
+			return
+		}
+		delete(pending, result.identifier)
+		if result.err != nil {
+			roundError.Failed[result.identifier] = result.err
+		} else {
+			roundError.Responsive = append(roundError.Responsive, result.identifier)
+		}
+	}
+
+	received := 0
+collect:
+	for ; received < len(signers); received++ {
+		select {
+		case result := <-results:
+			record(result)
+		case <-roundCtx.Done():
+			break collect
+		}
+	}
+
+	// results that arrived along with the deadline are not late
+drain:
+	for ; received < len(signers); received++ {
+		select {
+		case result := <-results:
+			record(result)
+		default:
+			break drain
+		}
+	}
+
+	for _, signer := range signers {
+		if pending[signer.Identifier()] {
+			delete(pending, signer.Identifier())
+			roundError.Unresponsive = append(roundError.Unresponsive, signer.Identifier())
+		}
+	}
+
+	if len(roundError.Unresponsive) == 0 && len(roundError.Failed) == 0 {
+		return nil
+	}
+
+	return roundError
+}
@@ -0,0 +1,79 @@
+package frost_uniffi_sdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// unresponsiveSigner never answers before ctx is done.
+type unresponsiveSigner struct {
+	identifier ParticipantIdentifier
+}
+
+func (s unresponsiveSigner) Identifier() ParticipantIdentifier {
+	return s.identifier
+}
+
+func (s unresponsiveSigner) Commit(ctx context.Context) (FrostSigningCommitments, error) {
+	<-ctx.Done()
+	return FrostSigningCommitments{}, ctx.Err()
+}
+
+func (s unresponsiveSigner) Sign(ctx context.Context, round2Config Round2Configuration) (FrostSignatureShare, error) {
+	<-ctx.Done()
+	return FrostSignatureShare{}, ctx.Err()
+}
+
+func TestSigningSessionRetriesWithoutUnresponsiveSigner(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	var signers []SessionSigner
+	var unresponsive ParticipantIdentifier
+	for identifier, keyPackage := range keyPackages {
+		if len(signers) == int(config.MinSigners) {
+			unresponsive = identifier
+			signers = append(signers, unresponsiveSigner{identifier: identifier})
+			continue
+		}
+		signers = append(signers, LocalSessionSigner{Participant: NewSigningParticipant(keyPackage)})
+	}
+
+	session := NewSigningSession(config, publicKey, message, SessionTimeouts{
+		Commitments:     100 * time.Millisecond,
+		SignatureShares: 100 * time.Millisecond,
+	})
+
+	_, err := session.Run(context.Background(), signers)
+
+	var roundError *SessionRoundError
+	if !errors.Is(err, ErrSessionRoundFailed) || !errors.As(err, &roundError) {
+		t.Fatalf("Expected session round error, got %v", err)
+	}
+
+	if roundError.Round != SessionRoundCommitments {
+		t.Fatalf("Expected round %s, got %s", SessionRoundCommitments, roundError.Round)
+	}
+
+	if len(roundError.Unresponsive) != 1 || roundError.Unresponsive[0] != unresponsive {
+		t.Fatalf("Expected %s to be unresponsive, got %v", unresponsive.Data, roundError.Unresponsive)
+	}
+
+	if !roundError.CanRetry(config.MinSigners) {
+		t.Fatalf("Expected to be able to retry with %d responsive signers", len(roundError.Responsive))
+	}
+
+	result, err := session.Run(context.Background(), signers[:config.MinSigners])
+	if err != nil {
+		t.Fatalf("Failed to run signing session: %v", err)
+	}
+
+	err = VerifyRandomizedSignature(result.Round2Configuration.Randomizer, message, result.Signature, publicKey)
+	if err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"sync"
+)
+
+// Err* are used for checking SigningParticipant errors with `errors.Is`
+var ErrParticipantMissingSigningNonces = errors.New("participant has no signing nonces, generate a commitment first")
+var ErrParticipantNoncesAlreadyUsed = errors.New("participant signing nonces were already used")
+
+// SigningParticipant is a participant of a FROST signature scheme.
+//
+// It keeps the signing nonces generated on Commit to itself and makes sure
+// they are used for a single signature share: reusing nonces across two
+// signatures leaks the signing share. The nonces are consumed and zeroed by
+// the first call to Sign, whether it succeeds or not. Any further call
+// fails with ErrParticipantNoncesAlreadyUsed until a new commitment is
+// generated.
+//
+// A SigningParticipant is safe for concurrent use by multiple goroutines.
+type SigningParticipant struct {
+	mu         sync.Mutex
+	keyPackage FrostKeyPackage
+	nonces     *FrostSigningNonces
+	noncesUsed bool
+}
+
+// NewSigningParticipant creates a participant that signs with keyPackage.
+func NewSigningParticipant(keyPackage FrostKeyPackage) *SigningParticipant {
+	return &SigningParticipant{
+		keyPackage: keyPackage,
+	}
+}
+
+// Identifier of this participant.
+func (p *SigningParticipant) Identifier() ParticipantIdentifier {
+	return p.keyPackage.Identifier
+}
+
+// Commit generates fresh signing nonces and returns the commitment to be
+// sent to the coordinator. Nonces of a previous commitment that were not
+// used are discarded.
+func (p *SigningParticipant) Commit() (FrostSigningCommitments, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	firstRoundCommitment, err := GenerateNoncesAndCommitments(p.keyPackage)
+	if err != nil {
+		return FrostSigningCommitments{}, err
+	}
+
+	p.discardNonces()
+	p.nonces = &firstRoundCommitment.Nonces
+	p.noncesUsed = false
+
+	return firstRoundCommitment.Commitments, nil
+}
+
+// Sign produces a signature share for the signing package and randomizer
+// of round2Config, consuming the nonces of the last commitment.
+func (p *SigningParticipant) Sign(round2Config Round2Configuration) (FrostSignatureShare, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.nonces == nil {
+		if p.noncesUsed {
+			return FrostSignatureShare{}, ErrParticipantNoncesAlreadyUsed
+		}
+		return FrostSignatureShare{}, ErrParticipantMissingSigningNonces
+	}
+
+	nonces := *p.nonces
+	defer zeroBytes(nonces.Data)
+
+	p.nonces = nil
+	p.noncesUsed = true
+
+	return Sign(round2Config.SigningPackage, nonces, p.keyPackage, round2Config.Randomizer)
+}
+
+func (p *SigningParticipant) discardNonces() {
+	if p.nonces != nil {
+		zeroBytes(p.nonces.Data)
+		p.nonces = nil
+	}
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
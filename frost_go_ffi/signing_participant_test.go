@@ -0,0 +1,67 @@
+package frost_uniffi_sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSigningParticipantNoncesAreUsedOnce(t *testing.T) {
+	config := Configuration{MinSigners: 2, MaxSigners: 3, Secret: []byte{}}
+	message := Message{Data: []byte("i am a message")}
+
+	publicKey, keyPackages := trustedDealerKeyPackages(t, config)
+
+	coordinator, err := NewCoordinator(config, publicKey, message)
+	if err != nil {
+		t.Fatalf("Failed to create coordinator: %v", err)
+	}
+
+	var participants []*SigningParticipant
+	for _, keyPackage := range keyPackages {
+		participant := NewSigningParticipant(keyPackage)
+
+		if _, err := participant.Sign(Round2Configuration{}); !errors.Is(err, ErrParticipantMissingSigningNonces) {
+			t.Fatalf("Expected missing signing nonces error, got %v", err)
+		}
+
+		commitment, err := participant.Commit()
+		if err != nil {
+			t.Fatalf("Failed to commit: %v", err)
+		}
+
+		if err := coordinator.ReceiveCommitment(commitment); err != nil {
+			t.Fatalf("Failed to receive commitment: %v", err)
+		}
+
+		participants = append(participants, participant)
+	}
+
+	round2Config, err := coordinator.CreateSigningPackage()
+	if err != nil {
+		t.Fatalf("Failed to create signing package: %v", err)
+	}
+
+	for _, participant := range participants {
+		signatureShare, err := participant.Sign(round2Config)
+		if err != nil {
+			t.Fatalf("Failed to sign: %v", err)
+		}
+
+		if _, err := participant.Sign(round2Config); !errors.Is(err, ErrParticipantNoncesAlreadyUsed) {
+			t.Fatalf("Expected nonces already used error, got %v", err)
+		}
+
+		if err := coordinator.ReceiveSignatureShare(signatureShare); err != nil {
+			t.Fatalf("Failed to receive signature share: %v", err)
+		}
+	}
+
+	signature, err := coordinator.Aggregate()
+	if err != nil {
+		t.Fatalf("Failed to aggregate signature: %v", err)
+	}
+
+	if err := coordinator.Verify(signature); err != nil {
+		t.Fatalf("Failed to verify signature: %v", err)
+	}
+}